@@ -13,6 +13,7 @@ export type CardSummary = {
     id: string;
     number: number;
     project: string;
+    rank: string;
     status: string;
     title: string;
     todos_completed_count: number;
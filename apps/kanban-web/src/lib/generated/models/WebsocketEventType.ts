@@ -2,4 +2,4 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
-export type WebsocketEventType = 'project.created' | 'project.deleted' | 'card.created' | 'card.branch.updated' | 'card.moved' | 'card.commented' | 'card.updated' | 'card.todo.added' | 'card.todo.updated' | 'card.todo.deleted' | 'card.acceptance.added' | 'card.acceptance.updated' | 'card.acceptance.deleted' | 'card.deleted_soft' | 'card.deleted_hard' | 'resync.required';
+export type WebsocketEventType = 'project.created' | 'project.updated' | 'project.deleted' | 'project.restored' | 'card.created' | 'card.branch.updated' | 'card.moved' | 'card.reordered' | 'card.commented' | 'card.updated' | 'card.todo.added' | 'card.todo.updated' | 'card.todo.deleted' | 'card.acceptance.added' | 'card.acceptance.updated' | 'card.acceptance.deleted' | 'card.attachment.added' | 'card.attachment.deleted' | 'card.label.added' | 'card.label.removed' | 'card.priority.updated' | 'card.due.updated' | 'card.relation.added' | 'card.relation.removed' | 'card.relation.updated' | 'card.parent.updated' | 'card.deleted_soft' | 'card.deleted_hard' | 'card.restored' | 'card.transferred' | 'resync.required';
@@ -2,10 +2,13 @@ import type { WebsocketEvent, WebsocketEventType } from './generated';
 
 const WEBSOCKET_EVENT_TYPES: Record<WebsocketEventType, true> = {
   'project.created': true,
+  'project.updated': true,
   'project.deleted': true,
+  'project.restored': true,
   'card.created': true,
   'card.branch.updated': true,
   'card.moved': true,
+  'card.reordered': true,
   'card.commented': true,
   'card.updated': true,
   'card.todo.added': true,
@@ -14,8 +17,20 @@ const WEBSOCKET_EVENT_TYPES: Record<WebsocketEventType, true> = {
   'card.acceptance.added': true,
   'card.acceptance.updated': true,
   'card.acceptance.deleted': true,
+  'card.attachment.added': true,
+  'card.attachment.deleted': true,
+  'card.label.added': true,
+  'card.label.removed': true,
+  'card.priority.updated': true,
+  'card.due.updated': true,
+  'card.relation.added': true,
+  'card.relation.removed': true,
+  'card.relation.updated': true,
+  'card.parent.updated': true,
   'card.deleted_soft': true,
   'card.deleted_hard': true,
+  'card.restored': true,
+  'card.transferred': true,
   'resync.required': true,
 };
 
@@ -68,12 +83,15 @@ export function parseWebSocketEvent(raw: string): WebsocketEvent {
 export async function handleWebSocketEvent(payload: WebsocketEvent, context: WebSocketEventContext): Promise<void> {
   switch (payload.type) {
     case 'project.created':
+    case 'project.updated':
     case 'project.deleted':
+    case 'project.restored':
       await context.loadProjects();
       return;
     case 'card.created':
     case 'card.branch.updated':
     case 'card.moved':
+    case 'card.reordered':
     case 'card.commented':
     case 'card.updated':
     case 'card.todo.added':
@@ -82,8 +100,20 @@ export async function handleWebSocketEvent(payload: WebsocketEvent, context: Web
     case 'card.acceptance.added':
     case 'card.acceptance.updated':
     case 'card.acceptance.deleted':
+    case 'card.attachment.added':
+    case 'card.attachment.deleted':
+    case 'card.label.added':
+    case 'card.label.removed':
+    case 'card.priority.updated':
+    case 'card.due.updated':
+    case 'card.relation.added':
+    case 'card.relation.removed':
+    case 'card.relation.updated':
+    case 'card.parent.updated':
     case 'card.deleted_soft':
     case 'card.deleted_hard':
+    case 'card.restored':
+    case 'card.transferred':
     case 'resync.required':
       if (!context.selectedProjectSlug || payload.project !== context.selectedProjectSlug) {
         return;
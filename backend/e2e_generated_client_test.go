@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http/httptest"
 	"path/filepath"
+	"strconv"
 	"testing"
 
 	genclient "github.com/simonjohansson/kanban/backend/gen/client"
@@ -74,7 +75,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.Len(t, getCard.JSON200.Description, 1)
 	require.Len(t, getCard.JSON200.Comments, 0)
 
-	addComment, err := client.CommentCardWithResponse(ctx, "generated-client-demo", int64(1), genclient.TextBodyRequest{
+	addComment, err := client.CommentCardWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.TextBodyRequest{
 		Body: "This is a generated client comment",
 	})
 	require.NoError(t, err)
@@ -82,7 +83,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, addComment.JSON200)
 	require.Len(t, addComment.JSON200.Comments, 1)
 
-	appendDescription, err := client.AppendDescriptionWithResponse(ctx, "generated-client-demo", int64(1), genclient.TextBodyRequest{
+	appendDescription, err := client.AppendDescriptionWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.TextBodyRequest{
 		Body: "Append description via generated client",
 	})
 	require.NoError(t, err)
@@ -90,7 +91,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, appendDescription.JSON200)
 	require.Len(t, appendDescription.JSON200.Description, 2)
 
-	moveCard, err := client.MoveCardWithResponse(ctx, "generated-client-demo", int64(1), genclient.MoveCardRequest{
+	moveCard, err := client.MoveCardWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.MoveCardRequest{
 		Status: "Doing",
 	})
 	require.NoError(t, err)
@@ -98,7 +99,18 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, moveCard.JSON200)
 	require.Equal(t, "Doing", moveCard.JSON200.Status)
 
-	addTodoA, err := client.AddTodoWithResponse(ctx, "generated-client-demo", int64(1), genclient.AddTodoRequest{
+	staleRevision := `"` + strconv.FormatInt(getCard.JSON200.Revision, 10) + `"`
+	staleMove, err := client.MoveCardWithResponse(ctx, "generated-client-demo", int64(1), &genclient.MoveCardParams{IfMatch: &staleRevision}, genclient.MoveCardRequest{
+		Status: "Review",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 412, staleMove.StatusCode())
+	require.NotNil(t, staleMove.JSON412)
+	require.Equal(t, "Doing", staleMove.JSON412.Status)
+	require.Equal(t, moveCard.JSON200.Revision, staleMove.JSON412.Revision)
+	require.Equal(t, `"`+strconv.FormatInt(moveCard.JSON200.Revision, 10)+`"`, staleMove.HTTPResponse.Header.Get("ETag"))
+
+	addTodoA, err := client.AddTodoWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.AddTodoRequest{
 		Text: "Write tests",
 	})
 	require.NoError(t, err)
@@ -107,7 +119,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.Equal(t, int64(1), addTodoA.JSON201.Id)
 	require.False(t, addTodoA.JSON201.Completed)
 
-	addTodoB, err := client.AddTodoWithResponse(ctx, "generated-client-demo", int64(1), genclient.AddTodoRequest{
+	addTodoB, err := client.AddTodoWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.AddTodoRequest{
 		Text: "Run tests",
 	})
 	require.NoError(t, err)
@@ -115,23 +127,23 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, addTodoB.JSON201)
 	require.Equal(t, int64(2), addTodoB.JSON201.Id)
 
-	doneTodo, err := client.UpdateTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(2), genclient.UpdateTodoRequest{
-		Completed: true,
+	doneTodo, err := client.UpdateTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(2), nil, genclient.UpdateTodoRequest{
+		Completed: ptr(true),
 	})
 	require.NoError(t, err)
 	require.Equal(t, 200, doneTodo.StatusCode())
 	require.NotNil(t, doneTodo.JSON200)
 	require.True(t, doneTodo.JSON200.Completed)
 
-	undoTodo, err := client.UpdateTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(2), genclient.UpdateTodoRequest{
-		Completed: false,
+	undoTodo, err := client.UpdateTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(2), nil, genclient.UpdateTodoRequest{
+		Completed: ptr(false),
 	})
 	require.NoError(t, err)
 	require.Equal(t, 200, undoTodo.StatusCode())
 	require.NotNil(t, undoTodo.JSON200)
 	require.False(t, undoTodo.JSON200.Completed)
 
-	deleteTodo, err := client.DeleteTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(1))
+	deleteTodo, err := client.DeleteTodoWithResponse(ctx, "generated-client-demo", int64(1), int64(1), nil)
 	require.NoError(t, err)
 	require.Equal(t, 200, deleteTodo.StatusCode())
 	require.NotNil(t, deleteTodo.JSON200)
@@ -144,7 +156,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.Len(t, listTodos.JSON200.Todos, 1)
 	require.Equal(t, int64(2), listTodos.JSON200.Todos[0].Id)
 
-	addAC1, err := client.AddAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), genclient.AddAcceptanceCriterionRequest{
+	addAC1, err := client.AddAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.AddAcceptanceCriterionRequest{
 		Text: "Matches acceptance 1",
 	})
 	require.NoError(t, err)
@@ -152,7 +164,7 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, addAC1.JSON201)
 	require.Equal(t, int64(1), addAC1.JSON201.Id)
 
-	addAC2, err := client.AddAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), genclient.AddAcceptanceCriterionRequest{
+	addAC2, err := client.AddAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), nil, genclient.AddAcceptanceCriterionRequest{
 		Text: "Matches acceptance 2",
 	})
 	require.NoError(t, err)
@@ -160,23 +172,23 @@ func TestE2EGeneratedClientFlow(t *testing.T) {
 	require.NotNil(t, addAC2.JSON201)
 	require.Equal(t, int64(2), addAC2.JSON201.Id)
 
-	doneAC, err := client.UpdateAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(2), genclient.UpdateAcceptanceCriterionRequest{
-		Completed: true,
+	doneAC, err := client.UpdateAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(2), nil, genclient.UpdateAcceptanceCriterionRequest{
+		Completed: ptr(true),
 	})
 	require.NoError(t, err)
 	require.Equal(t, 200, doneAC.StatusCode())
 	require.NotNil(t, doneAC.JSON200)
 	require.True(t, doneAC.JSON200.Completed)
 
-	undoAC, err := client.UpdateAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(2), genclient.UpdateAcceptanceCriterionRequest{
-		Completed: false,
+	undoAC, err := client.UpdateAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(2), nil, genclient.UpdateAcceptanceCriterionRequest{
+		Completed: ptr(false),
 	})
 	require.NoError(t, err)
 	require.Equal(t, 200, undoAC.StatusCode())
 	require.NotNil(t, undoAC.JSON200)
 	require.False(t, undoAC.JSON200.Completed)
 
-	deleteAC, err := client.DeleteAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(1))
+	deleteAC, err := client.DeleteAcceptanceCriterionWithResponse(ctx, "generated-client-demo", int64(1), int64(1), nil)
 	require.NoError(t, err)
 	require.Equal(t, 200, deleteAC.StatusCode())
 	require.NotNil(t, deleteAC.JSON200)
@@ -15,6 +15,42 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for WebsocketEventType.
+const (
+	CardAcceptanceAdded   WebsocketEventType = "card.acceptance.added"
+	CardAcceptanceDeleted WebsocketEventType = "card.acceptance.deleted"
+	CardAcceptanceUpdated WebsocketEventType = "card.acceptance.updated"
+	CardAttachmentAdded   WebsocketEventType = "card.attachment.added"
+	CardAttachmentDeleted WebsocketEventType = "card.attachment.deleted"
+	CardBranchUpdated     WebsocketEventType = "card.branch.updated"
+	CardCommented         WebsocketEventType = "card.commented"
+	CardCreated           WebsocketEventType = "card.created"
+	CardDeletedHard       WebsocketEventType = "card.deleted_hard"
+	CardDeletedSoft       WebsocketEventType = "card.deleted_soft"
+	CardDueUpdated        WebsocketEventType = "card.due.updated"
+	CardLabelAdded        WebsocketEventType = "card.label.added"
+	CardLabelRemoved      WebsocketEventType = "card.label.removed"
+	CardMoved             WebsocketEventType = "card.moved"
+	CardParentUpdated     WebsocketEventType = "card.parent.updated"
+	CardPriorityUpdated   WebsocketEventType = "card.priority.updated"
+	CardRelationAdded     WebsocketEventType = "card.relation.added"
+	CardRelationRemoved   WebsocketEventType = "card.relation.removed"
+	CardRelationUpdated   WebsocketEventType = "card.relation.updated"
+	CardReordered         WebsocketEventType = "card.reordered"
+	CardRestored          WebsocketEventType = "card.restored"
+	CardTodoAdded         WebsocketEventType = "card.todo.added"
+	CardTodoDeleted       WebsocketEventType = "card.todo.deleted"
+	CardTodoUpdated       WebsocketEventType = "card.todo.updated"
+	CardTransferred       WebsocketEventType = "card.transferred"
+	CardUpdated           WebsocketEventType = "card.updated"
+	ProjectCreated        WebsocketEventType = "project.created"
+	ProjectDeleted        WebsocketEventType = "project.deleted"
+	ProjectRestored       WebsocketEventType = "project.restored"
+	ProjectUpdated        WebsocketEventType = "project.updated"
+	ResyncRequired        WebsocketEventType = "resync.required"
 )
 
 // AcceptanceCriterion defines model for AcceptanceCriterion.
@@ -33,6 +69,21 @@ type AddAcceptanceCriterionRequest struct {
 	Text   string  `json:"text"`
 }
 
+// AddCardLabelRequest defines model for AddCardLabelRequest.
+type AddCardLabelRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Label  string  `json:"label"`
+}
+
+// AddCardRelationRequest defines model for AddCardRelationRequest.
+type AddCardRelationRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	CardId string  `json:"card_id"`
+	Type   string  `json:"type"`
+}
+
 // AddTodoRequest defines model for AddTodoRequest.
 type AddTodoRequest struct {
 	// Schema A URL to the JSON Schema for this object.
@@ -40,43 +91,126 @@ type AddTodoRequest struct {
 	Text   string  `json:"text"`
 }
 
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string   `json:"$schema,omitempty"`
+	ContentType string    `json:"content_type"`
+	Filename    string    `json:"filename"`
+	Sha256      string    `json:"sha256"`
+	Size        int64     `json:"size"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
 // Card defines model for Card.
 type Card struct {
 	// Schema A URL to the JSON Schema for this object.
 	Schema             *string               `json:"$schema,omitempty"`
 	AcceptanceCriteria []AcceptanceCriterion `json:"acceptance_criteria"`
+	Attachments        []Attachment          `json:"attachments"`
 	Branch             string                `json:"branch"`
 	Comments           []TextEvent           `json:"comments"`
 	CreatedAt          time.Time             `json:"created_at"`
 	Deleted            bool                  `json:"deleted"`
 	Description        []TextEvent           `json:"description"`
+	DueAt              *time.Time            `json:"due_at,omitempty"`
 	History            []HistoryEvent        `json:"history"`
 	Id                 string                `json:"id"`
-	Number             int64                 `json:"number"`
-	Project            string                `json:"project"`
-	Status             string                `json:"status"`
-	Title              string                `json:"title"`
-	Todos              []Todo                `json:"todos"`
-	UpdatedAt          time.Time             `json:"updated_at"`
+	Labels             []string              `json:"labels"`
+	MovedTo            *string               `json:"moved_to,omitempty"`
+
+	// Notes The hand-written # Notes section of the card file; edit the file to change it
+	Notes    *string `json:"notes,omitempty"`
+	Number   int64   `json:"number"`
+	ParentId *string `json:"parent_id,omitempty"`
+	Priority *string `json:"priority,omitempty"`
+	Project  string  `json:"project"`
+
+	// Rank Orders the card within its status; compare byte-wise
+	Rank      string         `json:"rank"`
+	Relations []CardRelation `json:"relations"`
+	Revision  int64          `json:"revision"`
+
+	// Status One of the project's statuses
+	Status    string    `json:"status"`
+	Title     string    `json:"title"`
+	Todos     []Todo    `json:"todos"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CardRelation defines model for CardRelation.
+type CardRelation struct {
+	CardId string `json:"card_id"`
+	Type   string `json:"type"`
 }
 
 // CardSummary defines model for CardSummary.
 type CardSummary struct {
-	AcceptanceCriteriaCompletedCount int64     `json:"acceptance_criteria_completed_count"`
-	AcceptanceCriteriaCount          int64     `json:"acceptance_criteria_count"`
-	Branch                           string    `json:"branch"`
-	CommentsCount                    int64     `json:"comments_count"`
-	CreatedAt                        time.Time `json:"created_at"`
-	Deleted                          bool      `json:"deleted"`
-	HistoryCount                     int64     `json:"history_count"`
-	Id                               string    `json:"id"`
-	Number                           int64     `json:"number"`
-	Project                          string    `json:"project"`
-	Status                           string    `json:"status"`
-	Title                            string    `json:"title"`
-	TodosCompletedCount              int64     `json:"todos_completed_count"`
-	TodosCount                       int64     `json:"todos_count"`
-	UpdatedAt                        time.Time `json:"updated_at"`
+	AcceptanceCriteriaCompletedCount int64      `json:"acceptance_criteria_completed_count"`
+	AcceptanceCriteriaCount          int64      `json:"acceptance_criteria_count"`
+	Blocked                          bool       `json:"blocked"`
+	Branch                           string     `json:"branch"`
+	ChildrenDone                     int64      `json:"children_done"`
+	ChildrenTotal                    int64      `json:"children_total"`
+	CommentsCount                    int64      `json:"comments_count"`
+	CreatedAt                        time.Time  `json:"created_at"`
+	Deleted                          bool       `json:"deleted"`
+	DueAt                            *time.Time `json:"due_at,omitempty"`
+	HistoryCount                     int64      `json:"history_count"`
+	Id                               string     `json:"id"`
+	Labels                           []string   `json:"labels"`
+	MovedTo                          *string    `json:"moved_to,omitempty"`
+	Number                           int64      `json:"number"`
+	ParentId                         *string    `json:"parent_id,omitempty"`
+	Priority                         *string    `json:"priority,omitempty"`
+	Project                          string     `json:"project"`
+
+	// Rank Orders the card within its status; compare byte-wise
+	Rank     string `json:"rank"`
+	Revision int64  `json:"revision"`
+
+	// Status One of the project's statuses
+	Status              string    `json:"status"`
+	Title               string    `json:"title"`
+	TodosCompletedCount int64     `json:"todos_completed_count"`
+	TodosCount          int64     `json:"todos_count"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// CardTreeNode defines model for CardTreeNode.
+type CardTreeNode struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema                           *string        `json:"$schema,omitempty"`
+	AcceptanceCriteriaCompletedCount int64          `json:"acceptance_criteria_completed_count"`
+	AcceptanceCriteriaCount          int64          `json:"acceptance_criteria_count"`
+	Blocked                          bool           `json:"blocked"`
+	Branch                           string         `json:"branch"`
+	Children                         []CardTreeNode `json:"children"`
+	ChildrenDone                     int64          `json:"children_done"`
+	ChildrenTotal                    int64          `json:"children_total"`
+	CommentsCount                    int64          `json:"comments_count"`
+	CreatedAt                        time.Time      `json:"created_at"`
+	Deleted                          bool           `json:"deleted"`
+	DueAt                            *time.Time     `json:"due_at,omitempty"`
+	HistoryCount                     int64          `json:"history_count"`
+	Id                               string         `json:"id"`
+	Labels                           []string       `json:"labels"`
+	MovedTo                          *string        `json:"moved_to,omitempty"`
+	Number                           int64          `json:"number"`
+	ParentId                         *string        `json:"parent_id,omitempty"`
+	Priority                         *string        `json:"priority,omitempty"`
+	Project                          string         `json:"project"`
+
+	// Rank Orders the card within its status; compare byte-wise
+	Rank     string `json:"rank"`
+	Revision int64  `json:"revision"`
+
+	// Status One of the project's statuses
+	Status              string    `json:"status"`
+	Title               string    `json:"title"`
+	TodosCompletedCount int64     `json:"todos_completed_count"`
+	TodosCount          int64     `json:"todos_count"`
+	UpdatedAt           time.Time `json:"updated_at"`
 }
 
 // ClientConfigOutputBody defines model for ClientConfigOutputBody.
@@ -92,8 +226,12 @@ type CreateCardRequest struct {
 	Schema      *string `json:"$schema,omitempty"`
 	Branch      *string `json:"branch,omitempty"`
 	Description *string `json:"description,omitempty"`
-	Status      string  `json:"status"`
-	Title       string  `json:"title"`
+	Force       *bool   `json:"force,omitempty"`
+	ParentId    *string `json:"parent_id,omitempty"`
+
+	// Status One of the project's statuses
+	Status string `json:"status"`
+	Title  string `json:"title"`
 }
 
 // CreateProjectRequest defines model for CreateProjectRequest.
@@ -149,6 +287,14 @@ type ErrorModel struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// FormFile defines model for FormFile.
+type FormFile struct {
+	ContentType string `json:"ContentType"`
+	Filename    string `json:"Filename"`
+	IsSet       bool   `json:"IsSet"`
+	Size        int64  `json:"Size"`
+}
+
 // HealthOutputBody defines model for HealthOutputBody.
 type HealthOutputBody struct {
 	// Schema A URL to the JSON Schema for this object.
@@ -170,11 +316,21 @@ type ListAcceptanceCriteriaOutputBody struct {
 	AcceptanceCriteria []AcceptanceCriterion `json:"acceptance_criteria"`
 }
 
+// ListAttachmentsOutputBody defines model for ListAttachmentsOutputBody.
+type ListAttachmentsOutputBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema      *string      `json:"$schema,omitempty"`
+	Attachments []Attachment `json:"attachments"`
+}
+
 // ListCardsOutputBody defines model for ListCardsOutputBody.
 type ListCardsOutputBody struct {
 	// Schema A URL to the JSON Schema for this object.
 	Schema *string       `json:"$schema,omitempty"`
 	Cards  []CardSummary `json:"cards"`
+
+	// WipLimits The project's WIP limits by status
+	WipLimits *map[string]int64 `json:"wip_limits,omitempty"`
 }
 
 // ListProjectsOutputBody defines model for ListProjectsOutputBody.
@@ -191,11 +347,27 @@ type ListTodosOutputBody struct {
 	Todos  []Todo  `json:"todos"`
 }
 
+// ListTrashedProjectsOutputBody defines model for ListTrashedProjectsOutputBody.
+type ListTrashedProjectsOutputBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string          `json:"$schema,omitempty"`
+	Projects []TrashedProject `json:"projects"`
+}
+
 // MoveCardRequest defines model for MoveCardRequest.
 type MoveCardRequest struct {
 	// Schema A URL to the JSON Schema for this object.
 	Schema *string `json:"$schema,omitempty"`
-	Status string  `json:"status"`
+
+	// After Place the card directly after this card of the status
+	After *int64 `json:"after,omitempty"`
+
+	// Before Place the card directly before this card of the status
+	Before *int64 `json:"before,omitempty"`
+	Force  *bool  `json:"force,omitempty"`
+
+	// Status One of the project's statuses
+	Status string `json:"status"`
 }
 
 // Project defines model for Project.
@@ -208,7 +380,33 @@ type Project struct {
 	NextCardSeq int64     `json:"next_card_seq"`
 	RemoteUrl   *string   `json:"remote_url,omitempty"`
 	Slug        string    `json:"slug"`
-	UpdatedAt   time.Time `json:"updated_at"`
+
+	// Statuses Ordered workflow statuses; the first is where work starts and the last counts as done
+	Statuses []string `json:"statuses"`
+
+	// TransitionRules Conditions cards must meet to enter a status
+	TransitionRules *[]TransitionRule `json:"transition_rules,omitempty"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+
+	// WipLimits Maximum number of live cards per status; statuses without an entry are unlimited
+	WipLimits *map[string]int64 `json:"wip_limits,omitempty"`
+}
+
+// ProjectStatusesOutputBody defines model for ProjectStatusesOutputBody.
+type ProjectStatusesOutputBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Statuses Ordered workflow statuses; the first is where work starts and the last counts as done
+	Statuses []string `json:"statuses"`
+}
+
+// PurgeTrashedProjectOutputBody defines model for PurgeTrashedProjectOutputBody.
+type PurgeTrashedProjectOutputBody struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Id     string  `json:"id"`
+	Purged bool    `json:"purged"`
 }
 
 // RebuildProjectionOutputBody defines model for RebuildProjectionOutputBody.
@@ -226,6 +424,41 @@ type SetCardBranchRequest struct {
 	Branch string  `json:"branch"`
 }
 
+// SetCardDueRequest defines model for SetCardDueRequest.
+type SetCardDueRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	DueAt  string  `json:"due_at"`
+}
+
+// SetCardPriorityRequest defines model for SetCardPriorityRequest.
+type SetCardPriorityRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	Priority string  `json:"priority"`
+}
+
+// SetProjectStatusesRequest defines model for SetProjectStatusesRequest.
+type SetProjectStatusesRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// StatusMap Where cards in a dropped status go, keyed by the dropped status
+	StatusMap *map[string]string `json:"status_map,omitempty"`
+
+	// Statuses New ordered workflow statuses
+	Statuses []string `json:"statuses"`
+}
+
+// SetTransitionRulesRequest defines model for SetTransitionRulesRequest.
+type SetTransitionRulesRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+
+	// Rules Rules replacing the project's current ones; empty removes them all
+	Rules []TransitionRule `json:"rules"`
+}
+
 // TextBodyRequest defines model for TextBodyRequest.
 type TextBodyRequest struct {
 	// Schema A URL to the JSON Schema for this object.
@@ -248,42 +481,233 @@ type Todo struct {
 	Text      string  `json:"text"`
 }
 
+// TransferCardRequest defines model for TransferCardRequest.
+type TransferCardRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema        *string `json:"$schema,omitempty"`
+	TargetProject string  `json:"target_project"`
+}
+
+// TransitionRule defines model for TransitionRule.
+type TransitionRule struct {
+	// From Status the card leaves; empty for any status
+	From *string `json:"from,omitempty"`
+
+	// Requires Conditions the card must meet: acceptance_criteria_completed, todos_completed or branch
+	Requires []string `json:"requires"`
+
+	// To Status the card enters
+	To string `json:"to"`
+}
+
+// TrashedProject defines model for TrashedProject.
+type TrashedProject struct {
+	CardsCount int64     `json:"cards_count"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	Id         string    `json:"id"`
+	Name       string    `json:"name"`
+	Slug       string    `json:"slug"`
+}
+
 // UpdateAcceptanceCriterionRequest defines model for UpdateAcceptanceCriterionRequest.
 type UpdateAcceptanceCriterionRequest struct {
 	// Schema A URL to the JSON Schema for this object.
 	Schema    *string `json:"$schema,omitempty"`
-	Completed bool    `json:"completed"`
+	Completed *bool   `json:"completed,omitempty"`
+	Position  *int64  `json:"position,omitempty"`
+	Text      *string `json:"text,omitempty"`
+}
+
+// UpdateCardRequest defines model for UpdateCardRequest.
+type UpdateCardRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Branch *string `json:"branch,omitempty"`
+	Force  *bool   `json:"force,omitempty"`
+
+	// Status One of the project's statuses
+	Status *string `json:"status,omitempty"`
+	Title  *string `json:"title,omitempty"`
+}
+
+// UpdateProjectRequest defines model for UpdateProjectRequest.
+type UpdateProjectRequest struct {
+	// Schema A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	LocalPath *string `json:"local_path,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	RemoteUrl *string `json:"remote_url,omitempty"`
+
+	// WipLimits WIP limit per status to set; 0 removes a limit and unnamed statuses keep theirs
+	WipLimits *map[string]int64 `json:"wip_limits,omitempty"`
 }
 
 // UpdateTodoRequest defines model for UpdateTodoRequest.
 type UpdateTodoRequest struct {
 	// Schema A URL to the JSON Schema for this object.
 	Schema    *string `json:"$schema,omitempty"`
-	Completed bool    `json:"completed"`
+	Completed *bool   `json:"completed,omitempty"`
+	Position  *int64  `json:"position,omitempty"`
+	Text      *string `json:"text,omitempty"`
+}
+
+// WebsocketEvent defines model for WebsocketEvent.
+type WebsocketEvent struct {
+	CardId     *string            `json:"card_id,omitempty"`
+	CardNumber *int64             `json:"card_number,omitempty"`
+	Project    string             `json:"project"`
+	Timestamp  time.Time          `json:"timestamp"`
+	Type       WebsocketEventType `json:"type"`
 }
 
+// WebsocketEventType defines model for WebsocketEventType.
+type WebsocketEventType string
+
 // ListCardsParams defines parameters for ListCards.
 type ListCardsParams struct {
-	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+	IncludeDeleted *bool   `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+	Label          *string `form:"label,omitempty" json:"label,omitempty"`
+	Sort           *string `form:"sort,omitempty" json:"sort,omitempty"`
+	Overdue        *bool   `form:"overdue,omitempty" json:"overdue,omitempty"`
 }
 
 // DeleteCardParams defines parameters for DeleteCard.
 type DeleteCardParams struct {
-	Hard *bool `form:"hard,omitempty" json:"hard,omitempty"`
+	Hard    *bool   `form:"hard,omitempty" json:"hard,omitempty"`
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateCardParams defines parameters for UpdateCard.
+type UpdateCardParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AddAcceptanceCriterionParams defines parameters for AddAcceptanceCriterion.
+type AddAcceptanceCriterionParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// DeleteAcceptanceCriterionParams defines parameters for DeleteAcceptanceCriterion.
+type DeleteAcceptanceCriterionParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateAcceptanceCriterionParams defines parameters for UpdateAcceptanceCriterion.
+type UpdateAcceptanceCriterionParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UploadAttachmentMultipartBody defines parameters for UploadAttachment.
+type UploadAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentParams defines parameters for UploadAttachment.
+type UploadAttachmentParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// DeleteAttachmentParams defines parameters for DeleteAttachment.
+type DeleteAttachmentParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// SetCardBranchParams defines parameters for SetCardBranch.
+type SetCardBranchParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// CommentCardParams defines parameters for CommentCard.
+type CommentCardParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AppendDescriptionParams defines parameters for AppendDescription.
+type AppendDescriptionParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// SetCardDueParams defines parameters for SetCardDue.
+type SetCardDueParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AddCardLabelParams defines parameters for AddCardLabel.
+type AddCardLabelParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// RemoveCardLabelParams defines parameters for RemoveCardLabel.
+type RemoveCardLabelParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// MoveCardParams defines parameters for MoveCard.
+type MoveCardParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// SetCardPriorityParams defines parameters for SetCardPriority.
+type SetCardPriorityParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AddCardRelationParams defines parameters for AddCardRelation.
+type AddCardRelationParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// RemoveCardRelationParams defines parameters for RemoveCardRelation.
+type RemoveCardRelationParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// RestoreCardParams defines parameters for RestoreCard.
+type RestoreCardParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// AddTodoParams defines parameters for AddTodo.
+type AddTodoParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// DeleteTodoParams defines parameters for DeleteTodo.
+type DeleteTodoParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// TransferCardParams defines parameters for TransferCard.
+type TransferCardParams struct {
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = CreateProjectRequest
 
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProjectRequest
+
 // CreateCardJSONRequestBody defines body for CreateCard for application/json ContentType.
 type CreateCardJSONRequestBody = CreateCardRequest
 
+// UpdateCardJSONRequestBody defines body for UpdateCard for application/json ContentType.
+type UpdateCardJSONRequestBody = UpdateCardRequest
+
 // AddAcceptanceCriterionJSONRequestBody defines body for AddAcceptanceCriterion for application/json ContentType.
 type AddAcceptanceCriterionJSONRequestBody = AddAcceptanceCriterionRequest
 
 // UpdateAcceptanceCriterionJSONRequestBody defines body for UpdateAcceptanceCriterion for application/json ContentType.
 type UpdateAcceptanceCriterionJSONRequestBody = UpdateAcceptanceCriterionRequest
 
+// UploadAttachmentMultipartRequestBody defines body for UploadAttachment for multipart/form-data ContentType.
+type UploadAttachmentMultipartRequestBody UploadAttachmentMultipartBody
+
 // SetCardBranchJSONRequestBody defines body for SetCardBranch for application/json ContentType.
 type SetCardBranchJSONRequestBody = SetCardBranchRequest
 
@@ -293,15 +717,36 @@ type CommentCardJSONRequestBody = TextBodyRequest
 // AppendDescriptionJSONRequestBody defines body for AppendDescription for application/json ContentType.
 type AppendDescriptionJSONRequestBody = TextBodyRequest
 
+// SetCardDueJSONRequestBody defines body for SetCardDue for application/json ContentType.
+type SetCardDueJSONRequestBody = SetCardDueRequest
+
+// AddCardLabelJSONRequestBody defines body for AddCardLabel for application/json ContentType.
+type AddCardLabelJSONRequestBody = AddCardLabelRequest
+
 // MoveCardJSONRequestBody defines body for MoveCard for application/json ContentType.
 type MoveCardJSONRequestBody = MoveCardRequest
 
+// SetCardPriorityJSONRequestBody defines body for SetCardPriority for application/json ContentType.
+type SetCardPriorityJSONRequestBody = SetCardPriorityRequest
+
+// AddCardRelationJSONRequestBody defines body for AddCardRelation for application/json ContentType.
+type AddCardRelationJSONRequestBody = AddCardRelationRequest
+
 // AddTodoJSONRequestBody defines body for AddTodo for application/json ContentType.
 type AddTodoJSONRequestBody = AddTodoRequest
 
 // UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
 type UpdateTodoJSONRequestBody = UpdateTodoRequest
 
+// TransferCardJSONRequestBody defines body for TransferCard for application/json ContentType.
+type TransferCardJSONRequestBody = TransferCardRequest
+
+// SetProjectStatusesJSONRequestBody defines body for SetProjectStatuses for application/json ContentType.
+type SetProjectStatusesJSONRequestBody = SetProjectStatusesRequest
+
+// SetProjectTransitionRulesJSONRequestBody defines body for SetProjectTransitionRules for application/json ContentType.
+type SetProjectTransitionRulesJSONRequestBody = SetTransitionRulesRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -395,6 +840,11 @@ type ClientInterface interface {
 	// DeleteProject request
 	DeleteProject(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateProjectWithBody request with any body
+	UpdateProjectWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProject(ctx context.Context, project string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCards request
 	ListCards(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -409,57 +859,133 @@ type ClientInterface interface {
 	// GetCard request
 	GetCard(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateCardWithBody request with any body
+	UpdateCardWithBody(ctx context.Context, project string, number int64, params *UpdateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCard(ctx context.Context, project string, number int64, params *UpdateCardParams, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListAcceptanceCriteria request
 	ListAcceptanceCriteria(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AddAcceptanceCriterionWithBody request with any body
-	AddAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	AddAcceptanceCriterion(ctx context.Context, project string, number int64, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddAcceptanceCriterion(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteAcceptanceCriterion request
-	DeleteAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, params *DeleteAcceptanceCriterionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateAcceptanceCriterionWithBody request with any body
-	UpdateAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, criterionId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ListAttachments request
+	ListAttachments(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadAttachmentWithBody request with any body
+	UploadAttachmentWithBody(ctx context.Context, project string, number int64, params *UploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAttachment request
+	DeleteAttachment(ctx context.Context, project string, number int64, filename string, params *DeleteAttachmentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadAttachment request
+	DownloadAttachment(ctx context.Context, project string, number int64, filename string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SetCardBranchWithBody request with any body
-	SetCardBranchWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SetCardBranchWithBody(ctx context.Context, project string, number int64, params *SetCardBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	SetCardBranch(ctx context.Context, project string, number int64, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SetCardBranch(ctx context.Context, project string, number int64, params *SetCardBranchParams, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CommentCardWithBody request with any body
-	CommentCardWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CommentCardWithBody(ctx context.Context, project string, number int64, params *CommentCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CommentCard(ctx context.Context, project string, number int64, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CommentCard(ctx context.Context, project string, number int64, params *CommentCardParams, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AppendDescriptionWithBody request with any body
-	AppendDescriptionWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AppendDescriptionWithBody(ctx context.Context, project string, number int64, params *AppendDescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AppendDescription(ctx context.Context, project string, number int64, params *AppendDescriptionParams, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	AppendDescription(ctx context.Context, project string, number int64, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// SetCardDueWithBody request with any body
+	SetCardDueWithBody(ctx context.Context, project string, number int64, params *SetCardDueParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetCardDue(ctx context.Context, project string, number int64, params *SetCardDueParams, body SetCardDueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddCardLabelWithBody request with any body
+	AddCardLabelWithBody(ctx context.Context, project string, number int64, params *AddCardLabelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddCardLabel(ctx context.Context, project string, number int64, params *AddCardLabelParams, body AddCardLabelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveCardLabel request
+	RemoveCardLabel(ctx context.Context, project string, number int64, label string, params *RemoveCardLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MoveCardWithBody request with any body
-	MoveCardWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	MoveCardWithBody(ctx context.Context, project string, number int64, params *MoveCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveCard(ctx context.Context, project string, number int64, params *MoveCardParams, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetCardPriorityWithBody request with any body
+	SetCardPriorityWithBody(ctx context.Context, project string, number int64, params *SetCardPriorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetCardPriority(ctx context.Context, project string, number int64, params *SetCardPriorityParams, body SetCardPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddCardRelationWithBody request with any body
+	AddCardRelationWithBody(ctx context.Context, project string, number int64, params *AddCardRelationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	MoveCard(ctx context.Context, project string, number int64, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddCardRelation(ctx context.Context, project string, number int64, params *AddCardRelationParams, body AddCardRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveCardRelation request
+	RemoveCardRelation(ctx context.Context, project string, number int64, pType string, targetProject string, targetNumber int64, params *RemoveCardRelationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreCard request
+	RestoreCard(ctx context.Context, project string, number int64, params *RestoreCardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListTodos request
 	ListTodos(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AddTodoWithBody request with any body
-	AddTodoWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddTodoWithBody(ctx context.Context, project string, number int64, params *AddTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	AddTodo(ctx context.Context, project string, number int64, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	AddTodo(ctx context.Context, project string, number int64, params *AddTodoParams, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteTodo request
-	DeleteTodo(ctx context.Context, project string, number int64, todoId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteTodo(ctx context.Context, project string, number int64, todoId int64, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateTodoWithBody request with any body
-	UpdateTodoWithBody(ctx context.Context, project string, number int64, todoId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTodoWithBody(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodo(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferCardWithBody request with any body
+	TransferCardWithBody(ctx context.Context, project string, number int64, params *TransferCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferCard(ctx context.Context, project string, number int64, params *TransferCardParams, body TransferCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCardTree request
+	GetCardTree(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectStatuses request
+	GetProjectStatuses(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProjectStatusesWithBody request with any body
+	SetProjectStatusesWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateTodo(ctx context.Context, project string, number int64, todoId int64, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SetProjectStatuses(ctx context.Context, project string, body SetProjectStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetProjectTransitionRulesWithBody request with any body
+	SetProjectTransitionRulesWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetProjectTransitionRules(ctx context.Context, project string, body SetProjectTransitionRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTrashedProjects request
+	ListTrashedProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeTrashedProject request
+	PurgeTrashedProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTrashedProject request
+	RestoreTrashedProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// WebsocketEvents request
 	WebsocketEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -549,6 +1075,30 @@ func (c *Client) DeleteProject(ctx context.Context, project string, reqEditors .
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateProjectWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequestWithBody(c.Server, project, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProject(ctx context.Context, project string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequest(c.Server, project, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListCards(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListCardsRequest(c.Server, project, params)
 	if err != nil {
@@ -609,8 +1159,8 @@ func (c *Client) GetCard(ctx context.Context, project string, number int64, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListAcceptanceCriteria(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListAcceptanceCriteriaRequest(c.Server, project, number)
+func (c *Client) UpdateCardWithBody(ctx context.Context, project string, number int64, params *UpdateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCardRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -621,8 +1171,8 @@ func (c *Client) ListAcceptanceCriteria(ctx context.Context, project string, num
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddAcceptanceCriterionRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) UpdateCard(ctx context.Context, project string, number int64, params *UpdateCardParams, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCardRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -633,8 +1183,8 @@ func (c *Client) AddAcceptanceCriterionWithBody(ctx context.Context, project str
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddAcceptanceCriterion(ctx context.Context, project string, number int64, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddAcceptanceCriterionRequest(c.Server, project, number, body)
+func (c *Client) ListAcceptanceCriteria(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAcceptanceCriteriaRequest(c.Server, project, number)
 	if err != nil {
 		return nil, err
 	}
@@ -645,8 +1195,8 @@ func (c *Client) AddAcceptanceCriterion(ctx context.Context, project string, num
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAcceptanceCriterionRequest(c.Server, project, number, criterionId)
+func (c *Client) AddAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAcceptanceCriterionRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -657,8 +1207,8 @@ func (c *Client) DeleteAcceptanceCriterion(ctx context.Context, project string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, criterionId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAcceptanceCriterionRequestWithBody(c.Server, project, number, criterionId, contentType, body)
+func (c *Client) AddAcceptanceCriterion(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAcceptanceCriterionRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -669,8 +1219,8 @@ func (c *Client) UpdateAcceptanceCriterionWithBody(ctx context.Context, project
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAcceptanceCriterionRequest(c.Server, project, number, criterionId, body)
+func (c *Client) DeleteAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, params *DeleteAcceptanceCriterionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAcceptanceCriterionRequest(c.Server, project, number, criterionId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -681,8 +1231,8 @@ func (c *Client) UpdateAcceptanceCriterion(ctx context.Context, project string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetCardBranchWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetCardBranchRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) UpdateAcceptanceCriterionWithBody(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAcceptanceCriterionRequestWithBody(c.Server, project, number, criterionId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -693,8 +1243,8 @@ func (c *Client) SetCardBranchWithBody(ctx context.Context, project string, numb
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetCardBranch(ctx context.Context, project string, number int64, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetCardBranchRequest(c.Server, project, number, body)
+func (c *Client) UpdateAcceptanceCriterion(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAcceptanceCriterionRequest(c.Server, project, number, criterionId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -705,8 +1255,8 @@ func (c *Client) SetCardBranch(ctx context.Context, project string, number int64
 	return c.Client.Do(req)
 }
 
-func (c *Client) CommentCardWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommentCardRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) ListAttachments(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAttachmentsRequest(c.Server, project, number)
 	if err != nil {
 		return nil, err
 	}
@@ -717,8 +1267,8 @@ func (c *Client) CommentCardWithBody(ctx context.Context, project string, number
 	return c.Client.Do(req)
 }
 
-func (c *Client) CommentCard(ctx context.Context, project string, number int64, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommentCardRequest(c.Server, project, number, body)
+func (c *Client) UploadAttachmentWithBody(ctx context.Context, project string, number int64, params *UploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadAttachmentRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -729,8 +1279,8 @@ func (c *Client) CommentCard(ctx context.Context, project string, number int64,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AppendDescriptionWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAppendDescriptionRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) DeleteAttachment(ctx context.Context, project string, number int64, filename string, params *DeleteAttachmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAttachmentRequest(c.Server, project, number, filename, params)
 	if err != nil {
 		return nil, err
 	}
@@ -741,8 +1291,8 @@ func (c *Client) AppendDescriptionWithBody(ctx context.Context, project string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AppendDescription(ctx context.Context, project string, number int64, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAppendDescriptionRequest(c.Server, project, number, body)
+func (c *Client) DownloadAttachment(ctx context.Context, project string, number int64, filename string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadAttachmentRequest(c.Server, project, number, filename)
 	if err != nil {
 		return nil, err
 	}
@@ -753,8 +1303,8 @@ func (c *Client) AppendDescription(ctx context.Context, project string, number i
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveCardWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveCardRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) SetCardBranchWithBody(ctx context.Context, project string, number int64, params *SetCardBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardBranchRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -765,8 +1315,8 @@ func (c *Client) MoveCardWithBody(ctx context.Context, project string, number in
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveCard(ctx context.Context, project string, number int64, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveCardRequest(c.Server, project, number, body)
+func (c *Client) SetCardBranch(ctx context.Context, project string, number int64, params *SetCardBranchParams, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardBranchRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -777,8 +1327,8 @@ func (c *Client) MoveCard(ctx context.Context, project string, number int64, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTodos(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTodosRequest(c.Server, project, number)
+func (c *Client) CommentCardWithBody(ctx context.Context, project string, number int64, params *CommentCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommentCardRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -789,8 +1339,8 @@ func (c *Client) ListTodos(ctx context.Context, project string, number int64, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTodoWithBody(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTodoRequestWithBody(c.Server, project, number, contentType, body)
+func (c *Client) CommentCard(ctx context.Context, project string, number int64, params *CommentCardParams, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommentCardRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -801,8 +1351,8 @@ func (c *Client) AddTodoWithBody(ctx context.Context, project string, number int
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTodo(ctx context.Context, project string, number int64, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTodoRequest(c.Server, project, number, body)
+func (c *Client) AppendDescriptionWithBody(ctx context.Context, project string, number int64, params *AppendDescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppendDescriptionRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -813,8 +1363,8 @@ func (c *Client) AddTodo(ctx context.Context, project string, number int64, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTodo(ctx context.Context, project string, number int64, todoId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequest(c.Server, project, number, todoId)
+func (c *Client) AppendDescription(ctx context.Context, project string, number int64, params *AppendDescriptionParams, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppendDescriptionRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -825,8 +1375,8 @@ func (c *Client) DeleteTodo(ctx context.Context, project string, number int64, t
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodoWithBody(ctx context.Context, project string, number int64, todoId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequestWithBody(c.Server, project, number, todoId, contentType, body)
+func (c *Client) SetCardDueWithBody(ctx context.Context, project string, number int64, params *SetCardDueParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardDueRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -837,8 +1387,8 @@ func (c *Client) UpdateTodoWithBody(ctx context.Context, project string, number
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodo(ctx context.Context, project string, number int64, todoId int64, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequest(c.Server, project, number, todoId, body)
+func (c *Client) SetCardDue(ctx context.Context, project string, number int64, params *SetCardDueParams, body SetCardDueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardDueRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -849,8 +1399,8 @@ func (c *Client) UpdateTodo(ctx context.Context, project string, number int64, t
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebsocketEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebsocketEventsRequest(c.Server)
+func (c *Client) AddCardLabelWithBody(ctx context.Context, project string, number int64, params *AddCardLabelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddCardLabelRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -861,378 +1411,379 @@ func (c *Client) WebsocketEvents(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-// NewRebuildProjectionRequest generates requests for RebuildProjection
-func NewRebuildProjectionRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AddCardLabel(ctx context.Context, project string, number int64, params *AddCardLabelParams, body AddCardLabelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddCardLabelRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/admin/rebuild")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveCardLabel(ctx context.Context, project string, number int64, label string, params *RemoveCardLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveCardLabelRequest(c.Server, project, number, label, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClientConfigRequest generates requests for GetClientConfig
-func NewGetClientConfigRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) MoveCardWithBody(ctx context.Context, project string, number int64, params *MoveCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveCardRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/client-config")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) MoveCard(ctx context.Context, project string, number int64, params *MoveCardParams, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveCardRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetCardPriorityWithBody(ctx context.Context, project string, number int64, params *SetCardPriorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardPriorityRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetCardPriority(ctx context.Context, project string, number int64, params *SetCardPriorityParams, body SetCardPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCardPriorityRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListProjectsRequest generates requests for ListProjects
-func NewListProjectsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AddCardRelationWithBody(ctx context.Context, project string, number int64, params *AddCardRelationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddCardRelationRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddCardRelation(ctx context.Context, project string, number int64, params *AddCardRelationParams, body AddCardRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddCardRelationRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
-func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RemoveCardRelation(ctx context.Context, project string, number int64, pType string, targetProject string, targetNumber int64, params *RemoveCardRelationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveCardRelationRequest(c.Server, project, number, pType, targetProject, targetNumber, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
-func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RestoreCard(ctx context.Context, project string, number int64, params *RestoreCardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreCardRequest(c.Server, project, number, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListTodos(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodosRequest(c.Server, project, number)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteProjectRequest generates requests for DeleteProject
-func NewDeleteProjectRequest(server string, project string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+func (c *Client) AddTodoWithBody(ctx context.Context, project string, number int64, params *AddTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTodoRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddTodo(ctx context.Context, project string, number int64, params *AddTodoParams, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTodoRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTodo(ctx context.Context, project string, number int64, todoId int64, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequest(c.Server, project, number, todoId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListCardsRequest generates requests for ListCards
-func NewListCardsRequest(server string, project string, params *ListCardsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+func (c *Client) UpdateTodoWithBody(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, project, number, todoId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTodo(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, project, number, todoId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects/%s/cards", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TransferCardWithBody(ctx context.Context, project string, number int64, params *TransferCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferCardRequestWithBody(c.Server, project, number, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.IncludeDeleted != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) TransferCard(ctx context.Context, project string, number int64, params *TransferCardParams, body TransferCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferCardRequest(c.Server, project, number, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCardRequest calls the generic CreateCard builder with application/json body
-func NewCreateCardRequest(server string, project string, body CreateCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetCardTree(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardTreeRequest(c.Server, project, number)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCardRequestWithBody(server, project, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
-func NewCreateCardRequestWithBody(server string, project string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+func (c *Client) GetProjectStatuses(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectStatusesRequest(c.Server, project)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetProjectStatusesWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProjectStatusesRequestWithBody(c.Server, project, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects/%s/cards", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetProjectStatuses(ctx context.Context, project string, body SetProjectStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProjectStatusesRequest(c.Server, project, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) SetProjectTransitionRulesWithBody(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProjectTransitionRulesRequestWithBody(c.Server, project, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteCardRequest generates requests for DeleteCard
-func NewDeleteCardRequest(server string, project string, number int64, params *DeleteCardParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+func (c *Client) SetProjectTransitionRules(ctx context.Context, project string, body SetProjectTransitionRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetProjectTransitionRulesRequest(c.Server, project, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+func (c *Client) ListTrashedProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTrashedProjectsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PurgeTrashedProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeTrashedProjectRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RestoreTrashedProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTrashedProjectRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Hard != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "hard", runtime.ParamLocationQuery, *params.Hard); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) WebsocketEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebsocketEventsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetCardRequest generates requests for GetCard
-func NewGetCardRequest(server string, project string, number int64) (*http.Request, error) {
+// NewRebuildProjectionRequest generates requests for RebuildProjection
+func NewRebuildProjectionRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/admin/rebuild")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewGetClientConfigRequest generates requests for GetClientConfig
+func NewGetClientConfigRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/client-config")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1250,30 +1801,43 @@ func NewGetCardRequest(server string, project string, number int64) (*http.Reque
 	return req, nil
 }
 
-// NewListAcceptanceCriteriaRequest generates requests for ListAcceptanceCriteria
-func NewListAcceptanceCriteriaRequest(server string, project string, number int64) (*http.Request, error) {
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1291,41 +1855,27 @@ func NewListAcceptanceCriteriaRequest(server string, project string, number int6
 	return req, nil
 }
 
-// NewAddAcceptanceCriterionRequest calls the generic AddAcceptanceCriterion builder with application/json body
-func NewAddAcceptanceCriterionRequest(server string, project string, number int64, body AddAcceptanceCriterionJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddAcceptanceCriterionRequestWithBody(server, project, number, "application/json", bodyReader)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAddAcceptanceCriterionRequestWithBody generates requests for AddAcceptanceCriterion with any type of body
-func NewAddAcceptanceCriterionRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1345,8 +1895,8 @@ func NewAddAcceptanceCriterionRequestWithBody(server string, project string, num
 	return req, nil
 }
 
-// NewDeleteAcceptanceCriterionRequest generates requests for DeleteAcceptanceCriterion
-func NewDeleteAcceptanceCriterionRequest(server string, project string, number int64, criterionId int64) (*http.Request, error) {
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, project string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1356,26 +1906,12 @@ func NewDeleteAcceptanceCriterionRequest(server string, project string, number i
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "criterion_id", runtime.ParamLocationPath, criterionId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1393,19 +1929,19 @@ func NewDeleteAcceptanceCriterionRequest(server string, project string, number i
 	return req, nil
 }
 
-// NewUpdateAcceptanceCriterionRequest calls the generic UpdateAcceptanceCriterion builder with application/json body
-func NewUpdateAcceptanceCriterionRequest(server string, project string, number int64, criterionId int64, body UpdateAcceptanceCriterionJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, project string, body UpdateProjectJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAcceptanceCriterionRequestWithBody(server, project, number, criterionId, "application/json", bodyReader)
+	return NewUpdateProjectRequestWithBody(server, project, "application/json", bodyReader)
 }
 
-// NewUpdateAcceptanceCriterionRequestWithBody generates requests for UpdateAcceptanceCriterion with any type of body
-func NewUpdateAcceptanceCriterionRequestWithBody(server string, project string, number int64, criterionId int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
+func NewUpdateProjectRequestWithBody(server string, project string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1415,26 +1951,12 @@ func NewUpdateAcceptanceCriterionRequestWithBody(server string, project string,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "criterion_id", runtime.ParamLocationPath, criterionId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1454,19 +1976,8 @@ func NewUpdateAcceptanceCriterionRequestWithBody(server string, project string,
 	return req, nil
 }
 
-// NewSetCardBranchRequest calls the generic SetCardBranch builder with application/json body
-func NewSetCardBranchRequest(server string, project string, number int64, body SetCardBranchJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetCardBranchRequestWithBody(server, project, number, "application/json", bodyReader)
-}
-
-// NewSetCardBranchRequestWithBody generates requests for SetCardBranch with any type of body
-func NewSetCardBranchRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewListCardsRequest generates requests for ListCards
+func NewListCardsRequest(server string, project string, params *ListCardsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1476,19 +1987,12 @@ func NewSetCardBranchRequestWithBody(server string, project string, number int64
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/branch", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1498,29 +2002,97 @@ func NewSetCardBranchRequestWithBody(server string, project string, number int64
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Label != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "label", runtime.ParamLocationQuery, *params.Label); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCommentCardRequest calls the generic CommentCard builder with application/json body
-func NewCommentCardRequest(server string, project string, number int64, body CommentCardJSONRequestBody) (*http.Request, error) {
+// NewCreateCardRequest calls the generic CreateCard builder with application/json body
+func NewCreateCardRequest(server string, project string, body CreateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCommentCardRequestWithBody(server, project, number, "application/json", bodyReader)
+	return NewCreateCardRequestWithBody(server, project, "application/json", bodyReader)
 }
 
-// NewCommentCardRequestWithBody generates requests for CommentCard with any type of body
-func NewCommentCardRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
+func NewCreateCardRequestWithBody(server string, project string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1530,19 +2102,12 @@ func NewCommentCardRequestWithBody(server string, project string, number int64,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/comments", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1562,19 +2127,86 @@ func NewCommentCardRequestWithBody(server string, project string, number int64,
 	return req, nil
 }
 
-// NewAppendDescriptionRequest calls the generic AppendDescription builder with application/json body
-func NewAppendDescriptionRequest(server string, project string, number int64, body AppendDescriptionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteCardRequest generates requests for DeleteCard
+func NewDeleteCardRequest(server string, project string, number int64, params *DeleteCardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAppendDescriptionRequestWithBody(server, project, number, "application/json", bodyReader)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Hard != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "hard", runtime.ParamLocationQuery, *params.Hard); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// NewAppendDescriptionRequestWithBody generates requests for AppendDescription with any type of body
-func NewAppendDescriptionRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetCardRequest generates requests for GetCard
+func NewGetCardRequest(server string, project string, number int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1596,7 +2228,7 @@ func NewAppendDescriptionRequestWithBody(server string, project string, number i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/description", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1606,29 +2238,27 @@ func NewAppendDescriptionRequestWithBody(server string, project string, number i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewMoveCardRequest calls the generic MoveCard builder with application/json body
-func NewMoveCardRequest(server string, project string, number int64, body MoveCardJSONRequestBody) (*http.Request, error) {
+// NewUpdateCardRequest calls the generic UpdateCard builder with application/json body
+func NewUpdateCardRequest(server string, project string, number int64, params *UpdateCardParams, body UpdateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewMoveCardRequestWithBody(server, project, number, "application/json", bodyReader)
+	return NewUpdateCardRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// NewMoveCardRequestWithBody generates requests for MoveCard with any type of body
-func NewMoveCardRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCardRequestWithBody generates requests for UpdateCard with any type of body
+func NewUpdateCardRequestWithBody(server string, project string, number int64, params *UpdateCardParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1650,7 +2280,7 @@ func NewMoveCardRequestWithBody(server string, project string, number int64, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/move", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1667,11 +2297,26 @@ func NewMoveCardRequestWithBody(server string, project string, number int64, con
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewListTodosRequest generates requests for ListTodos
-func NewListTodosRequest(server string, project string, number int64) (*http.Request, error) {
+// NewListAcceptanceCriteriaRequest generates requests for ListAcceptanceCriteria
+func NewListAcceptanceCriteriaRequest(server string, project string, number int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1693,7 +2338,7 @@ func NewListTodosRequest(server string, project string, number int64) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1711,19 +2356,19 @@ func NewListTodosRequest(server string, project string, number int64) (*http.Req
 	return req, nil
 }
 
-// NewAddTodoRequest calls the generic AddTodo builder with application/json body
-func NewAddTodoRequest(server string, project string, number int64, body AddTodoJSONRequestBody) (*http.Request, error) {
+// NewAddAcceptanceCriterionRequest calls the generic AddAcceptanceCriterion builder with application/json body
+func NewAddAcceptanceCriterionRequest(server string, project string, number int64, params *AddAcceptanceCriterionParams, body AddAcceptanceCriterionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddTodoRequestWithBody(server, project, number, "application/json", bodyReader)
+	return NewAddAcceptanceCriterionRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// NewAddTodoRequestWithBody generates requests for AddTodo with any type of body
-func NewAddTodoRequestWithBody(server string, project string, number int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddAcceptanceCriterionRequestWithBody generates requests for AddAcceptanceCriterion with any type of body
+func NewAddAcceptanceCriterionRequestWithBody(server string, project string, number int64, params *AddAcceptanceCriterionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1745,7 +2390,7 @@ func NewAddTodoRequestWithBody(server string, project string, number int64, cont
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1762,11 +2407,26 @@ func NewAddTodoRequestWithBody(server string, project string, number int64, cont
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewDeleteTodoRequest generates requests for DeleteTodo
-func NewDeleteTodoRequest(server string, project string, number int64, todoId int64) (*http.Request, error) {
+// NewDeleteAcceptanceCriterionRequest generates requests for DeleteAcceptanceCriterion
+func NewDeleteAcceptanceCriterionRequest(server string, project string, number int64, criterionId int64, params *DeleteAcceptanceCriterionParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1785,7 +2445,7 @@ func NewDeleteTodoRequest(server string, project string, number int64, todoId in
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "criterion_id", runtime.ParamLocationPath, criterionId)
 	if err != nil {
 		return nil, err
 	}
@@ -1795,7 +2455,7 @@ func NewDeleteTodoRequest(server string, project string, number int64, todoId in
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1810,22 +2470,37 @@ func NewDeleteTodoRequest(server string, project string, number int64, todoId in
 		return nil, err
 	}
 
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
-func NewUpdateTodoRequest(server string, project string, number int64, todoId int64, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+// NewUpdateAcceptanceCriterionRequest calls the generic UpdateAcceptanceCriterion builder with application/json body
+func NewUpdateAcceptanceCriterionRequest(server string, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, body UpdateAcceptanceCriterionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTodoRequestWithBody(server, project, number, todoId, "application/json", bodyReader)
+	return NewUpdateAcceptanceCriterionRequestWithBody(server, project, number, criterionId, params, "application/json", bodyReader)
 }
 
-// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
-func NewUpdateTodoRequestWithBody(server string, project string, number int64, todoId int64, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAcceptanceCriterionRequestWithBody generates requests for UpdateAcceptanceCriterion with any type of body
+func NewUpdateAcceptanceCriterionRequestWithBody(server string, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1844,7 +2519,7 @@ func NewUpdateTodoRequestWithBody(server string, project string, number int64, t
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "criterion_id", runtime.ParamLocationPath, criterionId)
 	if err != nil {
 		return nil, err
 	}
@@ -1854,7 +2529,7 @@ func NewUpdateTodoRequestWithBody(server string, project string, number int64, t
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/acceptance/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1871,19 +2546,48 @@ func NewUpdateTodoRequestWithBody(server string, project string, number int64, t
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewWebsocketEventsRequest generates requests for WebsocketEvents
-func NewWebsocketEventsRequest(server string) (*http.Request, error) {
+// NewListAttachmentsRequest generates requests for ListAttachments
+func NewListAttachmentsRequest(server string, project string, number int64) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ws")
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/attachments", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1901,1024 +2605,4590 @@ func NewWebsocketEventsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewUploadAttachmentRequestWithBody generates requests for UploadAttachment with any type of body
+func NewUploadAttachmentRequestWithBody(server string, project string, number int64, params *UploadAttachmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam1 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// RebuildProjectionWithResponse request
-	RebuildProjectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RebuildProjectionResponse, error)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetClientConfigWithResponse request
-	GetClientConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientConfigResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListProjectsWithResponse request
-	ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// CreateProjectWithBodyWithResponse request with any body
-	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+	if params != nil {
 
-	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-	// DeleteProjectWithResponse request
-	DeleteProjectWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	// ListCardsWithResponse request
-	ListCardsWithResponse(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*ListCardsResponse, error)
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-	// CreateCardWithBodyWithResponse request with any body
-	CreateCardWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+	}
 
-	CreateCardWithResponse(ctx context.Context, project string, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+	return req, nil
+}
 
-	// DeleteCardWithResponse request
-	DeleteCardWithResponse(ctx context.Context, project string, number int64, params *DeleteCardParams, reqEditors ...RequestEditorFn) (*DeleteCardResponse, error)
+// NewDeleteAttachmentRequest generates requests for DeleteAttachment
+func NewDeleteAttachmentRequest(server string, project string, number int64, filename string, params *DeleteAttachmentParams) (*http.Request, error) {
+	var err error
 
-	// GetCardWithResponse request
-	GetCardWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
+	var pathParam0 string
 
-	// ListAcceptanceCriteriaWithResponse request
-	ListAcceptanceCriteriaWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAcceptanceCriteriaResponse, error)
-
-	// AddAcceptanceCriterionWithBodyWithResponse request with any body
-	AddAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	AddAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error)
+	var pathParam1 string
 
-	// DeleteAcceptanceCriterionWithResponse request
-	DeleteAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, reqEditors ...RequestEditorFn) (*DeleteAcceptanceCriterionResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAcceptanceCriterionWithBodyWithResponse request with any body
-	UpdateAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, criterionId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error)
+	var pathParam2 string
 
-	UpdateAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "filename", runtime.ParamLocationPath, filename)
+	if err != nil {
+		return nil, err
+	}
 
-	// SetCardBranchWithBodyWithResponse request with any body
-	SetCardBranchWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	SetCardBranchWithResponse(ctx context.Context, project string, number int64, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CommentCardWithBodyWithResponse request with any body
-	CommentCardWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommentCardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CommentCardWithResponse(ctx context.Context, project string, number int64, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CommentCardResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AppendDescriptionWithBodyWithResponse request with any body
-	AppendDescriptionWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error)
+	if params != nil {
 
-	AppendDescriptionWithResponse(ctx context.Context, project string, number int64, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error)
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-	// MoveCardWithBodyWithResponse request with any body
-	MoveCardWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveCardResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	MoveCardWithResponse(ctx context.Context, project string, number int64, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveCardResponse, error)
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-	// ListTodosWithResponse request
-	ListTodosWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+	}
 
-	// AddTodoWithBodyWithResponse request with any body
-	AddTodoWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoResponse, error)
+	return req, nil
+}
 
-	AddTodoWithResponse(ctx context.Context, project string, number int64, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoResponse, error)
+// NewDownloadAttachmentRequest generates requests for DownloadAttachment
+func NewDownloadAttachmentRequest(server string, project string, number int64, filename string) (*http.Request, error) {
+	var err error
 
-	// DeleteTodoWithResponse request
-	DeleteTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+	var pathParam0 string
 
-	// UpdateTodoWithBodyWithResponse request with any body
-	UpdateTodoWithBodyWithResponse(ctx context.Context, project string, number int64, todoId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	var pathParam1 string
 
-	// WebsocketEventsWithResponse request
-	WebsocketEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WebsocketEventsResponse, error)
-}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
 
-type RebuildProjectionResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *RebuildProjectionOutputBody
-	ApplicationproblemJSON500 *ErrorModel
-}
+	var pathParam2 string
 
-// Status returns HTTPResponse.Status
-func (r RebuildProjectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "filename", runtime.ParamLocationPath, filename)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RebuildProjectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetClientConfigResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *ClientConfigOutputBody
-	ApplicationproblemJSONDefault *ErrorModel
-}
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetClientConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *HealthOutputBody
-	ApplicationproblemJSONDefault *ErrorModel
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSetCardBranchRequest calls the generic SetCardBranch builder with application/json body
+func NewSetCardBranchRequest(server string, project string, number int64, params *SetCardBranchParams, body SetCardBranchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCardBranchRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSetCardBranchRequestWithBody generates requests for SetCardBranch with any type of body
+func NewSetCardBranchRequestWithBody(server string, project string, number int64, params *SetCardBranchParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListProjectsResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *ListProjectsOutputBody
-	ApplicationproblemJSON500 *ErrorModel
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateProjectResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON201                   *Project
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON409 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/branch", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteProjectResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *DeleteProjectOutputBody
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-type ListCardsResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *ListCardsOutputBody
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-// Status returns HTTPResponse.Status
-func (r ListCardsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCardsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCommentCardRequest calls the generic CommentCard builder with application/json body
+func NewCommentCardRequest(server string, project string, number int64, params *CommentCardParams, body CommentCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCommentCardRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-type CreateCardResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON201                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+// NewCommentCardRequestWithBody generates requests for CommentCard with any type of body
+func NewCommentCardRequestWithBody(server string, project string, number int64, params *CommentCardParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCardResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCardResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/comments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListAcceptanceCriteriaResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *ListAcceptanceCriteriaOutputBody
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListAcceptanceCriteriaResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAcceptanceCriteriaResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type AddAcceptanceCriterionResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON201                   *AcceptanceCriterion
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddAcceptanceCriterionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAppendDescriptionRequest calls the generic AppendDescription builder with application/json body
+func NewAppendDescriptionRequest(server string, project string, number int64, params *AppendDescriptionParams, body AppendDescriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewAppendDescriptionRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddAcceptanceCriterionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewAppendDescriptionRequestWithBody generates requests for AppendDescription with any type of body
+func NewAppendDescriptionRequestWithBody(server string, project string, number int64, params *AppendDescriptionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAcceptanceCriterionResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *AcceptanceCriterion
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteAcceptanceCriterionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAcceptanceCriterionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateAcceptanceCriterionResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *AcceptanceCriterion
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/description", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateAcceptanceCriterionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAcceptanceCriterionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SetCardBranchResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r SetCardBranchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetCardBranchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type CommentCardResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CommentCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSetCardDueRequest calls the generic SetCardDue builder with application/json body
+func NewSetCardDueRequest(server string, project string, number int64, params *SetCardDueParams, body SetCardDueJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCardDueRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CommentCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type AppendDescriptionResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+// NewSetCardDueRequestWithBody generates requests for SetCardDue with any type of body
+func NewSetCardDueRequestWithBody(server string, project string, number int64, params *SetCardDueParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r AppendDescriptionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AppendDescriptionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type MoveCardResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Card
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r MoveCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MoveCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListTodosResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *ListTodosOutputBody
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/due", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddTodoResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON201                   *Todo
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r AddTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		if params.IfMatch != nil {
+			var headerParam0 string
 
-type DeleteTodoResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Todo
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
-}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
 
-// Status returns HTTPResponse.Status
-func (r DeleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			req.Header.Set("If-Match", headerParam0)
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateTodoResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *Todo
-	ApplicationproblemJSON400 *ErrorModel
-	ApplicationproblemJSON404 *ErrorModel
-	ApplicationproblemJSON422 *ErrorModel
-	ApplicationproblemJSON500 *ErrorModel
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewAddCardLabelRequest calls the generic AddCardLabel builder with application/json body
+func NewAddCardLabelRequest(server string, project string, number int64, params *AddCardLabelParams, body AddCardLabelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewAddCardLabelRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewAddCardLabelRequestWithBody generates requests for AddCardLabel with any type of body
+func NewAddCardLabelRequestWithBody(server string, project string, number int64, params *AddCardLabelParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type WebsocketEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r WebsocketEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebsocketEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam1 string
 
-// RebuildProjectionWithResponse request returning *RebuildProjectionResponse
-func (c *ClientWithResponses) RebuildProjectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RebuildProjectionResponse, error) {
-	rsp, err := c.RebuildProjection(ctx, reqEditors...)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return ParseRebuildProjectionResponse(rsp)
-}
 
-// GetClientConfigWithResponse request returning *GetClientConfigResponse
-func (c *ClientWithResponses) GetClientConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientConfigResponse, error) {
-	rsp, err := c.GetClientConfig(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetClientConfigResponse(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/labels", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// ListProjectsWithResponse request returning *ListProjectsResponse
-func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
-	rsp, err := c.ListProjects(ctx, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectsResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
-func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+// NewRemoveCardLabelRequest generates requests for RemoveCardLabel
+func NewRemoveCardLabelRequest(server string, project string, number int64, label string, params *RemoveCardLabelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectResponse(rsp)
-}
 
-// DeleteProjectWithResponse request returning *DeleteProjectResponse
-func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
-	rsp, err := c.DeleteProject(ctx, project, reqEditors...)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "label", runtime.ParamLocationPath, label)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectResponse(rsp)
-}
 
-// ListCardsWithResponse request returning *ListCardsResponse
-func (c *ClientWithResponses) ListCardsWithResponse(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*ListCardsResponse, error) {
-	rsp, err := c.ListCards(ctx, project, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCardsResponse(rsp)
-}
 
-// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
-func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCardWithBody(ctx, project, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/labels/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, project string, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCard(ctx, project, body, reqEditors...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCardResponse(rsp)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// DeleteCardWithResponse request returning *DeleteCardResponse
-func (c *ClientWithResponses) DeleteCardWithResponse(ctx context.Context, project string, number int64, params *DeleteCardParams, reqEditors ...RequestEditorFn) (*DeleteCardResponse, error) {
-	rsp, err := c.DeleteCard(ctx, project, number, params, reqEditors...)
+// NewMoveCardRequest calls the generic MoveCard builder with application/json body
+func NewMoveCardRequest(server string, project string, number int64, params *MoveCardParams, body MoveCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCardResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveCardRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// GetCardWithResponse request returning *GetCardResponse
-func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
-	rsp, err := c.GetCard(ctx, project, number, reqEditors...)
+// NewMoveCardRequestWithBody generates requests for MoveCard with any type of body
+func NewMoveCardRequestWithBody(server string, project string, number int64, params *MoveCardParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCardResponse(rsp)
-}
 
-// ListAcceptanceCriteriaWithResponse request returning *ListAcceptanceCriteriaResponse
-func (c *ClientWithResponses) ListAcceptanceCriteriaWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAcceptanceCriteriaResponse, error) {
-	rsp, err := c.ListAcceptanceCriteria(ctx, project, number, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListAcceptanceCriteriaResponse(rsp)
-}
 
-// AddAcceptanceCriterionWithBodyWithResponse request with arbitrary body returning *AddAcceptanceCriterionResponse
-func (c *ClientWithResponses) AddAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error) {
-	rsp, err := c.AddAcceptanceCriterionWithBody(ctx, project, number, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddAcceptanceCriterionResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error) {
-	rsp, err := c.AddAcceptanceCriterion(ctx, project, number, body, reqEditors...)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/move", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddAcceptanceCriterionResponse(rsp)
-}
 
-// DeleteAcceptanceCriterionWithResponse request returning *DeleteAcceptanceCriterionResponse
-func (c *ClientWithResponses) DeleteAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, reqEditors ...RequestEditorFn) (*DeleteAcceptanceCriterionResponse, error) {
-	rsp, err := c.DeleteAcceptanceCriterion(ctx, project, number, criterionId, reqEditors...)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAcceptanceCriterionResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// UpdateAcceptanceCriterionWithBodyWithResponse request with arbitrary body returning *UpdateAcceptanceCriterionResponse
-func (c *ClientWithResponses) UpdateAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, criterionId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error) {
-	rsp, err := c.UpdateAcceptanceCriterionWithBody(ctx, project, number, criterionId, contentType, body, reqEditors...)
+// NewSetCardPriorityRequest calls the generic SetCardPriority builder with application/json body
+func NewSetCardPriorityRequest(server string, project string, number int64, params *SetCardPriorityParams, body SetCardPriorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAcceptanceCriterionResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCardPriorityRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error) {
-	rsp, err := c.UpdateAcceptanceCriterion(ctx, project, number, criterionId, body, reqEditors...)
+// NewSetCardPriorityRequestWithBody generates requests for SetCardPriority with any type of body
+func NewSetCardPriorityRequestWithBody(server string, project string, number int64, params *SetCardPriorityParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAcceptanceCriterionResponse(rsp)
-}
 
-// SetCardBranchWithBodyWithResponse request with arbitrary body returning *SetCardBranchResponse
-func (c *ClientWithResponses) SetCardBranchWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error) {
-	rsp, err := c.SetCardBranchWithBody(ctx, project, number, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetCardBranchResponse(rsp)
-}
 
-func (c *ClientWithResponses) SetCardBranchWithResponse(ctx context.Context, project string, number int64, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error) {
-	rsp, err := c.SetCardBranch(ctx, project, number, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetCardBranchResponse(rsp)
-}
 
-// CommentCardWithBodyWithResponse request with arbitrary body returning *CommentCardResponse
-func (c *ClientWithResponses) CommentCardWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommentCardResponse, error) {
-	rsp, err := c.CommentCardWithBody(ctx, project, number, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/priority", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommentCardResponse(rsp)
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CommentCardWithResponse(ctx context.Context, project string, number int64, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CommentCardResponse, error) {
-	rsp, err := c.CommentCard(ctx, project, number, body, reqEditors...)
+// NewAddCardRelationRequest calls the generic AddCardRelation builder with application/json body
+func NewAddCardRelationRequest(server string, project string, number int64, params *AddCardRelationParams, body AddCardRelationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommentCardResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewAddCardRelationRequestWithBody(server, project, number, params, "application/json", bodyReader)
 }
 
-// AppendDescriptionWithBodyWithResponse request with arbitrary body returning *AppendDescriptionResponse
-func (c *ClientWithResponses) AppendDescriptionWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error) {
-	rsp, err := c.AppendDescriptionWithBody(ctx, project, number, contentType, body, reqEditors...)
+// NewAddCardRelationRequestWithBody generates requests for AddCardRelation with any type of body
+func NewAddCardRelationRequestWithBody(server string, project string, number int64, params *AddCardRelationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAppendDescriptionResponse(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/relations", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) AppendDescriptionWithResponse(ctx context.Context, project string, number int64, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error) {
-	rsp, err := c.AppendDescription(ctx, project, number, body, reqEditors...)
+// NewRemoveCardRelationRequest generates requests for RemoveCardRelation
+func NewRemoveCardRelationRequest(server string, project string, number int64, pType string, targetProject string, targetNumber int64, params *RemoveCardRelationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAppendDescriptionResponse(rsp)
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "type", runtime.ParamLocationPath, pType)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "target_project", runtime.ParamLocationPath, targetProject)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam4 string
+
+	pathParam4, err = runtime.StyleParamWithLocation("simple", false, "target_number", runtime.ParamLocationPath, targetNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/relations/%s/%s/%s", pathParam0, pathParam1, pathParam2, pathParam3, pathParam4)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// MoveCardWithBodyWithResponse request with arbitrary body returning *MoveCardResponse
-func (c *ClientWithResponses) MoveCardWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveCardResponse, error) {
-	rsp, err := c.MoveCardWithBody(ctx, project, number, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewRestoreCardRequest generates requests for RestoreCard
+func NewRestoreCardRequest(server string, project string, number int64, params *RestoreCardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListTodosRequest generates requests for ListTodos
+func NewListTodosRequest(server string, project string, number int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTodoRequest calls the generic AddTodo builder with application/json body
+func NewAddTodoRequest(server string, project string, number int64, params *AddTodoParams, body AddTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTodoRequestWithBody(server, project, number, params, "application/json", bodyReader)
+}
+
+// NewAddTodoRequestWithBody generates requests for AddTodo with any type of body
+func NewAddTodoRequestWithBody(server string, project string, number int64, params *AddTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteTodoRequest generates requests for DeleteTodo
+func NewDeleteTodoRequest(server string, project string, number int64, todoId int64, params *DeleteTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, project string, number int64, todoId int64, params *UpdateTodoParams, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, project, number, todoId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, project string, number int64, todoId int64, params *UpdateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/todos/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewTransferCardRequest calls the generic TransferCard builder with application/json body
+func NewTransferCardRequest(server string, project string, number int64, params *TransferCardParams, body TransferCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransferCardRequestWithBody(server, project, number, params, "application/json", bodyReader)
+}
+
+// NewTransferCardRequestWithBody generates requests for TransferCard with any type of body
+func NewTransferCardRequestWithBody(server string, project string, number int64, params *TransferCardParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/transfer", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCardTreeRequest generates requests for GetCardTree
+func NewGetCardTreeRequest(server string, project string, number int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "number", runtime.ParamLocationPath, number)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/cards/%s/tree", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectStatusesRequest generates requests for GetProjectStatuses
+func NewGetProjectStatusesRequest(server string, project string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/statuses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetProjectStatusesRequest calls the generic SetProjectStatuses builder with application/json body
+func NewSetProjectStatusesRequest(server string, project string, body SetProjectStatusesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProjectStatusesRequestWithBody(server, project, "application/json", bodyReader)
+}
+
+// NewSetProjectStatusesRequestWithBody generates requests for SetProjectStatuses with any type of body
+func NewSetProjectStatusesRequestWithBody(server string, project string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/statuses", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetProjectTransitionRulesRequest calls the generic SetProjectTransitionRules builder with application/json body
+func NewSetProjectTransitionRulesRequest(server string, project string, body SetProjectTransitionRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetProjectTransitionRulesRequestWithBody(server, project, "application/json", bodyReader)
+}
+
+// NewSetProjectTransitionRulesRequestWithBody generates requests for SetProjectTransitionRules with any type of body
+func NewSetProjectTransitionRulesRequestWithBody(server string, project string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project", runtime.ParamLocationPath, project)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/transition-rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTrashedProjectsRequest generates requests for ListTrashedProjects
+func NewListTrashedProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPurgeTrashedProjectRequest generates requests for PurgeTrashedProject
+func NewPurgeTrashedProjectRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTrashedProjectRequest generates requests for RestoreTrashedProject
+func NewRestoreTrashedProjectRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/projects/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebsocketEventsRequest generates requests for WebsocketEvents
+func NewWebsocketEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ws")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// RebuildProjectionWithResponse request
+	RebuildProjectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RebuildProjectionResponse, error)
+
+	// GetClientConfigWithResponse request
+	GetClientConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientConfigResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
+	// UpdateProjectWithBodyWithResponse request with any body
+	UpdateProjectWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	UpdateProjectWithResponse(ctx context.Context, project string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	// ListCardsWithResponse request
+	ListCardsWithResponse(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*ListCardsResponse, error)
+
+	// CreateCardWithBodyWithResponse request with any body
+	CreateCardWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	CreateCardWithResponse(ctx context.Context, project string, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	// DeleteCardWithResponse request
+	DeleteCardWithResponse(ctx context.Context, project string, number int64, params *DeleteCardParams, reqEditors ...RequestEditorFn) (*DeleteCardResponse, error)
+
+	// GetCardWithResponse request
+	GetCardWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
+
+	// UpdateCardWithBodyWithResponse request with any body
+	UpdateCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *UpdateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	UpdateCardWithResponse(ctx context.Context, project string, number int64, params *UpdateCardParams, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	// ListAcceptanceCriteriaWithResponse request
+	ListAcceptanceCriteriaWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAcceptanceCriteriaResponse, error)
+
+	// AddAcceptanceCriterionWithBodyWithResponse request with any body
+	AddAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error)
+
+	AddAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error)
+
+	// DeleteAcceptanceCriterionWithResponse request
+	DeleteAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *DeleteAcceptanceCriterionParams, reqEditors ...RequestEditorFn) (*DeleteAcceptanceCriterionResponse, error)
+
+	// UpdateAcceptanceCriterionWithBodyWithResponse request with any body
+	UpdateAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error)
+
+	UpdateAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error)
+
+	// ListAttachmentsWithResponse request
+	ListAttachmentsWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAttachmentsResponse, error)
+
+	// UploadAttachmentWithBodyWithResponse request with any body
+	UploadAttachmentWithBodyWithResponse(ctx context.Context, project string, number int64, params *UploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAttachmentResponse, error)
+
+	// DeleteAttachmentWithResponse request
+	DeleteAttachmentWithResponse(ctx context.Context, project string, number int64, filename string, params *DeleteAttachmentParams, reqEditors ...RequestEditorFn) (*DeleteAttachmentResponse, error)
+
+	// DownloadAttachmentWithResponse request
+	DownloadAttachmentWithResponse(ctx context.Context, project string, number int64, filename string, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error)
+
+	// SetCardBranchWithBodyWithResponse request with any body
+	SetCardBranchWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error)
+
+	SetCardBranchWithResponse(ctx context.Context, project string, number int64, params *SetCardBranchParams, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error)
+
+	// CommentCardWithBodyWithResponse request with any body
+	CommentCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *CommentCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommentCardResponse, error)
+
+	CommentCardWithResponse(ctx context.Context, project string, number int64, params *CommentCardParams, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CommentCardResponse, error)
+
+	// AppendDescriptionWithBodyWithResponse request with any body
+	AppendDescriptionWithBodyWithResponse(ctx context.Context, project string, number int64, params *AppendDescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error)
+
+	AppendDescriptionWithResponse(ctx context.Context, project string, number int64, params *AppendDescriptionParams, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error)
+
+	// SetCardDueWithBodyWithResponse request with any body
+	SetCardDueWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardDueParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardDueResponse, error)
+
+	SetCardDueWithResponse(ctx context.Context, project string, number int64, params *SetCardDueParams, body SetCardDueJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardDueResponse, error)
+
+	// AddCardLabelWithBodyWithResponse request with any body
+	AddCardLabelWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddCardLabelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCardLabelResponse, error)
+
+	AddCardLabelWithResponse(ctx context.Context, project string, number int64, params *AddCardLabelParams, body AddCardLabelJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCardLabelResponse, error)
+
+	// RemoveCardLabelWithResponse request
+	RemoveCardLabelWithResponse(ctx context.Context, project string, number int64, label string, params *RemoveCardLabelParams, reqEditors ...RequestEditorFn) (*RemoveCardLabelResponse, error)
+
+	// MoveCardWithBodyWithResponse request with any body
+	MoveCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *MoveCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveCardResponse, error)
+
+	MoveCardWithResponse(ctx context.Context, project string, number int64, params *MoveCardParams, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveCardResponse, error)
+
+	// SetCardPriorityWithBodyWithResponse request with any body
+	SetCardPriorityWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardPriorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardPriorityResponse, error)
+
+	SetCardPriorityWithResponse(ctx context.Context, project string, number int64, params *SetCardPriorityParams, body SetCardPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardPriorityResponse, error)
+
+	// AddCardRelationWithBodyWithResponse request with any body
+	AddCardRelationWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddCardRelationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCardRelationResponse, error)
+
+	AddCardRelationWithResponse(ctx context.Context, project string, number int64, params *AddCardRelationParams, body AddCardRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCardRelationResponse, error)
+
+	// RemoveCardRelationWithResponse request
+	RemoveCardRelationWithResponse(ctx context.Context, project string, number int64, pType string, targetProject string, targetNumber int64, params *RemoveCardRelationParams, reqEditors ...RequestEditorFn) (*RemoveCardRelationResponse, error)
+
+	// RestoreCardWithResponse request
+	RestoreCardWithResponse(ctx context.Context, project string, number int64, params *RestoreCardParams, reqEditors ...RequestEditorFn) (*RestoreCardResponse, error)
+
+	// ListTodosWithResponse request
+	ListTodosWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+
+	// AddTodoWithBodyWithResponse request with any body
+	AddTodoWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoResponse, error)
+
+	AddTodoWithResponse(ctx context.Context, project string, number int64, params *AddTodoParams, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoResponse, error)
+
+	// DeleteTodoWithResponse request
+	DeleteTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// TransferCardWithBodyWithResponse request with any body
+	TransferCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *TransferCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferCardResponse, error)
+
+	TransferCardWithResponse(ctx context.Context, project string, number int64, params *TransferCardParams, body TransferCardJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferCardResponse, error)
+
+	// GetCardTreeWithResponse request
+	GetCardTreeWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardTreeResponse, error)
+
+	// GetProjectStatusesWithResponse request
+	GetProjectStatusesWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*GetProjectStatusesResponse, error)
+
+	// SetProjectStatusesWithBodyWithResponse request with any body
+	SetProjectStatusesWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProjectStatusesResponse, error)
+
+	SetProjectStatusesWithResponse(ctx context.Context, project string, body SetProjectStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProjectStatusesResponse, error)
+
+	// SetProjectTransitionRulesWithBodyWithResponse request with any body
+	SetProjectTransitionRulesWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProjectTransitionRulesResponse, error)
+
+	SetProjectTransitionRulesWithResponse(ctx context.Context, project string, body SetProjectTransitionRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProjectTransitionRulesResponse, error)
+
+	// ListTrashedProjectsWithResponse request
+	ListTrashedProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrashedProjectsResponse, error)
+
+	// PurgeTrashedProjectWithResponse request
+	PurgeTrashedProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PurgeTrashedProjectResponse, error)
+
+	// RestoreTrashedProjectWithResponse request
+	RestoreTrashedProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreTrashedProjectResponse, error)
+
+	// WebsocketEventsWithResponse request
+	WebsocketEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WebsocketEventsResponse, error)
+}
+
+type RebuildProjectionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RebuildProjectionOutputBody
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r RebuildProjectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RebuildProjectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientConfigResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ClientConfigOutputBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *HealthOutputBody
+	ApplicationproblemJSONDefault *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListProjectsOutputBody
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Project
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON409 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DeleteProjectOutputBody
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Project
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCardsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListCardsOutputBody
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON409 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	JSON308                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON409 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAcceptanceCriteriaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListAcceptanceCriteriaOutputBody
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAcceptanceCriteriaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAcceptanceCriteriaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAcceptanceCriterionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *AcceptanceCriterion
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAcceptanceCriterionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAcceptanceCriterionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAcceptanceCriterionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AcceptanceCriterion
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAcceptanceCriterionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAcceptanceCriterionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAcceptanceCriterionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AcceptanceCriterion
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAcceptanceCriterionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAcceptanceCriterionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListAttachmentsOutputBody
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadAttachmentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Attachment
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON413 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Attachment
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadAttachmentResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCardBranchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCardBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCardBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommentCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r CommentCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommentCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppendDescriptionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r AppendDescriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppendDescriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCardDueResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCardDueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCardDueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddCardLabelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r AddCardLabelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddCardLabelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveCardLabelResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveCardLabelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveCardLabelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON409 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCardPriorityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCardPriorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCardPriorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddCardRelationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r AddCardRelationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddCardRelationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveCardRelationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveCardRelationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveCardRelationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodosResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListTodosOutputBody
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Todo
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Todo
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Todo
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferCardResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Card
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	JSON412                   *Card
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardTreeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CardTreeNode
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectStatusesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ProjectStatusesOutputBody
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProjectStatusesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Project
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProjectStatusesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProjectStatusesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetProjectTransitionRulesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Project
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r SetProjectTransitionRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetProjectTransitionRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTrashedProjectsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ListTrashedProjectsOutputBody
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTrashedProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTrashedProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeTrashedProjectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PurgeTrashedProjectOutputBody
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeTrashedProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeTrashedProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTrashedProjectResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Project
+	ApplicationproblemJSON400 *ErrorModel
+	ApplicationproblemJSON404 *ErrorModel
+	ApplicationproblemJSON409 *ErrorModel
+	ApplicationproblemJSON422 *ErrorModel
+	ApplicationproblemJSON500 *ErrorModel
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTrashedProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTrashedProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebsocketEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON101      *WebsocketEvent
+	JSON200      *WebsocketEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r WebsocketEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebsocketEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// RebuildProjectionWithResponse request returning *RebuildProjectionResponse
+func (c *ClientWithResponses) RebuildProjectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RebuildProjectionResponse, error) {
+	rsp, err := c.RebuildProjection(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRebuildProjectionResponse(rsp)
+}
+
+// GetClientConfigWithResponse request returning *GetClientConfigResponse
+func (c *ClientWithResponses) GetClientConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientConfigResponse, error) {
+	rsp, err := c.GetClientConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientConfigResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResponse
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, project, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
+// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResponse
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, project string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProject(ctx, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+// ListCardsWithResponse request returning *ListCardsResponse
+func (c *ClientWithResponses) ListCardsWithResponse(ctx context.Context, project string, params *ListCardsParams, reqEditors ...RequestEditorFn) (*ListCardsResponse, error) {
+	rsp, err := c.ListCards(ctx, project, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCardsResponse(rsp)
+}
+
+// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
+func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCardWithBody(ctx, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, project string, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCard(ctx, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+// DeleteCardWithResponse request returning *DeleteCardResponse
+func (c *ClientWithResponses) DeleteCardWithResponse(ctx context.Context, project string, number int64, params *DeleteCardParams, reqEditors ...RequestEditorFn) (*DeleteCardResponse, error) {
+	rsp, err := c.DeleteCard(ctx, project, number, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCardResponse(rsp)
+}
+
+// GetCardWithResponse request returning *GetCardResponse
+func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
+	rsp, err := c.GetCard(ctx, project, number, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardResponse(rsp)
+}
+
+// UpdateCardWithBodyWithResponse request with arbitrary body returning *UpdateCardResponse
+func (c *ClientWithResponses) UpdateCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *UpdateCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCardWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCardWithResponse(ctx context.Context, project string, number int64, params *UpdateCardParams, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCard(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+// ListAcceptanceCriteriaWithResponse request returning *ListAcceptanceCriteriaResponse
+func (c *ClientWithResponses) ListAcceptanceCriteriaWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAcceptanceCriteriaResponse, error) {
+	rsp, err := c.ListAcceptanceCriteria(ctx, project, number, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAcceptanceCriteriaResponse(rsp)
+}
+
+// AddAcceptanceCriterionWithBodyWithResponse request with arbitrary body returning *AddAcceptanceCriterionResponse
+func (c *ClientWithResponses) AddAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error) {
+	rsp, err := c.AddAcceptanceCriterionWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAcceptanceCriterionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, params *AddAcceptanceCriterionParams, body AddAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAcceptanceCriterionResponse, error) {
+	rsp, err := c.AddAcceptanceCriterion(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAcceptanceCriterionResponse(rsp)
+}
+
+// DeleteAcceptanceCriterionWithResponse request returning *DeleteAcceptanceCriterionResponse
+func (c *ClientWithResponses) DeleteAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *DeleteAcceptanceCriterionParams, reqEditors ...RequestEditorFn) (*DeleteAcceptanceCriterionResponse, error) {
+	rsp, err := c.DeleteAcceptanceCriterion(ctx, project, number, criterionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAcceptanceCriterionResponse(rsp)
+}
+
+// UpdateAcceptanceCriterionWithBodyWithResponse request with arbitrary body returning *UpdateAcceptanceCriterionResponse
+func (c *ClientWithResponses) UpdateAcceptanceCriterionWithBodyWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error) {
+	rsp, err := c.UpdateAcceptanceCriterionWithBody(ctx, project, number, criterionId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAcceptanceCriterionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAcceptanceCriterionWithResponse(ctx context.Context, project string, number int64, criterionId int64, params *UpdateAcceptanceCriterionParams, body UpdateAcceptanceCriterionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAcceptanceCriterionResponse, error) {
+	rsp, err := c.UpdateAcceptanceCriterion(ctx, project, number, criterionId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAcceptanceCriterionResponse(rsp)
+}
+
+// ListAttachmentsWithResponse request returning *ListAttachmentsResponse
+func (c *ClientWithResponses) ListAttachmentsWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListAttachmentsResponse, error) {
+	rsp, err := c.ListAttachments(ctx, project, number, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAttachmentsResponse(rsp)
+}
+
+// UploadAttachmentWithBodyWithResponse request with arbitrary body returning *UploadAttachmentResponse
+func (c *ClientWithResponses) UploadAttachmentWithBodyWithResponse(ctx context.Context, project string, number int64, params *UploadAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAttachmentResponse, error) {
+	rsp, err := c.UploadAttachmentWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadAttachmentResponse(rsp)
+}
+
+// DeleteAttachmentWithResponse request returning *DeleteAttachmentResponse
+func (c *ClientWithResponses) DeleteAttachmentWithResponse(ctx context.Context, project string, number int64, filename string, params *DeleteAttachmentParams, reqEditors ...RequestEditorFn) (*DeleteAttachmentResponse, error) {
+	rsp, err := c.DeleteAttachment(ctx, project, number, filename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAttachmentResponse(rsp)
+}
+
+// DownloadAttachmentWithResponse request returning *DownloadAttachmentResponse
+func (c *ClientWithResponses) DownloadAttachmentWithResponse(ctx context.Context, project string, number int64, filename string, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error) {
+	rsp, err := c.DownloadAttachment(ctx, project, number, filename, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadAttachmentResponse(rsp)
+}
+
+// SetCardBranchWithBodyWithResponse request with arbitrary body returning *SetCardBranchResponse
+func (c *ClientWithResponses) SetCardBranchWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardBranchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error) {
+	rsp, err := c.SetCardBranchWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardBranchResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetCardBranchWithResponse(ctx context.Context, project string, number int64, params *SetCardBranchParams, body SetCardBranchJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardBranchResponse, error) {
+	rsp, err := c.SetCardBranch(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardBranchResponse(rsp)
+}
+
+// CommentCardWithBodyWithResponse request with arbitrary body returning *CommentCardResponse
+func (c *ClientWithResponses) CommentCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *CommentCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommentCardResponse, error) {
+	rsp, err := c.CommentCardWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommentCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CommentCardWithResponse(ctx context.Context, project string, number int64, params *CommentCardParams, body CommentCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CommentCardResponse, error) {
+	rsp, err := c.CommentCard(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommentCardResponse(rsp)
+}
+
+// AppendDescriptionWithBodyWithResponse request with arbitrary body returning *AppendDescriptionResponse
+func (c *ClientWithResponses) AppendDescriptionWithBodyWithResponse(ctx context.Context, project string, number int64, params *AppendDescriptionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error) {
+	rsp, err := c.AppendDescriptionWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppendDescriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AppendDescriptionWithResponse(ctx context.Context, project string, number int64, params *AppendDescriptionParams, body AppendDescriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*AppendDescriptionResponse, error) {
+	rsp, err := c.AppendDescription(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppendDescriptionResponse(rsp)
+}
+
+// SetCardDueWithBodyWithResponse request with arbitrary body returning *SetCardDueResponse
+func (c *ClientWithResponses) SetCardDueWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardDueParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardDueResponse, error) {
+	rsp, err := c.SetCardDueWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardDueResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetCardDueWithResponse(ctx context.Context, project string, number int64, params *SetCardDueParams, body SetCardDueJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardDueResponse, error) {
+	rsp, err := c.SetCardDue(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardDueResponse(rsp)
+}
+
+// AddCardLabelWithBodyWithResponse request with arbitrary body returning *AddCardLabelResponse
+func (c *ClientWithResponses) AddCardLabelWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddCardLabelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCardLabelResponse, error) {
+	rsp, err := c.AddCardLabelWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCardLabelResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddCardLabelWithResponse(ctx context.Context, project string, number int64, params *AddCardLabelParams, body AddCardLabelJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCardLabelResponse, error) {
+	rsp, err := c.AddCardLabel(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCardLabelResponse(rsp)
+}
+
+// RemoveCardLabelWithResponse request returning *RemoveCardLabelResponse
+func (c *ClientWithResponses) RemoveCardLabelWithResponse(ctx context.Context, project string, number int64, label string, params *RemoveCardLabelParams, reqEditors ...RequestEditorFn) (*RemoveCardLabelResponse, error) {
+	rsp, err := c.RemoveCardLabel(ctx, project, number, label, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveCardLabelResponse(rsp)
+}
+
+// MoveCardWithBodyWithResponse request with arbitrary body returning *MoveCardResponse
+func (c *ClientWithResponses) MoveCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *MoveCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveCardResponse, error) {
+	rsp, err := c.MoveCardWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveCardWithResponse(ctx context.Context, project string, number int64, params *MoveCardParams, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveCardResponse, error) {
+	rsp, err := c.MoveCard(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveCardResponse(rsp)
+}
+
+// SetCardPriorityWithBodyWithResponse request with arbitrary body returning *SetCardPriorityResponse
+func (c *ClientWithResponses) SetCardPriorityWithBodyWithResponse(ctx context.Context, project string, number int64, params *SetCardPriorityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCardPriorityResponse, error) {
+	rsp, err := c.SetCardPriorityWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardPriorityResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetCardPriorityWithResponse(ctx context.Context, project string, number int64, params *SetCardPriorityParams, body SetCardPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCardPriorityResponse, error) {
+	rsp, err := c.SetCardPriority(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCardPriorityResponse(rsp)
+}
+
+// AddCardRelationWithBodyWithResponse request with arbitrary body returning *AddCardRelationResponse
+func (c *ClientWithResponses) AddCardRelationWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddCardRelationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddCardRelationResponse, error) {
+	rsp, err := c.AddCardRelationWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCardRelationResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddCardRelationWithResponse(ctx context.Context, project string, number int64, params *AddCardRelationParams, body AddCardRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*AddCardRelationResponse, error) {
+	rsp, err := c.AddCardRelation(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddCardRelationResponse(rsp)
+}
+
+// RemoveCardRelationWithResponse request returning *RemoveCardRelationResponse
+func (c *ClientWithResponses) RemoveCardRelationWithResponse(ctx context.Context, project string, number int64, pType string, targetProject string, targetNumber int64, params *RemoveCardRelationParams, reqEditors ...RequestEditorFn) (*RemoveCardRelationResponse, error) {
+	rsp, err := c.RemoveCardRelation(ctx, project, number, pType, targetProject, targetNumber, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveCardRelationResponse(rsp)
+}
+
+// RestoreCardWithResponse request returning *RestoreCardResponse
+func (c *ClientWithResponses) RestoreCardWithResponse(ctx context.Context, project string, number int64, params *RestoreCardParams, reqEditors ...RequestEditorFn) (*RestoreCardResponse, error) {
+	rsp, err := c.RestoreCard(ctx, project, number, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreCardResponse(rsp)
+}
+
+// ListTodosWithResponse request returning *ListTodosResponse
+func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
+	rsp, err := c.ListTodos(ctx, project, number, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodosResponse(rsp)
+}
+
+// AddTodoWithBodyWithResponse request with arbitrary body returning *AddTodoResponse
+func (c *ClientWithResponses) AddTodoWithBodyWithResponse(ctx context.Context, project string, number int64, params *AddTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoResponse, error) {
+	rsp, err := c.AddTodoWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTodoWithResponse(ctx context.Context, project string, number int64, params *AddTodoParams, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoResponse, error) {
+	rsp, err := c.AddTodo(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTodoResponse(rsp)
+}
+
+// DeleteTodoWithResponse request returning *DeleteTodoResponse
+func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, params *DeleteTodoParams, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodo(ctx, project, number, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, project, number, todoId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, project, number, todoId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// TransferCardWithBodyWithResponse request with arbitrary body returning *TransferCardResponse
+func (c *ClientWithResponses) TransferCardWithBodyWithResponse(ctx context.Context, project string, number int64, params *TransferCardParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferCardResponse, error) {
+	rsp, err := c.TransferCardWithBody(ctx, project, number, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferCardWithResponse(ctx context.Context, project string, number int64, params *TransferCardParams, body TransferCardJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferCardResponse, error) {
+	rsp, err := c.TransferCard(ctx, project, number, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferCardResponse(rsp)
+}
+
+// GetCardTreeWithResponse request returning *GetCardTreeResponse
+func (c *ClientWithResponses) GetCardTreeWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*GetCardTreeResponse, error) {
+	rsp, err := c.GetCardTree(ctx, project, number, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardTreeResponse(rsp)
+}
+
+// GetProjectStatusesWithResponse request returning *GetProjectStatusesResponse
+func (c *ClientWithResponses) GetProjectStatusesWithResponse(ctx context.Context, project string, reqEditors ...RequestEditorFn) (*GetProjectStatusesResponse, error) {
+	rsp, err := c.GetProjectStatuses(ctx, project, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectStatusesResponse(rsp)
+}
+
+// SetProjectStatusesWithBodyWithResponse request with arbitrary body returning *SetProjectStatusesResponse
+func (c *ClientWithResponses) SetProjectStatusesWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProjectStatusesResponse, error) {
+	rsp, err := c.SetProjectStatusesWithBody(ctx, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProjectStatusesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProjectStatusesWithResponse(ctx context.Context, project string, body SetProjectStatusesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProjectStatusesResponse, error) {
+	rsp, err := c.SetProjectStatuses(ctx, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProjectStatusesResponse(rsp)
+}
+
+// SetProjectTransitionRulesWithBodyWithResponse request with arbitrary body returning *SetProjectTransitionRulesResponse
+func (c *ClientWithResponses) SetProjectTransitionRulesWithBodyWithResponse(ctx context.Context, project string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetProjectTransitionRulesResponse, error) {
+	rsp, err := c.SetProjectTransitionRulesWithBody(ctx, project, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProjectTransitionRulesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetProjectTransitionRulesWithResponse(ctx context.Context, project string, body SetProjectTransitionRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetProjectTransitionRulesResponse, error) {
+	rsp, err := c.SetProjectTransitionRules(ctx, project, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetProjectTransitionRulesResponse(rsp)
+}
+
+// ListTrashedProjectsWithResponse request returning *ListTrashedProjectsResponse
+func (c *ClientWithResponses) ListTrashedProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrashedProjectsResponse, error) {
+	rsp, err := c.ListTrashedProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTrashedProjectsResponse(rsp)
+}
+
+// PurgeTrashedProjectWithResponse request returning *PurgeTrashedProjectResponse
+func (c *ClientWithResponses) PurgeTrashedProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PurgeTrashedProjectResponse, error) {
+	rsp, err := c.PurgeTrashedProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeTrashedProjectResponse(rsp)
+}
+
+// RestoreTrashedProjectWithResponse request returning *RestoreTrashedProjectResponse
+func (c *ClientWithResponses) RestoreTrashedProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RestoreTrashedProjectResponse, error) {
+	rsp, err := c.RestoreTrashedProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTrashedProjectResponse(rsp)
+}
+
+// WebsocketEventsWithResponse request returning *WebsocketEventsResponse
+func (c *ClientWithResponses) WebsocketEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WebsocketEventsResponse, error) {
+	rsp, err := c.WebsocketEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebsocketEventsResponse(rsp)
+}
+
+// ParseRebuildProjectionResponse parses an HTTP response from a RebuildProjectionWithResponse call
+func ParseRebuildProjectionResponse(rsp *http.Response) (*RebuildProjectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RebuildProjectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RebuildProjectionOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientConfigResponse parses an HTTP response from a GetClientConfigWithResponse call
+func ParseGetClientConfigResponse(rsp *http.Response) (*GetClientConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientConfigOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListProjectsOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteProjectOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCardsResponse parses an HTTP response from a ListCardsWithResponse call
+func ParseListCardsResponse(rsp *http.Response) (*ListCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCardsOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
+func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCardResponse parses an HTTP response from a DeleteCardWithResponse call
+func ParseDeleteCardResponse(rsp *http.Response) (*DeleteCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
+func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 308:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON308 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCardResponse parses an HTTP response from a UpdateCardWithResponse call
+func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAcceptanceCriteriaResponse parses an HTTP response from a ListAcceptanceCriteriaWithResponse call
+func ParseListAcceptanceCriteriaResponse(rsp *http.Response) (*ListAcceptanceCriteriaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAcceptanceCriteriaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAcceptanceCriteriaOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddAcceptanceCriterionResponse parses an HTTP response from a AddAcceptanceCriterionWithResponse call
+func ParseAddAcceptanceCriterionResponse(rsp *http.Response) (*AddAcceptanceCriterionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddAcceptanceCriterionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AcceptanceCriterion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAcceptanceCriterionResponse parses an HTTP response from a DeleteAcceptanceCriterionWithResponse call
+func ParseDeleteAcceptanceCriterionResponse(rsp *http.Response) (*DeleteAcceptanceCriterionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAcceptanceCriterionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AcceptanceCriterion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAcceptanceCriterionResponse parses an HTTP response from a UpdateAcceptanceCriterionWithResponse call
+func ParseUpdateAcceptanceCriterionResponse(rsp *http.Response) (*UpdateAcceptanceCriterionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAcceptanceCriterionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AcceptanceCriterion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAttachmentsResponse parses an HTTP response from a ListAttachmentsWithResponse call
+func ParseListAttachmentsResponse(rsp *http.Response) (*ListAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAttachmentsOutputBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadAttachmentResponse parses an HTTP response from a UploadAttachmentWithResponse call
+func ParseUploadAttachmentResponse(rsp *http.Response) (*UploadAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAttachmentResponse parses an HTTP response from a DeleteAttachmentWithResponse call
+func ParseDeleteAttachmentResponse(rsp *http.Response) (*DeleteAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return ParseMoveCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) MoveCardWithResponse(ctx context.Context, project string, number int64, body MoveCardJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveCardResponse, error) {
-	rsp, err := c.MoveCard(ctx, project, number, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMoveCardResponse(rsp)
+	return response, nil
 }
 
-// ListTodosWithResponse request returning *ListTodosResponse
-func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, project string, number int64, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
-	rsp, err := c.ListTodos(ctx, project, number, reqEditors...)
+// ParseDownloadAttachmentResponse parses an HTTP response from a DownloadAttachmentWithResponse call
+func ParseDownloadAttachmentResponse(rsp *http.Response) (*DownloadAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTodosResponse(rsp)
-}
 
-// AddTodoWithBodyWithResponse request with arbitrary body returning *AddTodoResponse
-func (c *ClientWithResponses) AddTodoWithBodyWithResponse(ctx context.Context, project string, number int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoResponse, error) {
-	rsp, err := c.AddTodoWithBody(ctx, project, number, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DownloadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddTodoWithResponse(ctx context.Context, project string, number int64, body AddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoResponse, error) {
-	rsp, err := c.AddTodo(ctx, project, number, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAddTodoResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// DeleteTodoWithResponse request returning *DeleteTodoResponse
-func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodo(ctx, project, number, todoId, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseDeleteTodoResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
-func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, project string, number int64, todoId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodoWithBody(ctx, project, number, todoId, contentType, body, reqEditors...)
+// ParseSetCardBranchResponse parses an HTTP response from a SetCardBranchWithResponse call
+func ParseSetCardBranchResponse(rsp *http.Response) (*SetCardBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, project string, number int64, todoId int64, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodo(ctx, project, number, todoId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetCardBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTodoResponse(rsp)
-}
 
-// WebsocketEventsWithResponse request returning *WebsocketEventsResponse
-func (c *ClientWithResponses) WebsocketEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WebsocketEventsResponse, error) {
-	rsp, err := c.WebsocketEvents(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return ParseWebsocketEventsResponse(rsp)
+
+	return response, nil
 }
 
-// ParseRebuildProjectionResponse parses an HTTP response from a RebuildProjectionWithResponse call
-func ParseRebuildProjectionResponse(rsp *http.Response) (*RebuildProjectionResponse, error) {
+// ParseCommentCardResponse parses an HTTP response from a CommentCardWithResponse call
+func ParseCommentCardResponse(rsp *http.Response) (*CommentCardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RebuildProjectionResponse{
+	response := &CommentCardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RebuildProjectionOutputBody
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2931,92 +7201,176 @@ func ParseRebuildProjectionResponse(rsp *http.Response) (*RebuildProjectionRespo
 	return response, nil
 }
 
-// ParseGetClientConfigResponse parses an HTTP response from a GetClientConfigWithResponse call
-func ParseGetClientConfigResponse(rsp *http.Response) (*GetClientConfigResponse, error) {
+// ParseAppendDescriptionResponse parses an HTTP response from a AppendDescriptionWithResponse call
+func ParseAppendDescriptionResponse(rsp *http.Response) (*AppendDescriptionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientConfigResponse{
+	response := &AppendDescriptionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClientConfigOutputBody
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSONDefault = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+// ParseSetCardDueResponse parses an HTTP response from a SetCardDueWithResponse call
+func ParseSetCardDueResponse(rsp *http.Response) (*SetCardDueResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResponse{
+	response := &SetCardDueResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthOutputBody
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSONDefault = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
-func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+// ParseAddCardLabelResponse parses an HTTP response from a AddCardLabelWithResponse call
+func ParseAddCardLabelResponse(rsp *http.Response) (*AddCardLabelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectsResponse{
+	response := &AddCardLabelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListProjectsOutputBody
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorModel
@@ -3030,26 +7384,26 @@ func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error
 	return response, nil
 }
 
-// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
-func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+// ParseRemoveCardLabelResponse parses an HTTP response from a RemoveCardLabelWithResponse call
+func ParseRemoveCardLabelResponse(rsp *http.Response) (*RemoveCardLabelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectResponse{
+	response := &RemoveCardLabelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorModel
@@ -3058,12 +7412,19 @@ func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, err
 		}
 		response.ApplicationproblemJSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON409 = &dest
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
@@ -3084,27 +7445,34 @@ func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, err
 	return response, nil
 }
 
-// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
-func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+// ParseMoveCardResponse parses an HTTP response from a MoveCardWithResponse call
+func ParseMoveCardResponse(rsp *http.Response) (*MoveCardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectResponse{
+	response := &MoveCardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeleteProjectOutputBody
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3112,6 +7480,20 @@ func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, err
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3131,27 +7513,48 @@ func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, err
 	return response, nil
 }
 
-// ParseListCardsResponse parses an HTTP response from a ListCardsWithResponse call
-func ParseListCardsResponse(rsp *http.Response) (*ListCardsResponse, error) {
+// ParseSetCardPriorityResponse parses an HTTP response from a SetCardPriorityWithResponse call
+func ParseSetCardPriorityResponse(rsp *http.Response) (*SetCardPriorityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCardsResponse{
+	response := &SetCardPriorityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListCardsOutputBody
+		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3171,26 +7574,26 @@ func ParseListCardsResponse(rsp *http.Response) (*ListCardsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
-func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+// ParseAddCardRelationResponse parses an HTTP response from a AddCardRelationWithResponse call
+func ParseAddCardRelationResponse(rsp *http.Response) (*AddCardRelationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCardResponse{
+	response := &AddCardRelationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest Card
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorModel
@@ -3199,6 +7602,20 @@ func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3218,15 +7635,15 @@ func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteCardResponse parses an HTTP response from a DeleteCardWithResponse call
-func ParseDeleteCardResponse(rsp *http.Response) (*DeleteCardResponse, error) {
+// ParseRemoveCardRelationResponse parses an HTTP response from a RemoveCardRelationWithResponse call
+func ParseRemoveCardRelationResponse(rsp *http.Response) (*RemoveCardRelationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCardResponse{
+	response := &RemoveCardRelationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3253,6 +7670,13 @@ func ParseDeleteCardResponse(rsp *http.Response) (*DeleteCardResponse, error) {
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3272,15 +7696,15 @@ func ParseDeleteCardResponse(rsp *http.Response) (*DeleteCardResponse, error) {
 	return response, nil
 }
 
-// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
-func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+// ParseRestoreCardResponse parses an HTTP response from a RestoreCardWithResponse call
+func ParseRestoreCardResponse(rsp *http.Response) (*RestoreCardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCardResponse{
+	response := &RestoreCardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3307,6 +7731,13 @@ func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3326,22 +7757,22 @@ func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
 	return response, nil
 }
 
-// ParseListAcceptanceCriteriaResponse parses an HTTP response from a ListAcceptanceCriteriaWithResponse call
-func ParseListAcceptanceCriteriaResponse(rsp *http.Response) (*ListAcceptanceCriteriaResponse, error) {
+// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
+func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListAcceptanceCriteriaResponse{
+	response := &ListTodosResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAcceptanceCriteriaOutputBody
+		var dest ListTodosOutputBody
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3380,22 +7811,22 @@ func ParseListAcceptanceCriteriaResponse(rsp *http.Response) (*ListAcceptanceCri
 	return response, nil
 }
 
-// ParseAddAcceptanceCriterionResponse parses an HTTP response from a AddAcceptanceCriterionWithResponse call
-func ParseAddAcceptanceCriterionResponse(rsp *http.Response) (*AddAcceptanceCriterionResponse, error) {
+// ParseAddTodoResponse parses an HTTP response from a AddTodoWithResponse call
+func ParseAddTodoResponse(rsp *http.Response) (*AddTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddAcceptanceCriterionResponse{
+	response := &AddTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AcceptanceCriterion
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3415,6 +7846,13 @@ func ParseAddAcceptanceCriterionResponse(rsp *http.Response) (*AddAcceptanceCrit
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3434,22 +7872,22 @@ func ParseAddAcceptanceCriterionResponse(rsp *http.Response) (*AddAcceptanceCrit
 	return response, nil
 }
 
-// ParseDeleteAcceptanceCriterionResponse parses an HTTP response from a DeleteAcceptanceCriterionWithResponse call
-func ParseDeleteAcceptanceCriterionResponse(rsp *http.Response) (*DeleteAcceptanceCriterionResponse, error) {
+// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
+func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAcceptanceCriterionResponse{
+	response := &DeleteTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AcceptanceCriterion
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3469,6 +7907,13 @@ func ParseDeleteAcceptanceCriterionResponse(rsp *http.Response) (*DeleteAcceptan
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3488,22 +7933,22 @@ func ParseDeleteAcceptanceCriterionResponse(rsp *http.Response) (*DeleteAcceptan
 	return response, nil
 }
 
-// ParseUpdateAcceptanceCriterionResponse parses an HTTP response from a UpdateAcceptanceCriterionWithResponse call
-func ParseUpdateAcceptanceCriterionResponse(rsp *http.Response) (*UpdateAcceptanceCriterionResponse, error) {
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAcceptanceCriterionResponse{
+	response := &UpdateTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AcceptanceCriterion
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3523,6 +7968,13 @@ func ParseUpdateAcceptanceCriterionResponse(rsp *http.Response) (*UpdateAcceptan
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3542,15 +7994,15 @@ func ParseUpdateAcceptanceCriterionResponse(rsp *http.Response) (*UpdateAcceptan
 	return response, nil
 }
 
-// ParseSetCardBranchResponse parses an HTTP response from a SetCardBranchWithResponse call
-func ParseSetCardBranchResponse(rsp *http.Response) (*SetCardBranchResponse, error) {
+// ParseTransferCardResponse parses an HTTP response from a TransferCardWithResponse call
+func ParseTransferCardResponse(rsp *http.Response) (*TransferCardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetCardBranchResponse{
+	response := &TransferCardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3577,6 +8029,13 @@ func ParseSetCardBranchResponse(rsp *http.Response) (*SetCardBranchResponse, err
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3596,22 +8055,22 @@ func ParseSetCardBranchResponse(rsp *http.Response) (*SetCardBranchResponse, err
 	return response, nil
 }
 
-// ParseCommentCardResponse parses an HTTP response from a CommentCardWithResponse call
-func ParseCommentCardResponse(rsp *http.Response) (*CommentCardResponse, error) {
+// ParseGetCardTreeResponse parses an HTTP response from a GetCardTreeWithResponse call
+func ParseGetCardTreeResponse(rsp *http.Response) (*GetCardTreeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CommentCardResponse{
+	response := &GetCardTreeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest CardTreeNode
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3650,34 +8109,27 @@ func ParseCommentCardResponse(rsp *http.Response) (*CommentCardResponse, error)
 	return response, nil
 }
 
-// ParseAppendDescriptionResponse parses an HTTP response from a AppendDescriptionWithResponse call
-func ParseAppendDescriptionResponse(rsp *http.Response) (*AppendDescriptionResponse, error) {
+// ParseGetProjectStatusesResponse parses an HTTP response from a GetProjectStatusesWithResponse call
+func ParseGetProjectStatusesResponse(rsp *http.Response) (*GetProjectStatusesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppendDescriptionResponse{
+	response := &GetProjectStatusesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest ProjectStatusesOutputBody
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorModel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3704,22 +8156,22 @@ func ParseAppendDescriptionResponse(rsp *http.Response) (*AppendDescriptionRespo
 	return response, nil
 }
 
-// ParseMoveCardResponse parses an HTTP response from a MoveCardWithResponse call
-func ParseMoveCardResponse(rsp *http.Response) (*MoveCardResponse, error) {
+// ParseSetProjectStatusesResponse parses an HTTP response from a SetProjectStatusesWithResponse call
+func ParseSetProjectStatusesResponse(rsp *http.Response) (*SetProjectStatusesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MoveCardResponse{
+	response := &SetProjectStatusesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3758,22 +8210,22 @@ func ParseMoveCardResponse(rsp *http.Response) (*MoveCardResponse, error) {
 	return response, nil
 }
 
-// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
-func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
+// ParseSetProjectTransitionRulesResponse parses an HTTP response from a SetProjectTransitionRulesWithResponse call
+func ParseSetProjectTransitionRulesResponse(rsp *http.Response) (*SetProjectTransitionRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTodosResponse{
+	response := &SetProjectTransitionRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListTodosOutputBody
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3812,47 +8264,26 @@ func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
 	return response, nil
 }
 
-// ParseAddTodoResponse parses an HTTP response from a AddTodoWithResponse call
-func ParseAddTodoResponse(rsp *http.Response) (*AddTodoResponse, error) {
+// ParseListTrashedProjectsResponse parses an HTTP response from a ListTrashedProjectsWithResponse call
+func ParseListTrashedProjectsResponse(rsp *http.Response) (*ListTrashedProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTodoResponse{
+	response := &ListTrashedProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Todo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorModel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorModel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ErrorModel
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTrashedProjectsOutputBody
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON422 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorModel
@@ -3866,22 +8297,22 @@ func ParseAddTodoResponse(rsp *http.Response) (*AddTodoResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
-func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+// ParsePurgeTrashedProjectResponse parses an HTTP response from a PurgeTrashedProjectWithResponse call
+func ParsePurgeTrashedProjectResponse(rsp *http.Response) (*PurgeTrashedProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTodoResponse{
+	response := &PurgeTrashedProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Todo
+		var dest PurgeTrashedProjectOutputBody
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3920,22 +8351,22 @@ func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
-func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+// ParseRestoreTrashedProjectResponse parses an HTTP response from a RestoreTrashedProjectWithResponse call
+func ParseRestoreTrashedProjectResponse(rsp *http.Response) (*RestoreTrashedProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTodoResponse{
+	response := &RestoreTrashedProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Todo
+		var dest Project
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3955,6 +8386,13 @@ func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
 		}
 		response.ApplicationproblemJSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ErrorModel
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3987,5 +8425,22 @@ func ParseWebsocketEventsResponse(rsp *http.Response) (*WebsocketEventsResponse,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 101:
+		var dest WebsocketEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON101 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebsocketEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
@@ -0,0 +1,1187 @@
+package cardcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	apiclient "github.com/simonjohansson/kanban/backend/gen/client"
+	"github.com/simonjohansson/kanban/backend/internal/kanban/commands/common"
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func New(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc) *cobra.Command {
+	cardCmd := &cobra.Command{
+		Use:     "card",
+		Aliases: []string{"cards"},
+		Short:   "Manage cards.",
+		Long:    "Create, list, get, edit, move, comment, describe, manage todos/acceptance criteria, delete, and restore cards.",
+	}
+
+	createCmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"new"},
+		Short:   "Create a card.",
+		Long:    "Create a card in a project with required title and status, optionally as a child of another card. Creating a card in a status at its WIP limit is refused unless --force is given.",
+		Example: strings.TrimSpace(`kanban card create --project alpha --title "Task" --status Todo
+kanban cards new -p alpha -t "Task" -s Doing
+kanban card create -p alpha -t "Subtask" -s Todo --parent alpha/card-3`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			title, _ := cmd.Flags().GetString("title")
+			status, _ := cmd.Flags().GetString("status")
+			description, _ := cmd.Flags().GetString("description")
+			branch, _ := cmd.Flags().GetString("branch")
+			parent, _ := cmd.Flags().GetString("parent")
+
+			body := apiclient.CreateCardRequest{Title: strings.TrimSpace(title), Status: strings.TrimSpace(status)}
+			if value := strings.TrimSpace(description); value != "" {
+				body.Description = &value
+			}
+			if value := strings.TrimSpace(branch); value != "" {
+				body.Branch = &value
+			}
+			if value := strings.TrimSpace(parent); value != "" {
+				body.ParentId = &value
+			}
+			if force, _ := cmd.Flags().GetBool("force"); force {
+				body.Force = &force
+			}
+
+			resp, reqErr := client.CreateCard(context.Background(), strings.TrimSpace(project), body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	createCmd.Flags().StringP("project", "p", "", "Project slug")
+	createCmd.Flags().StringP("title", "t", "", "Card title")
+	createCmd.Flags().StringP("description", "d", "", "Initial description text")
+	createCmd.Flags().String("branch", "", "Optional git branch metadata")
+	createCmd.Flags().StringP("status", "s", "", "Card status; one of the project's statuses")
+	createCmd.Flags().String("parent", "", "Parent card ID (<project>/card-<number>)")
+	createCmd.Flags().Bool("force", false, "Create the card even if its status is at its WIP limit")
+	_ = createCmd.MarkFlagRequired("project")
+	_ = createCmd.MarkFlagRequired("title")
+	_ = createCmd.MarkFlagRequired("status")
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List cards.",
+		Long:    "List cards in a project, optionally only those with a given label or past their due date, ordered by status then rank (the board order), number, priority, due date or last update.",
+		Example: strings.TrimSpace(`kanban card list --project alpha
+kanban cards ls -p alpha --include-deleted
+kanban cards ls -p alpha --label bug
+kanban cards ls -p alpha --sort priority
+kanban cards ls -p alpha --overdue --sort due`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			includeDeleted, _ := cmd.Flags().GetBool("include-deleted")
+			params := &apiclient.ListCardsParams{IncludeDeleted: &includeDeleted}
+			if label, _ := cmd.Flags().GetString("label"); strings.TrimSpace(label) != "" {
+				value := strings.TrimSpace(label)
+				params.Label = &value
+			}
+			if sort, _ := cmd.Flags().GetString("sort"); strings.TrimSpace(sort) != "" {
+				value := strings.TrimSpace(sort)
+				params.Sort = &value
+			}
+			if overdue, _ := cmd.Flags().GetBool("overdue"); overdue {
+				params.Overdue = &overdue
+			}
+			resp, reqErr := client.ListCards(context.Background(), strings.TrimSpace(project), params)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	listCmd.Flags().StringP("project", "p", "", "Project slug")
+	listCmd.Flags().Bool("include-deleted", false, "Include soft-deleted cards")
+	listCmd.Flags().StringP("label", "l", "", "Only list cards with this label")
+	listCmd.Flags().String("sort", "", "Sort order (rank|number|priority|due|updated)")
+	listCmd.Flags().Bool("overdue", false, "Only list cards past their due date that are not done")
+	_ = listCmd.MarkFlagRequired("project")
+
+	getCmd := &cobra.Command{
+		Use:     "get",
+		Aliases: []string{"show"},
+		Short:   "Get one card.",
+		Long:    "Fetch one card by number from a project.",
+		Example: strings.TrimSpace(`kanban card get --project alpha --id 1
+kanban cards show -p alpha -i 1 --output json`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			resp, reqErr := client.GetCard(context.Background(), strings.TrimSpace(project), id)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	getCmd.Flags().StringP("project", "p", "", "Project slug")
+	getCmd.Flags().Int64P("id", "i", 0, "Card number")
+	_ = getCmd.MarkFlagRequired("project")
+	_ = getCmd.MarkFlagRequired("id")
+
+	treeCmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show a card with its child cards.",
+		Long:  "Fetch a card together with its children, recursively, each with a rollup of how many of its children are done.",
+		Example: strings.TrimSpace(`kanban card tree --project alpha --id 3
+kanban card tree -p alpha -i 3 --output json`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			resp, reqErr := client.GetCardTree(context.Background(), strings.TrimSpace(project), id)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	treeCmd.Flags().StringP("project", "p", "", "Project slug")
+	treeCmd.Flags().Int64P("id", "i", 0, "Card number")
+	_ = treeCmd.MarkFlagRequired("project")
+	_ = treeCmd.MarkFlagRequired("id")
+
+	moveCmd := &cobra.Command{
+		Use:   "move",
+		Short: "Move a card.",
+		Long:  "Update card status and, with --before or --after, its place among the cards of that status; a card entering a status otherwise goes to the bottom. Moving a card that breaks a project transition rule, a card blocked by unfinished cards out of the project's first status to any but the done status, or a card into a status at its WIP limit is refused unless --force is given; a forced move past a rule is recorded in the card history.",
+		Example: strings.TrimSpace(`kanban card move --project alpha --id 1 --status Doing
+kanban cards move -p alpha -i 1 -s Review
+kanban cards move -p alpha -i 1 -s Doing --force
+kanban card move -p alpha -i 4 -s Todo --before 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			status, _ := cmd.Flags().GetString("status")
+			body := apiclient.MoveCardRequest{Status: strings.TrimSpace(status)}
+			if force, _ := cmd.Flags().GetBool("force"); force {
+				body.Force = &force
+			}
+			if before, _ := cmd.Flags().GetInt64("before"); before != 0 {
+				body.Before = &before
+			}
+			if after, _ := cmd.Flags().GetInt64("after"); after != 0 {
+				body.After = &after
+			}
+			resp, reqErr := client.MoveCard(context.Background(), strings.TrimSpace(project), id, &apiclient.MoveCardParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	moveCmd.Flags().StringP("project", "p", "", "Project slug")
+	moveCmd.Flags().Int64P("id", "i", 0, "Card number")
+	moveCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	moveCmd.Flags().Bool("force", false, "Move even if the card breaks a transition rule, is blocked, or the status is at its WIP limit")
+	moveCmd.Flags().StringP("status", "s", "", "Target status; one of the project's statuses")
+	moveCmd.Flags().Int64("before", 0, "Place the card directly before this card of the target status")
+	moveCmd.Flags().Int64("after", 0, "Place the card directly after this card of the target status")
+	moveCmd.MarkFlagsMutuallyExclusive("before", "after")
+	_ = moveCmd.MarkFlagRequired("project")
+	_ = moveCmd.MarkFlagRequired("id")
+	_ = moveCmd.MarkFlagRequired("status")
+
+	commentCmd := &cobra.Command{
+		Use:     "comment",
+		Aliases: []string{"note"},
+		Short:   "Append a comment.",
+		Long:    "Add a comment event to a card.",
+		Example: strings.TrimSpace(`kanban card comment --project alpha --id 1 --body "Need review"
+kanban cards note -p alpha -i 1 -b "LGTM"`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			bodyRaw, _ := cmd.Flags().GetString("body")
+
+			resp, reqErr := client.CommentCard(context.Background(), strings.TrimSpace(project), id, &apiclient.CommentCardParams{IfMatch: ifMatch(cmd)}, apiclient.TextBodyRequest{Body: strings.TrimSpace(bodyRaw)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	commentCmd.Flags().StringP("project", "p", "", "Project slug")
+	commentCmd.Flags().Int64P("id", "i", 0, "Card number")
+	commentCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	commentCmd.Flags().StringP("body", "b", "", "Comment body")
+	_ = commentCmd.MarkFlagRequired("project")
+	_ = commentCmd.MarkFlagRequired("id")
+	_ = commentCmd.MarkFlagRequired("body")
+
+	describeCmd := &cobra.Command{
+		Use:     "describe",
+		Aliases: []string{"desc"},
+		Short:   "Append description text.",
+		Long:    "Append text to the card description event log.",
+		Example: strings.TrimSpace(`kanban card describe --project alpha --id 1 --body "Investigated root cause"
+kanban cards desc -p alpha -i 1 -b "Added acceptance criteria"`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			bodyRaw, _ := cmd.Flags().GetString("body")
+
+			resp, reqErr := client.AppendDescription(context.Background(), strings.TrimSpace(project), id, &apiclient.AppendDescriptionParams{IfMatch: ifMatch(cmd)}, apiclient.TextBodyRequest{Body: strings.TrimSpace(bodyRaw)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	describeCmd.Flags().StringP("project", "p", "", "Project slug")
+	describeCmd.Flags().Int64P("id", "i", 0, "Card number")
+	describeCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	describeCmd.Flags().StringP("body", "b", "", "Description text to append")
+	_ = describeCmd.MarkFlagRequired("project")
+	_ = describeCmd.MarkFlagRequired("id")
+	_ = describeCmd.MarkFlagRequired("body")
+
+	branchCmd := &cobra.Command{
+		Use:   "branch",
+		Short: "Set card branch metadata.",
+		Long:  "Set or update the card branch value.",
+		Example: strings.TrimSpace(`kanban card branch --project alpha --id 1 --branch feature/task
+kanban cards branch -p alpha -i 1 -b feature/task-v2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			branch, _ := cmd.Flags().GetString("branch")
+
+			body := apiclient.SetCardBranchRequest{Branch: strings.TrimSpace(branch)}
+			resp, reqErr := client.SetCardBranch(context.Background(), strings.TrimSpace(project), id, &apiclient.SetCardBranchParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	branchCmd.Flags().StringP("project", "p", "", "Project slug")
+	branchCmd.Flags().Int64P("id", "i", 0, "Card number")
+	branchCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	branchCmd.Flags().StringP("branch", "b", "", "Git branch metadata")
+	_ = branchCmd.MarkFlagRequired("project")
+	_ = branchCmd.MarkFlagRequired("id")
+	_ = branchCmd.MarkFlagRequired("branch")
+
+	priorityCmd := &cobra.Command{
+		Use:   "priority",
+		Short: "Set card priority.",
+		Long:  "Set the card priority from P0 (most urgent) to P3, or clear it with an empty value.",
+		Example: strings.TrimSpace(`kanban card priority --project alpha --id 1 --priority P1
+kanban cards priority -p alpha -i 1 --priority ""`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			priority, _ := cmd.Flags().GetString("priority")
+
+			body := apiclient.SetCardPriorityRequest{Priority: strings.TrimSpace(priority)}
+			resp, reqErr := client.SetCardPriority(context.Background(), strings.TrimSpace(project), id, &apiclient.SetCardPriorityParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	priorityCmd.Flags().StringP("project", "p", "", "Project slug")
+	priorityCmd.Flags().Int64P("id", "i", 0, "Card number")
+	priorityCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	priorityCmd.Flags().String("priority", "", "Card priority (P0|P1|P2|P3, empty clears it)")
+	_ = priorityCmd.MarkFlagRequired("project")
+	_ = priorityCmd.MarkFlagRequired("id")
+	_ = priorityCmd.MarkFlagRequired("priority")
+
+	dueCmd := &cobra.Command{
+		Use:   "due",
+		Short: "Set card due date.",
+		Long:  "Set the card due date as YYYY-MM-DD (end of that day, UTC) or an RFC 3339 timestamp, or clear it with an empty value.",
+		Example: strings.TrimSpace(`kanban card due --project alpha --id 1 --due 2026-03-01
+kanban cards due -p alpha -i 1 --due 2026-03-01T09:00:00+01:00
+kanban cards due -p alpha -i 1 --due ""`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			due, _ := cmd.Flags().GetString("due")
+
+			body := apiclient.SetCardDueRequest{DueAt: strings.TrimSpace(due)}
+			resp, reqErr := client.SetCardDue(context.Background(), strings.TrimSpace(project), id, &apiclient.SetCardDueParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	dueCmd.Flags().StringP("project", "p", "", "Project slug")
+	dueCmd.Flags().Int64P("id", "i", 0, "Card number")
+	dueCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	dueCmd.Flags().String("due", "", "Due date (YYYY-MM-DD or RFC 3339, empty clears it)")
+	_ = dueCmd.MarkFlagRequired("project")
+	_ = dueCmd.MarkFlagRequired("id")
+	_ = dueCmd.MarkFlagRequired("due")
+
+	editCmd := &cobra.Command{
+		Use:     "edit",
+		Aliases: []string{"update"},
+		Short:   "Edit card fields.",
+		Long:    "Update the title, branch or status of a card. Only the flags you pass are changed. A status change is checked like card move and needs --force in the same cases.",
+		Example: strings.TrimSpace(`kanban card edit --project alpha --id 1 --title "Better title"
+kanban cards update -p alpha -i 1 -t "Better title" --branch feature/better --if-match 3
+kanban card edit -p alpha -i 1 -s Done --force`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+
+			body := apiclient.UpdateCardRequest{}
+			if cmd.Flags().Changed("title") {
+				value, _ := cmd.Flags().GetString("title")
+				value = strings.TrimSpace(value)
+				body.Title = &value
+			}
+			if cmd.Flags().Changed("branch") {
+				value, _ := cmd.Flags().GetString("branch")
+				value = strings.TrimSpace(value)
+				body.Branch = &value
+			}
+			if cmd.Flags().Changed("status") {
+				value, _ := cmd.Flags().GetString("status")
+				value = strings.TrimSpace(value)
+				body.Status = &value
+			}
+			if force, _ := cmd.Flags().GetBool("force"); force {
+				body.Force = &force
+			}
+			if body.Title == nil && body.Branch == nil && body.Status == nil {
+				return wrapErr(http.StatusBadRequest, "at least one of --title, --branch or --status is required")
+			}
+
+			resp, reqErr := client.UpdateCard(context.Background(), strings.TrimSpace(project), id, &apiclient.UpdateCardParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	editCmd.Flags().StringP("project", "p", "", "Project slug")
+	editCmd.Flags().Int64P("id", "i", 0, "Card number")
+	editCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	editCmd.Flags().StringP("title", "t", "", "New card title")
+	editCmd.Flags().String("branch", "", "New git branch metadata (empty clears it)")
+	editCmd.Flags().StringP("status", "s", "", "New card status; one of the project's statuses")
+	editCmd.Flags().Bool("force", false, "Change the status even if the card breaks a transition rule, is blocked, or the status is at its WIP limit")
+	_ = editCmd.MarkFlagRequired("project")
+	_ = editCmd.MarkFlagRequired("id")
+
+	deleteCmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm", "remove"},
+		Short:   "Delete a card.",
+		Long:    "Soft-delete by default; set --hard for permanent delete.",
+		Example: strings.TrimSpace(`kanban card delete --project alpha --id 1
+kanban cards rm -p alpha -i 1 --hard`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			hard, _ := cmd.Flags().GetBool("hard")
+
+			params := &apiclient.DeleteCardParams{Hard: &hard, IfMatch: ifMatch(cmd)}
+			resp, reqErr := client.DeleteCard(context.Background(), strings.TrimSpace(project), id, params)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	deleteCmd.Flags().StringP("project", "p", "", "Project slug")
+	deleteCmd.Flags().Int64P("id", "i", 0, "Card number")
+	deleteCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	deleteCmd.Flags().Bool("hard", false, "Permanently delete instead of soft delete")
+	_ = deleteCmd.MarkFlagRequired("project")
+	_ = deleteCmd.MarkFlagRequired("id")
+
+	restoreCmd := &cobra.Command{
+		Use:     "restore",
+		Aliases: []string{"undelete"},
+		Short:   "Restore a soft-deleted card.",
+		Long:    "Clear the deleted flag of a soft-deleted card. Hard-deleted cards cannot be restored.",
+		Example: strings.TrimSpace(`kanban card restore --project alpha --id 1
+kanban cards undelete -p alpha -i 1`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+
+			params := &apiclient.RestoreCardParams{IfMatch: ifMatch(cmd)}
+			resp, reqErr := client.RestoreCard(context.Background(), strings.TrimSpace(project), id, params)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	restoreCmd.Flags().StringP("project", "p", "", "Project slug")
+	restoreCmd.Flags().Int64P("id", "i", 0, "Card number")
+	restoreCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	_ = restoreCmd.MarkFlagRequired("project")
+	_ = restoreCmd.MarkFlagRequired("id")
+
+	transferCmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Move a card to another project.",
+		Long:  "Re-file a card under the next number of another project, keeping its description, comments, todos, acceptance criteria and history. The old card becomes a tombstone that redirects to the new one.",
+		Example: strings.TrimSpace(`kanban card transfer --project alpha --id 3 --to beta
+kanban cards transfer -p alpha -i 3 --to beta --if-match 4`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			target, _ := cmd.Flags().GetString("to")
+
+			params := &apiclient.TransferCardParams{IfMatch: ifMatch(cmd)}
+			body := apiclient.TransferCardRequest{TargetProject: strings.TrimSpace(target)}
+			resp, reqErr := client.TransferCard(context.Background(), strings.TrimSpace(project), id, params, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	transferCmd.Flags().StringP("project", "p", "", "Project slug")
+	transferCmd.Flags().Int64P("id", "i", 0, "Card number")
+	transferCmd.Flags().String("to", "", "Target project slug")
+	transferCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	_ = transferCmd.MarkFlagRequired("project")
+	_ = transferCmd.MarkFlagRequired("id")
+	_ = transferCmd.MarkFlagRequired("to")
+
+	todoCmd := &cobra.Command{
+		Use:     "todo",
+		Aliases: []string{"todos"},
+		Short:   "Manage card todos.",
+		Long:    "Add, list, complete, uncomplete, edit, reorder, and remove card todos.",
+	}
+
+	addTodoCmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new"},
+		Short:   "Add a todo to a card.",
+		Example: strings.TrimSpace(`kanban card todo add --project alpha --id 1 --body "Write tests"
+kanban cards todos new -p alpha -i 1 -b "Review logs"`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			bodyRaw, _ := cmd.Flags().GetString("body")
+			body := apiclient.AddTodoRequest{Text: strings.TrimSpace(bodyRaw)}
+			resp, reqErr := client.AddTodo(context.Background(), strings.TrimSpace(project), id, &apiclient.AddTodoParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	addTodoCmd.Flags().StringP("project", "p", "", "Project slug")
+	addTodoCmd.Flags().Int64P("id", "i", 0, "Card number")
+	addTodoCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	addTodoCmd.Flags().StringP("body", "b", "", "Todo text")
+	_ = addTodoCmd.MarkFlagRequired("project")
+	_ = addTodoCmd.MarkFlagRequired("id")
+	_ = addTodoCmd.MarkFlagRequired("body")
+
+	listTodosCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List todos on a card.",
+		Example: strings.TrimSpace(`kanban card todo list --project alpha --id 1
+kanban cards todos ls -p alpha -i 1`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			resp, reqErr := client.ListTodos(context.Background(), strings.TrimSpace(project), id)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	listTodosCmd.Flags().StringP("project", "p", "", "Project slug")
+	listTodosCmd.Flags().Int64P("id", "i", 0, "Card number")
+	_ = listTodosCmd.MarkFlagRequired("project")
+	_ = listTodosCmd.MarkFlagRequired("id")
+
+	doneTodoCmd := &cobra.Command{
+		Use:   "done",
+		Short: "Mark a todo as completed.",
+		Example: strings.TrimSpace(`kanban card todo done --project alpha --id 1 --todo-id 2
+kanban cards todos done -p alpha -i 1 --todo-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setTodoCompleted(runtime, stdout, handle, wrapErr, cmd, true)
+		},
+	}
+	doneTodoCmd.Flags().StringP("project", "p", "", "Project slug")
+	doneTodoCmd.Flags().Int64P("id", "i", 0, "Card number")
+	doneTodoCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	doneTodoCmd.Flags().Int64("todo-id", 0, "Todo identifier")
+	_ = doneTodoCmd.MarkFlagRequired("project")
+	_ = doneTodoCmd.MarkFlagRequired("id")
+	_ = doneTodoCmd.MarkFlagRequired("todo-id")
+
+	undoTodoCmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Mark a todo as not completed.",
+		Example: strings.TrimSpace(`kanban card todo undo --project alpha --id 1 --todo-id 2
+kanban cards todos undo -p alpha -i 1 --todo-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setTodoCompleted(runtime, stdout, handle, wrapErr, cmd, false)
+		},
+	}
+	undoTodoCmd.Flags().StringP("project", "p", "", "Project slug")
+	undoTodoCmd.Flags().Int64P("id", "i", 0, "Card number")
+	undoTodoCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	undoTodoCmd.Flags().Int64("todo-id", 0, "Todo identifier")
+	_ = undoTodoCmd.MarkFlagRequired("project")
+	_ = undoTodoCmd.MarkFlagRequired("id")
+	_ = undoTodoCmd.MarkFlagRequired("todo-id")
+
+	editTodoCmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Change a todo's text or position.",
+		Example: strings.TrimSpace(`kanban card todo edit --project alpha --id 1 --todo-id 2 --body "Write more tests"
+kanban cards todos edit -p alpha -i 1 --todo-id 2 --position 1`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			text, position, err := checklistEditFields(cmd)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			todoID, _ := cmd.Flags().GetInt64("todo-id")
+			body := apiclient.UpdateTodoRequest{Text: text, Position: position}
+			resp, reqErr := client.UpdateTodo(context.Background(), strings.TrimSpace(project), id, todoID, &apiclient.UpdateTodoParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	editTodoCmd.Flags().StringP("project", "p", "", "Project slug")
+	editTodoCmd.Flags().Int64P("id", "i", 0, "Card number")
+	editTodoCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	editTodoCmd.Flags().Int64("todo-id", 0, "Todo identifier")
+	editTodoCmd.Flags().StringP("body", "b", "", "New todo text")
+	editTodoCmd.Flags().Int64("position", 0, "New 1-based position in the todo list")
+	_ = editTodoCmd.MarkFlagRequired("project")
+	_ = editTodoCmd.MarkFlagRequired("id")
+	_ = editTodoCmd.MarkFlagRequired("todo-id")
+
+	deleteTodoCmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm", "remove"},
+		Short:   "Delete a todo from a card.",
+		Example: strings.TrimSpace(`kanban card todo delete --project alpha --id 1 --todo-id 2
+kanban cards todos rm -p alpha -i 1 --todo-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			todoID, _ := cmd.Flags().GetInt64("todo-id")
+			resp, reqErr := client.DeleteTodo(context.Background(), strings.TrimSpace(project), id, todoID, &apiclient.DeleteTodoParams{IfMatch: ifMatch(cmd)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	deleteTodoCmd.Flags().StringP("project", "p", "", "Project slug")
+	deleteTodoCmd.Flags().Int64P("id", "i", 0, "Card number")
+	deleteTodoCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	deleteTodoCmd.Flags().Int64("todo-id", 0, "Todo identifier")
+	_ = deleteTodoCmd.MarkFlagRequired("project")
+	_ = deleteTodoCmd.MarkFlagRequired("id")
+	_ = deleteTodoCmd.MarkFlagRequired("todo-id")
+
+	todoCmd.AddCommand(addTodoCmd, listTodosCmd, doneTodoCmd, undoTodoCmd, editTodoCmd, deleteTodoCmd)
+
+	acceptanceCmd := &cobra.Command{
+		Use:     "acceptance",
+		Aliases: []string{"ac"},
+		Short:   "Manage acceptance criteria checklists.",
+		Long:    "Add, list, complete, uncomplete, edit, reorder, and remove acceptance criteria on a card.",
+	}
+
+	addAcceptanceCmd := &cobra.Command{
+		Use:     "add",
+		Aliases: []string{"new"},
+		Short:   "Add acceptance criterion to a card.",
+		Example: strings.TrimSpace(`kanban card acceptance add --project alpha --id 1 --body "Requirement A"
+kanban card ac new -p alpha -i 1 -b "Requirement B"`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			bodyRaw, _ := cmd.Flags().GetString("body")
+			body := apiclient.AddAcceptanceCriterionRequest{Text: strings.TrimSpace(bodyRaw)}
+			resp, reqErr := client.AddAcceptanceCriterion(context.Background(), strings.TrimSpace(project), id, &apiclient.AddAcceptanceCriterionParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	addAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	addAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	addAcceptanceCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	addAcceptanceCmd.Flags().StringP("body", "b", "", "Acceptance criterion text")
+	_ = addAcceptanceCmd.MarkFlagRequired("project")
+	_ = addAcceptanceCmd.MarkFlagRequired("id")
+	_ = addAcceptanceCmd.MarkFlagRequired("body")
+
+	listAcceptanceCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List acceptance criteria on a card.",
+		Example: strings.TrimSpace(`kanban card acceptance list --project alpha --id 1
+kanban card ac ls -p alpha -i 1`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			resp, reqErr := client.ListAcceptanceCriteria(context.Background(), strings.TrimSpace(project), id)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	listAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	listAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	_ = listAcceptanceCmd.MarkFlagRequired("project")
+	_ = listAcceptanceCmd.MarkFlagRequired("id")
+
+	doneAcceptanceCmd := &cobra.Command{
+		Use:   "done",
+		Short: "Mark an acceptance criterion as completed.",
+		Example: strings.TrimSpace(`kanban card acceptance done --project alpha --id 1 --criterion-id 2
+kanban card ac done -p alpha -i 1 --criterion-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setAcceptanceCriterionCompleted(runtime, stdout, handle, wrapErr, cmd, true)
+		},
+	}
+	doneAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	doneAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	doneAcceptanceCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	doneAcceptanceCmd.Flags().Int64("criterion-id", 0, "Acceptance criterion identifier")
+	_ = doneAcceptanceCmd.MarkFlagRequired("project")
+	_ = doneAcceptanceCmd.MarkFlagRequired("id")
+	_ = doneAcceptanceCmd.MarkFlagRequired("criterion-id")
+
+	undoAcceptanceCmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Mark an acceptance criterion as not completed.",
+		Example: strings.TrimSpace(`kanban card acceptance undo --project alpha --id 1 --criterion-id 2
+kanban card ac undo -p alpha -i 1 --criterion-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setAcceptanceCriterionCompleted(runtime, stdout, handle, wrapErr, cmd, false)
+		},
+	}
+	undoAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	undoAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	undoAcceptanceCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	undoAcceptanceCmd.Flags().Int64("criterion-id", 0, "Acceptance criterion identifier")
+	_ = undoAcceptanceCmd.MarkFlagRequired("project")
+	_ = undoAcceptanceCmd.MarkFlagRequired("id")
+	_ = undoAcceptanceCmd.MarkFlagRequired("criterion-id")
+
+	editAcceptanceCmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Change an acceptance criterion's text or position.",
+		Example: strings.TrimSpace(`kanban card acceptance edit --project alpha --id 1 --criterion-id 2 --body "Requirement B2"
+kanban card ac edit -p alpha -i 1 --criterion-id 2 --position 1`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			text, position, err := checklistEditFields(cmd)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			criterionID, _ := cmd.Flags().GetInt64("criterion-id")
+			body := apiclient.UpdateAcceptanceCriterionRequest{Text: text, Position: position}
+			resp, reqErr := client.UpdateAcceptanceCriterion(context.Background(), strings.TrimSpace(project), id, criterionID, &apiclient.UpdateAcceptanceCriterionParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	editAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	editAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	editAcceptanceCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	editAcceptanceCmd.Flags().Int64("criterion-id", 0, "Acceptance criterion identifier")
+	editAcceptanceCmd.Flags().StringP("body", "b", "", "New acceptance criterion text")
+	editAcceptanceCmd.Flags().Int64("position", 0, "New 1-based position in the acceptance criteria")
+	_ = editAcceptanceCmd.MarkFlagRequired("project")
+	_ = editAcceptanceCmd.MarkFlagRequired("id")
+	_ = editAcceptanceCmd.MarkFlagRequired("criterion-id")
+
+	deleteAcceptanceCmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm", "remove"},
+		Short:   "Delete acceptance criterion from a card.",
+		Example: strings.TrimSpace(`kanban card acceptance delete --project alpha --id 1 --criterion-id 2
+kanban card ac rm -p alpha -i 1 --criterion-id 2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			criterionID, _ := cmd.Flags().GetInt64("criterion-id")
+			resp, reqErr := client.DeleteAcceptanceCriterion(context.Background(), strings.TrimSpace(project), id, criterionID, &apiclient.DeleteAcceptanceCriterionParams{IfMatch: ifMatch(cmd)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	deleteAcceptanceCmd.Flags().StringP("project", "p", "", "Project slug")
+	deleteAcceptanceCmd.Flags().Int64P("id", "i", 0, "Card number")
+	deleteAcceptanceCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	deleteAcceptanceCmd.Flags().Int64("criterion-id", 0, "Acceptance criterion identifier")
+	_ = deleteAcceptanceCmd.MarkFlagRequired("project")
+	_ = deleteAcceptanceCmd.MarkFlagRequired("id")
+	_ = deleteAcceptanceCmd.MarkFlagRequired("criterion-id")
+
+	acceptanceCmd.AddCommand(addAcceptanceCmd, listAcceptanceCmd, doneAcceptanceCmd, undoAcceptanceCmd, editAcceptanceCmd, deleteAcceptanceCmd)
+
+	labelCmd := &cobra.Command{
+		Use:     "label",
+		Aliases: []string{"labels"},
+		Short:   "Manage card labels.",
+		Long:    "Add and remove labels on a card. Labels are lowercase and may contain letters, digits, '.', '_' and '-'.",
+	}
+
+	addLabelCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a label to a card.",
+		Example: strings.TrimSpace(`kanban card label add --project alpha --id 1 --label bug
+kanban card labels add -p alpha -i 1 -l needs-design`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			label, _ := cmd.Flags().GetString("label")
+			body := apiclient.AddCardLabelRequest{Label: strings.TrimSpace(label)}
+			resp, reqErr := client.AddCardLabel(context.Background(), strings.TrimSpace(project), id, &apiclient.AddCardLabelParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	addLabelCmd.Flags().StringP("project", "p", "", "Project slug")
+	addLabelCmd.Flags().Int64P("id", "i", 0, "Card number")
+	addLabelCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	addLabelCmd.Flags().StringP("label", "l", "", "Label to add")
+	_ = addLabelCmd.MarkFlagRequired("project")
+	_ = addLabelCmd.MarkFlagRequired("id")
+	_ = addLabelCmd.MarkFlagRequired("label")
+
+	removeLabelCmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Short:   "Remove a label from a card.",
+		Example: strings.TrimSpace(`kanban card label remove --project alpha --id 1 --label bug
+kanban card labels rm -p alpha -i 1 -l needs-design`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			label, _ := cmd.Flags().GetString("label")
+			resp, reqErr := client.RemoveCardLabel(context.Background(), strings.TrimSpace(project), id, strings.TrimSpace(label), &apiclient.RemoveCardLabelParams{IfMatch: ifMatch(cmd)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	removeLabelCmd.Flags().StringP("project", "p", "", "Project slug")
+	removeLabelCmd.Flags().Int64P("id", "i", 0, "Card number")
+	removeLabelCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	removeLabelCmd.Flags().StringP("label", "l", "", "Label to remove")
+	_ = removeLabelCmd.MarkFlagRequired("project")
+	_ = removeLabelCmd.MarkFlagRequired("id")
+	_ = removeLabelCmd.MarkFlagRequired("label")
+
+	labelCmd.AddCommand(addLabelCmd, removeLabelCmd)
+
+	attachCmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Attach a file to a card.",
+		Long:  "Upload a file as a card attachment. Uploading a name the card already has replaces that attachment.",
+		Example: strings.TrimSpace(`kanban card attach --project alpha --id 1 --file ./build.log
+kanban card attach -p alpha -i 1 -f ./screenshot.png --name login-error.png`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			path, _ := cmd.Flags().GetString("file")
+			name, _ := cmd.Flags().GetString("name")
+			contentType, _ := cmd.Flags().GetString("content-type")
+			body, formContentType, err := attachmentUploadBody(strings.TrimSpace(path), strings.TrimSpace(name), strings.TrimSpace(contentType))
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+			resp, reqErr := client.UploadAttachmentWithBody(context.Background(), strings.TrimSpace(project), id, &apiclient.UploadAttachmentParams{IfMatch: ifMatch(cmd)}, formContentType, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	attachCmd.Flags().StringP("project", "p", "", "Project slug")
+	attachCmd.Flags().Int64P("id", "i", 0, "Card number")
+	attachCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	attachCmd.Flags().StringP("file", "f", "", "Path of the file to upload")
+	attachCmd.Flags().StringP("name", "n", "", "Attachment filename (defaults to the file's base name)")
+	attachCmd.Flags().String("content-type", "", "Content type (detected by the server when omitted)")
+	_ = attachCmd.MarkFlagRequired("project")
+	_ = attachCmd.MarkFlagRequired("id")
+	_ = attachCmd.MarkFlagRequired("file")
+
+	attachmentsCmd := &cobra.Command{
+		Use:     "attachments",
+		Aliases: []string{"attachment"},
+		Short:   "List, download and delete card attachments.",
+		Long:    "List the attachments of a card with filename, size, content type and sha256. Subcommands download or delete one attachment.",
+		Example: strings.TrimSpace(`kanban card attachments --project alpha --id 1
+kanban card attachments download -p alpha -i 1 -n build.log -o ./build.log
+kanban card attachments rm -p alpha -i 1 -n build.log`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			resp, reqErr := client.ListAttachments(context.Background(), strings.TrimSpace(project), id)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	attachmentsCmd.Flags().StringP("project", "p", "", "Project slug")
+	attachmentsCmd.Flags().Int64P("id", "i", 0, "Card number")
+	_ = attachmentsCmd.MarkFlagRequired("project")
+	_ = attachmentsCmd.MarkFlagRequired("id")
+
+	downloadAttachmentCmd := &cobra.Command{
+		Use:     "download",
+		Aliases: []string{"get"},
+		Short:   "Download a card attachment.",
+		Long:    "Save an attachment to a file, by default its own filename in the current directory. Use --out - to write it to stdout.",
+		Example: strings.TrimSpace(`kanban card attachments download --project alpha --id 1 --name build.log
+kanban card attachments get -p alpha -i 1 -n build.log -o - | tail`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			name, _ := cmd.Flags().GetString("name")
+			out, _ := cmd.Flags().GetString("out")
+			resp, reqErr := client.DownloadAttachment(context.Background(), strings.TrimSpace(project), id, strings.TrimSpace(name))
+			if reqErr != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				return handle(runtime.Output(), stdout, resp, reqErr)
+			}
+			defer resp.Body.Close()
+			return saveAttachment(resp.Body, stdout, strings.TrimSpace(name), strings.TrimSpace(out), wrapErr)
+		},
+	}
+	downloadAttachmentCmd.Flags().StringP("project", "p", "", "Project slug")
+	downloadAttachmentCmd.Flags().Int64P("id", "i", 0, "Card number")
+	downloadAttachmentCmd.Flags().StringP("name", "n", "", "Attachment filename")
+	downloadAttachmentCmd.Flags().StringP("out", "o", "", "Destination path, or - for stdout")
+	_ = downloadAttachmentCmd.MarkFlagRequired("project")
+	_ = downloadAttachmentCmd.MarkFlagRequired("id")
+	_ = downloadAttachmentCmd.MarkFlagRequired("name")
+
+	deleteAttachmentCmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a card attachment.",
+		Example: strings.TrimSpace(`kanban card attachments delete --project alpha --id 1 --name build.log
+kanban card attachments rm -p alpha -i 1 -n build.log`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			name, _ := cmd.Flags().GetString("name")
+			resp, reqErr := client.DeleteAttachment(context.Background(), strings.TrimSpace(project), id, strings.TrimSpace(name), &apiclient.DeleteAttachmentParams{IfMatch: ifMatch(cmd)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	deleteAttachmentCmd.Flags().StringP("project", "p", "", "Project slug")
+	deleteAttachmentCmd.Flags().Int64P("id", "i", 0, "Card number")
+	deleteAttachmentCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	deleteAttachmentCmd.Flags().StringP("name", "n", "", "Attachment filename")
+	_ = deleteAttachmentCmd.MarkFlagRequired("project")
+	_ = deleteAttachmentCmd.MarkFlagRequired("id")
+	_ = deleteAttachmentCmd.MarkFlagRequired("name")
+
+	attachmentsCmd.AddCommand(downloadAttachmentCmd, deleteAttachmentCmd)
+
+	relationCmd := &cobra.Command{
+		Use:     "relation",
+		Aliases: []string{"relations", "rel"},
+		Short:   "Manage card relations.",
+		Long:    "Relate a card to another card, in any project. Types are blocks, blocked_by, relates_to, duplicates and duplicated_by; the other card records the inverse type.",
+	}
+
+	addRelationCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Relate a card to another card.",
+		Example: strings.TrimSpace(`kanban card relation add --project alpha --id 7 --type blocked_by --card alpha/card-4
+kanban card rel add -p alpha -i 7 -t relates_to -c beta/card-2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			relationType, _ := cmd.Flags().GetString("type")
+			target, _ := cmd.Flags().GetString("card")
+			body := apiclient.AddCardRelationRequest{Type: strings.TrimSpace(relationType), CardId: strings.TrimSpace(target)}
+			resp, reqErr := client.AddCardRelation(context.Background(), strings.TrimSpace(project), id, &apiclient.AddCardRelationParams{IfMatch: ifMatch(cmd)}, body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	addRelationCmd.Flags().StringP("project", "p", "", "Project slug")
+	addRelationCmd.Flags().Int64P("id", "i", 0, "Card number")
+	addRelationCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	addRelationCmd.Flags().StringP("type", "t", "", "Relation type (blocks|blocked_by|relates_to|duplicates|duplicated_by)")
+	addRelationCmd.Flags().StringP("card", "c", "", "Related card id (<project>/card-<number>)")
+	_ = addRelationCmd.MarkFlagRequired("project")
+	_ = addRelationCmd.MarkFlagRequired("id")
+	_ = addRelationCmd.MarkFlagRequired("type")
+	_ = addRelationCmd.MarkFlagRequired("card")
+
+	removeRelationCmd := &cobra.Command{
+		Use:     "remove",
+		Aliases: []string{"rm"},
+		Short:   "Remove a relation between two cards.",
+		Example: strings.TrimSpace(`kanban card relation remove --project alpha --id 7 --type blocked_by --card alpha/card-4
+kanban card rel rm -p alpha -i 7 -t relates_to -c beta/card-2`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			project, _ := cmd.Flags().GetString("project")
+			id, _ := cmd.Flags().GetInt64("id")
+			relationType, _ := cmd.Flags().GetString("type")
+			target, _ := cmd.Flags().GetString("card")
+			targetProject, targetNumber, err := model.ParseCardID(target)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+			resp, reqErr := client.RemoveCardRelation(context.Background(), strings.TrimSpace(project), id, strings.TrimSpace(relationType), targetProject, int64(targetNumber), &apiclient.RemoveCardRelationParams{IfMatch: ifMatch(cmd)})
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	removeRelationCmd.Flags().StringP("project", "p", "", "Project slug")
+	removeRelationCmd.Flags().Int64P("id", "i", 0, "Card number")
+	removeRelationCmd.Flags().Int64("if-match", 0, "Only apply if the card is still at this revision")
+	removeRelationCmd.Flags().StringP("type", "t", "", "Relation type (blocks|blocked_by|relates_to|duplicates|duplicated_by)")
+	removeRelationCmd.Flags().StringP("card", "c", "", "Related card id (<project>/card-<number>)")
+	_ = removeRelationCmd.MarkFlagRequired("project")
+	_ = removeRelationCmd.MarkFlagRequired("id")
+	_ = removeRelationCmd.MarkFlagRequired("type")
+	_ = removeRelationCmd.MarkFlagRequired("card")
+
+	relationCmd.AddCommand(addRelationCmd, removeRelationCmd)
+
+	cardCmd.AddCommand(createCmd, listCmd, getCmd, treeCmd, editCmd, moveCmd, commentCmd, describeCmd, branchCmd, priorityCmd, dueCmd, todoCmd, acceptanceCmd, labelCmd, relationCmd, attachCmd, attachmentsCmd, deleteCmd, restoreCmd, transferCmd)
+	return cardCmd
+}
+
+// ifMatch turns --if-match into an If-Match header value; unset means the
+// write is unconditional.
+// attachmentUploadBody builds the multipart form for an attachment upload.
+func attachmentUploadBody(path, name, contentType string) (io.Reader, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if name == "" {
+		name = filepath.Base(path)
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": name}))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return &body, writer.FormDataContentType(), nil
+}
+
+// saveAttachment writes a downloaded attachment to out, defaulting to its
+// filename, and reports where it went. "-" streams it to stdout instead.
+func saveAttachment(body io.Reader, stdout io.Writer, name, out string, wrapErr common.WrapErrorFunc) error {
+	if out == "-" {
+		if _, err := io.Copy(stdout, body); err != nil {
+			return wrapErr(http.StatusBadGateway, err.Error())
+		}
+		return nil
+	}
+	if out == "" {
+		out = filepath.Base(name)
+	}
+	file, err := os.Create(out)
+	if err != nil {
+		return wrapErr(http.StatusBadRequest, err.Error())
+	}
+	size, err := io.Copy(file, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return wrapErr(http.StatusBadGateway, err.Error())
+	}
+	encoded, _ := json.Marshal(map[string]any{"filename": name, "path": out, "size": size})
+	_, _ = fmt.Fprintln(stdout, string(encoded))
+	return nil
+}
+
+func ifMatch(cmd *cobra.Command) *string {
+	revision, _ := cmd.Flags().GetInt64("if-match")
+	if revision <= 0 {
+		return nil
+	}
+	value := strconv.Quote(strconv.FormatInt(revision, 10))
+	return &value
+}
+
+// checklistEditFields reads the --body and --position flags of the todo and
+// acceptance edit commands; at least one of them must be given.
+func checklistEditFields(cmd *cobra.Command) (*string, *int64, error) {
+	var (
+		text     *string
+		position *int64
+	)
+	if cmd.Flags().Changed("body") {
+		value, _ := cmd.Flags().GetString("body")
+		value = strings.TrimSpace(value)
+		text = &value
+	}
+	if cmd.Flags().Changed("position") {
+		value, _ := cmd.Flags().GetInt64("position")
+		position = &value
+	}
+	if text == nil && position == nil {
+		return nil, nil, fmt.Errorf("at least one of --body or --position is required")
+	}
+	return text, position, nil
+}
+
+func setTodoCompleted(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc, cmd *cobra.Command, completed bool) error {
+	client, err := common.NewClient(runtime)
+	if err != nil {
+		return wrapErr(http.StatusBadRequest, err.Error())
+	}
+
+	project, _ := cmd.Flags().GetString("project")
+	id, _ := cmd.Flags().GetInt64("id")
+	todoID, _ := cmd.Flags().GetInt64("todo-id")
+	body := apiclient.UpdateTodoRequest{Completed: &completed}
+	resp, reqErr := client.UpdateTodo(context.Background(), strings.TrimSpace(project), id, todoID, &apiclient.UpdateTodoParams{IfMatch: ifMatch(cmd)}, body)
+	return handle(runtime.Output(), stdout, resp, reqErr)
+}
+
+func setAcceptanceCriterionCompleted(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc, cmd *cobra.Command, completed bool) error {
+	client, err := common.NewClient(runtime)
+	if err != nil {
+		return wrapErr(http.StatusBadRequest, err.Error())
+	}
+
+	project, _ := cmd.Flags().GetString("project")
+	id, _ := cmd.Flags().GetInt64("id")
+	criterionID, _ := cmd.Flags().GetInt64("criterion-id")
+	body := apiclient.UpdateAcceptanceCriterionRequest{Completed: &completed}
+	resp, reqErr := client.UpdateAcceptanceCriterion(context.Background(), strings.TrimSpace(project), id, criterionID, &apiclient.UpdateAcceptanceCriterionParams{IfMatch: ifMatch(cmd)}, body)
+	return handle(runtime.Output(), stdout, resp, reqErr)
+}
@@ -0,0 +1,344 @@
+package projectcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apiclient "github.com/simonjohansson/kanban/backend/gen/client"
+	"github.com/simonjohansson/kanban/backend/internal/kanban/commands/common"
+	"github.com/spf13/cobra"
+)
+
+func New(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc) *cobra.Command {
+	projectCmd := &cobra.Command{
+		Use:     "project",
+		Aliases: []string{"projects", "proj"},
+		Short:   "Manage projects.",
+		Long:    "Create, list, update, and delete projects.",
+	}
+
+	createCmd := &cobra.Command{
+		Use:     "create",
+		Aliases: []string{"new"},
+		Short:   "Create a project.",
+		Long:    "Create a project with optional repository metadata.",
+		Example: strings.TrimSpace(`kanban project create --name "Alpha"
+kanban proj new -n "Alpha" --local-path /work/alpha --remote-url git@github.com:org/alpha.git`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			name, _ := cmd.Flags().GetString("name")
+			localPath, _ := cmd.Flags().GetString("local-path")
+			remoteURL, _ := cmd.Flags().GetString("remote-url")
+
+			body := apiclient.CreateProjectRequest{Name: strings.TrimSpace(name)}
+			if value := strings.TrimSpace(localPath); value != "" {
+				body.LocalPath = &value
+			}
+			if value := strings.TrimSpace(remoteURL); value != "" {
+				body.RemoteUrl = &value
+			}
+
+			resp, reqErr := client.CreateProject(context.Background(), body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	createCmd.Flags().StringP("name", "n", "", "Project display name")
+	createCmd.Flags().String("local-path", "", "Local repository path")
+	createCmd.Flags().String("remote-url", "", "Remote repository URL")
+	_ = createCmd.MarkFlagRequired("name")
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List projects.",
+		Long:    "List all projects known by the backend.",
+		Example: strings.TrimSpace(`kanban project list
+kanban proj ls`),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.ListProjects(context.Background())
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	updateCmd := &cobra.Command{
+		Use:     "update <project-slug>",
+		Aliases: []string{"edit"},
+		Short:   "Update project metadata.",
+		Long:    "Update the name, repository metadata or WIP limits of a project. Only the flags you pass are changed; pass an empty value to clear a path or URL, and a limit of 0 to remove a WIP limit. The slug never changes.",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project update alpha --local-path /work/alpha
+kanban proj edit alpha --remote-url git@github.com:org/alpha-renamed.git
+kanban project update alpha --wip-limit Doing=3 --wip-limit Review=0`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			body := apiclient.UpdateProjectRequest{}
+			if cmd.Flags().Changed("name") {
+				value, _ := cmd.Flags().GetString("name")
+				value = strings.TrimSpace(value)
+				body.Name = &value
+			}
+			if cmd.Flags().Changed("local-path") {
+				value, _ := cmd.Flags().GetString("local-path")
+				value = strings.TrimSpace(value)
+				body.LocalPath = &value
+			}
+			if cmd.Flags().Changed("remote-url") {
+				value, _ := cmd.Flags().GetString("remote-url")
+				value = strings.TrimSpace(value)
+				body.RemoteUrl = &value
+			}
+			if limits, _ := cmd.Flags().GetStringArray("wip-limit"); len(limits) > 0 {
+				wipLimits := make(map[string]int64, len(limits))
+				for _, limit := range limits {
+					status, value, ok := strings.Cut(limit, "=")
+					n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+					if !ok || strings.TrimSpace(status) == "" || err != nil {
+						return wrapErr(http.StatusBadRequest, "--wip-limit must look like Status=N")
+					}
+					wipLimits[strings.TrimSpace(status)] = n
+				}
+				body.WipLimits = &wipLimits
+			}
+			if body.Name == nil && body.LocalPath == nil && body.RemoteUrl == nil && body.WipLimits == nil {
+				return wrapErr(http.StatusBadRequest, "at least one of --name, --local-path, --remote-url or --wip-limit is required")
+			}
+
+			resp, reqErr := client.UpdateProject(context.Background(), strings.TrimSpace(args[0]), body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	updateCmd.Flags().StringP("name", "n", "", "New project display name")
+	updateCmd.Flags().String("local-path", "", "New local repository path")
+	updateCmd.Flags().String("remote-url", "", "New remote repository URL")
+	updateCmd.Flags().StringArray("wip-limit", nil, "WIP limit as Status=N; 0 removes it (repeatable)")
+
+	deleteCmd := &cobra.Command{
+		Use:     "delete <project-slug>",
+		Aliases: []string{"rm", "remove"},
+		Short:   "Delete a project.",
+		Long:    "Delete a project by slug. The project directory is moved to the trash, where it can be restored until it is purged.",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project delete alpha
+kanban proj rm alpha`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.DeleteProject(context.Background(), strings.TrimSpace(args[0]))
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	projectCmd.AddCommand(createCmd, listCmd, updateCmd, deleteCmd, newStatusesCommand(runtime, stdout, handle, wrapErr), newRulesCommand(runtime, stdout, handle, wrapErr), newTrashCommand(runtime, stdout, handle, wrapErr))
+	return projectCmd
+}
+
+func newStatusesCommand(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc) *cobra.Command {
+	statusesCmd := &cobra.Command{
+		Use:     "statuses",
+		Aliases: []string{"workflow"},
+		Short:   "Manage a project's workflow statuses.",
+		Long:    "Show or replace the ordered statuses cards in a project move through. The first status is where work starts and the last counts as done.",
+	}
+
+	showCmd := &cobra.Command{
+		Use:     "show <project-slug>",
+		Aliases: []string{"get"},
+		Short:   "Show a project's statuses.",
+		Long:    "Show the ordered workflow statuses of a project.",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project statuses show alpha`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.GetProjectStatuses(context.Background(), strings.TrimSpace(args[0]))
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set <project-slug>",
+		Short: "Replace a project's statuses.",
+		Long:  "Replace the workflow statuses of a project, in order. Cards in a status that is dropped must be moved with --map; the backend refuses the change otherwise.",
+		Args:  cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project statuses set alpha --status Backlog --status Doing --status Review --status Done --map Todo=Backlog
+kanban proj workflow set alpha -s Todo -s Doing -s Shipped --map Review=Doing --map Done=Shipped`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			statuses, _ := cmd.Flags().GetStringArray("status")
+			mappings, _ := cmd.Flags().GetStringArray("map")
+			body := apiclient.SetProjectStatusesRequest{Statuses: statuses}
+			if len(mappings) > 0 {
+				statusMap := make(map[string]string, len(mappings))
+				for _, mapping := range mappings {
+					from, to, ok := strings.Cut(mapping, "=")
+					if !ok || strings.TrimSpace(from) == "" || strings.TrimSpace(to) == "" {
+						return wrapErr(http.StatusBadRequest, "--map must look like Old=New")
+					}
+					statusMap[strings.TrimSpace(from)] = strings.TrimSpace(to)
+				}
+				body.StatusMap = &statusMap
+			}
+
+			resp, reqErr := client.SetProjectStatuses(context.Background(), strings.TrimSpace(args[0]), body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	setCmd.Flags().StringArrayP("status", "s", nil, "Workflow status, in order (repeatable)")
+	setCmd.Flags().StringArray("map", nil, "Move cards from a dropped status, as Old=New (repeatable)")
+	_ = setCmd.MarkFlagRequired("status")
+
+	statusesCmd.AddCommand(showCmd, setCmd)
+	return statusesCmd
+}
+
+func newRulesCommand(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc) *cobra.Command {
+	rulesCmd := &cobra.Command{
+		Use:     "rules",
+		Aliases: []string{"transition-rules"},
+		Short:   "Manage a project's transition rules.",
+		Long:    "Manage the conditions cards must meet to enter a status. Moves that break a rule are refused unless forced; forced moves are recorded in the card history.",
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set <project-slug>",
+		Short: "Replace a project's transition rules.",
+		Long:  "Replace all transition rules of a project. Each --rule reads FROM->TO:CONDITION[,CONDITION...], where FROM may be * for any status and conditions are acceptance_criteria_completed, todos_completed or branch. Without --rule, all rules are removed.",
+		Args:  cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project rules set alpha --rule "Review->Done:acceptance_criteria_completed,todos_completed" --rule "*->Review:branch"
+kanban proj rules set alpha`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			values, _ := cmd.Flags().GetStringArray("rule")
+			body := apiclient.SetTransitionRulesRequest{Rules: make([]apiclient.TransitionRule, 0, len(values))}
+			for _, value := range values {
+				rule, err := parseTransitionRule(value)
+				if err != nil {
+					return wrapErr(http.StatusBadRequest, err.Error())
+				}
+				body.Rules = append(body.Rules, rule)
+			}
+
+			resp, reqErr := client.SetProjectTransitionRules(context.Background(), strings.TrimSpace(args[0]), body)
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+	setCmd.Flags().StringArray("rule", nil, "Rule as FROM->TO:CONDITION[,CONDITION...] (repeatable)")
+
+	rulesCmd.AddCommand(setCmd)
+	return rulesCmd
+}
+
+// parseTransitionRule reads a rule written as FROM->TO:CONDITION[,CONDITION...].
+func parseTransitionRule(value string) (apiclient.TransitionRule, error) {
+	transition, conditions, ok := strings.Cut(value, ":")
+	from, to, arrow := strings.Cut(transition, "->")
+	if !ok || !arrow || strings.TrimSpace(to) == "" || strings.TrimSpace(conditions) == "" {
+		return apiclient.TransitionRule{}, fmt.Errorf("--rule %q must look like FROM->TO:CONDITION[,CONDITION...]", value)
+	}
+	rule := apiclient.TransitionRule{To: strings.TrimSpace(to)}
+	if from = strings.TrimSpace(from); from != "" && from != "*" {
+		rule.From = &from
+	}
+	for condition := range strings.SplitSeq(conditions, ",") {
+		if condition = strings.TrimSpace(condition); condition != "" {
+			rule.Requires = append(rule.Requires, condition)
+		}
+	}
+	return rule, nil
+}
+
+func newTrashCommand(runtime common.Runtime, stdout io.Writer, handle common.HandleResponseFunc, wrapErr common.WrapErrorFunc) *cobra.Command {
+	trashCmd := &cobra.Command{
+		Use:   "trash",
+		Short: "Manage deleted projects.",
+		Long:  "List, restore, and purge projects that were deleted. Trash entries older than the configured retention are purged by the backend.",
+	}
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List deleted projects.",
+		Long:    "List deleted projects in the trash, most recently deleted first.",
+		Example: strings.TrimSpace(`kanban project trash list
+kanban proj trash ls`),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.ListTrashedProjects(context.Background())
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	restoreCmd := &cobra.Command{
+		Use:     "restore <trash-id>",
+		Aliases: []string{"undelete"},
+		Short:   "Restore a deleted project.",
+		Long:    "Restore a deleted project and its cards under the original slug. Fails if a project with that slug exists.",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project trash restore alpha-20260101T120000.000Z`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.RestoreTrashedProject(context.Background(), strings.TrimSpace(args[0]))
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	purgeCmd := &cobra.Command{
+		Use:     "purge <trash-id>",
+		Aliases: []string{"rm"},
+		Short:   "Permanently delete a trashed project.",
+		Long:    "Permanently delete a project from the trash. This cannot be undone.",
+		Args:    cobra.ExactArgs(1),
+		Example: strings.TrimSpace(`kanban project trash purge alpha-20260101T120000.000Z`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			client, err := common.NewClient(runtime)
+			if err != nil {
+				return wrapErr(http.StatusBadRequest, err.Error())
+			}
+
+			resp, reqErr := client.PurgeTrashedProject(context.Background(), strings.TrimSpace(args[0]))
+			return handle(runtime.Output(), stdout, resp, reqErr)
+		},
+	}
+
+	trashCmd.AddCommand(listCmd, restoreCmd, purgeCmd)
+	return trashCmd
+}
@@ -0,0 +1,112 @@
+package kanban
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3
+
+type diffLine struct {
+	kind byte
+	text string
+	a, b int
+}
+
+// unifiedDiff renders the line changes from before to after as a unified
+// diff of name. Files are small, so a plain LCS table is good enough.
+func unifiedDiff(name, before, after string) string {
+	lines := diffLines(splitDiffLines(before), splitDiffLines(after))
+
+	var changed []int
+	for i, line := range lines {
+		if line.kind != ' ' {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- a/%s\n+++ b/%s\n", name, name)
+	for i := 0; i < len(changed); {
+		j := i
+		for j+1 < len(changed) && changed[j+1]-changed[j] <= 2*diffContext {
+			j++
+		}
+		start := max(0, changed[i]-diffContext)
+		end := min(len(lines), changed[j]+diffContext+1)
+		writeDiffHunk(&out, lines[start:end])
+		i = j + 1
+	}
+	return out.String()
+}
+
+func writeDiffHunk(out *strings.Builder, hunk []diffLine) {
+	aCount, bCount := 0, 0
+	for _, line := range hunk {
+		if line.kind != '+' {
+			aCount++
+		}
+		if line.kind != '-' {
+			bCount++
+		}
+	}
+	aStart, bStart := hunk[0].a, hunk[0].b
+	if aCount > 0 {
+		aStart++
+	}
+	if bCount > 0 {
+		bStart++
+	}
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount)
+	for _, line := range hunk {
+		out.WriteByte(line.kind)
+		out.WriteString(line.text)
+		out.WriteByte('\n')
+	}
+}
+
+// diffLines aligns a and b on their longest common subsequence, listing
+// removals before additions.
+func diffLines(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{kind: ' ', text: a[i], a: i, b: j})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{kind: '-', text: a[i], a: i, b: j})
+			i++
+		default:
+			lines = append(lines, diffLine{kind: '+', text: b[j], a: i, b: j})
+			j++
+		}
+	}
+	return lines
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
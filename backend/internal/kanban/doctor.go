@@ -0,0 +1,115 @@
+package kanban
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/simonjohansson/kanban/backend/internal/store"
+	"github.com/spf13/cobra"
+)
+
+func newDoctorCommand(cfg *Config, stdout io.Writer) *cobra.Command {
+	cardsPath := cfg.CardsPath
+	fix := false
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the markdown data directory for problems.",
+		Long: strings.TrimSpace(`Reads every project.md and card-N.md under the cards path and reports files
+that do not parse, card ids or numbers that disagree with their filename,
+next_card_seq values at or below an existing card, duplicate todo or
+acceptance criterion ids, and temp files left by interrupted writes.
+
+--fix repairs what it safely can: unparsable files are moved to .quarantine/
+under the cards path, mismatched fields are taken from the filename, and
+leftover temp files are deleted. The command exits non-zero while problems
+remain. It works on the files directly and needs no running server; --fix
+refuses to run while a server has the cards path open.`),
+		Example: strings.TrimSpace(`kanban doctor
+kanban doctor --fix
+kanban --output json doctor --cards-path /tmp/kanban/cards`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			markdownStore, err := openCardsStore(cmd, cfg, cardsPath, !fix)
+			if err != nil {
+				return err
+			}
+			defer markdownStore.Close()
+			report, err := markdownStore.Doctor(fix)
+			if err != nil {
+				return &cliError{status: http.StatusInternalServerError, message: err.Error()}
+			}
+			if err := printDoctorReport(cfg.Output, stdout, report); err != nil {
+				return &cliError{status: http.StatusInternalServerError, message: err.Error()}
+			}
+			if unfixed := report.Unfixed(); unfixed > 0 {
+				msg := fmt.Sprintf("%d problem(s) found; run kanban doctor --fix to repair them", unfixed)
+				return &cliError{status: http.StatusUnprocessableEntity, message: msg}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cardsPath, "cards-path", cardsPath, "directory for markdown source-of-truth files")
+	cmd.Flags().BoolVar(&fix, "fix", false, "repair the problems that can be repaired safely")
+	return cmd
+}
+
+// openCardsStore opens the markdown store of a command that works on the
+// files directly. The --cards-path flag wins over the configured path. A
+// read-only store works next to a running server; a writable one fails with
+// 409 while a server has the directory open.
+func openCardsStore(cmd *cobra.Command, cfg *Config, cardsPath string, readOnly bool) (*store.MarkdownStore, error) {
+	path := strings.TrimSpace(cardsPath)
+	if !cmd.Flags().Changed("cards-path") {
+		path = strings.TrimSpace(cfg.CardsPath)
+	}
+	if path == "" {
+		return nil, &cliError{status: http.StatusBadRequest, message: "--cards-path cannot be empty"}
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, &cliError{status: http.StatusNotFound, message: err.Error()}
+	}
+	open := store.NewMarkdownStore
+	if readOnly {
+		open = store.NewReadOnlyMarkdownStore
+	}
+	markdownStore, err := open(path)
+	if err != nil {
+		if errors.Is(err, store.ErrDataDirLocked) {
+			return nil, &cliError{status: http.StatusConflict, message: err.Error()}
+		}
+		return nil, &cliError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	return markdownStore, nil
+}
+
+func printDoctorReport(output Output, stdout io.Writer, report store.DoctorReport) error {
+	if output == OutputJSON {
+		raw, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, string(raw))
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "checked %d project(s), %d card(s)\n", report.ProjectsChecked, report.CardsChecked)
+	for _, issue := range report.Issues {
+		action := "fix: "
+		if issue.Fixed {
+			action = "fixed: "
+		}
+		fmt.Fprintf(&b, "%s %s: %s (%s%s)\n", issue.Path, issue.Kind, issue.Detail, action, issue.Repair)
+	}
+	if len(report.Issues) == 0 {
+		b.WriteString("no problems found\n")
+	}
+	_, err := io.WriteString(stdout, b.String())
+	return err
+}
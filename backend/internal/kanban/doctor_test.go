@@ -0,0 +1,78 @@
+package kanban
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/simonjohansson/kanban/backend/internal/store"
+)
+
+func TestDoctorCommandReportsAndFixes(t *testing.T) {
+	t.Parallel()
+
+	cardsPath := t.TempDir()
+	markdownStore, err := store.NewMarkdownStore(cardsPath)
+	require.NoError(t, err)
+	_, err = markdownStore.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = markdownStore.CreateCard("alpha", "Fine", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(cardsPath, "projects", "alpha", "card-2.md"), []byte("no frontmatter"), 0o644))
+
+	run := func(output Output, args ...string) (string, error) {
+		t.Helper()
+		var out bytes.Buffer
+		cmd := newDoctorCommand(&Config{Output: output, CardsPath: cardsPath}, &out)
+		cmd.SetArgs(args)
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	out, err := run(OutputText)
+	var cErr *cliError
+	require.True(t, asCLIError(err, &cErr))
+	require.Equal(t, http.StatusUnprocessableEntity, cErr.status)
+	require.Contains(t, out, "checked 1 project(s), 1 card(s)")
+	require.Contains(t, out, "projects/alpha/card-2.md parse_error: missing frontmatter (fix: move to .quarantine/")
+
+	// Checking works next to an open store; fixing waits for it to close.
+	_, err = run(OutputText, "--fix")
+	require.True(t, asCLIError(err, &cErr))
+	require.Equal(t, http.StatusConflict, cErr.status)
+	require.Contains(t, cErr.message, fmt.Sprintf("locked by process %d", os.Getpid()))
+	require.NoError(t, markdownStore.Close())
+
+	out, err = run(OutputJSON, "--fix")
+	require.NoError(t, err)
+	var report store.DoctorReport
+	require.NoError(t, json.Unmarshal([]byte(out), &report))
+	require.Len(t, report.Issues, 2)
+	for _, issue := range report.Issues {
+		require.True(t, issue.Fixed, issue.Kind)
+	}
+
+	out, err = run(OutputText)
+	require.NoError(t, err)
+	require.Contains(t, out, "no problems found")
+}
+
+func TestDoctorCommandRequiresExistingCardsPath(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	cmd := newDoctorCommand(&Config{Output: OutputText}, &out)
+	cmd.SetArgs([]string{"--cards-path", filepath.Join(t.TempDir(), "missing")})
+	err := cmd.Execute()
+	var cErr *cliError
+	require.True(t, asCLIError(err, &cErr))
+	require.Equal(t, http.StatusNotFound, cErr.status)
+}
@@ -0,0 +1,94 @@
+package kanban
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/simonjohansson/kanban/backend/internal/store"
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCommand(cfg *Config, stdout io.Writer) *cobra.Command {
+	cardsPath := cfg.CardsPath
+	dryRun := false
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade markdown files to the current format version.",
+		Long: strings.TrimSpace(`Rewrites every project.md and card-N.md whose format_version is older than
+the current one in the current format. Older files are still read and are
+upgraded when the server starts; migrate makes the upgrade explicit, for
+example before committing the cards directory.
+
+--dry-run writes nothing and prints a diff of each file that would change.
+Revisions and history are left alone. It works on the files directly and
+needs no running server; without --dry-run it refuses to run while a server
+has the cards path open.`),
+		Example: strings.TrimSpace(`kanban migrate --dry-run
+kanban migrate
+kanban --output json migrate --cards-path /tmp/kanban/cards`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			markdownStore, err := openCardsStore(cmd, cfg, cardsPath, dryRun)
+			if err != nil {
+				return err
+			}
+			defer markdownStore.Close()
+			migrated, err := markdownStore.Migrate(dryRun)
+			if err != nil {
+				return &cliError{status: http.StatusInternalServerError, message: err.Error()}
+			}
+			if err := printMigrated(cfg.Output, stdout, migrated, dryRun); err != nil {
+				return &cliError{status: http.StatusInternalServerError, message: err.Error()}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cardsPath, "cards-path", cardsPath, "directory for markdown source-of-truth files")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print a diff of the upgrades without writing them")
+	return cmd
+}
+
+func printMigrated(output Output, stdout io.Writer, migrated []store.MigratedFile, dryRun bool) error {
+	if output == OutputJSON {
+		type migratedFile struct {
+			store.MigratedFile
+			Diff string `json:"diff,omitempty"`
+		}
+		files := make([]migratedFile, 0, len(migrated))
+		for _, file := range migrated {
+			entry := migratedFile{MigratedFile: file}
+			if dryRun {
+				entry.Diff = unifiedDiff(file.Path, file.Before, file.After)
+			}
+			files = append(files, entry)
+		}
+		raw, err := json.Marshal(map[string]any{"dry_run": dryRun, "files": files})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, string(raw))
+		return err
+	}
+
+	var b strings.Builder
+	for _, file := range migrated {
+		fmt.Fprintf(&b, "%s: format %d -> %d\n", file.Path, file.FromVersion, file.ToVersion)
+		if dryRun {
+			b.WriteString(unifiedDiff(file.Path, file.Before, file.After))
+		}
+	}
+	switch {
+	case len(migrated) == 0:
+		b.WriteString("all files are in the current format\n")
+	case dryRun:
+		fmt.Fprintf(&b, "%d file(s) would be upgraded\n", len(migrated))
+	default:
+		fmt.Fprintf(&b, "%d file(s) upgraded\n", len(migrated))
+	}
+	_, err := io.WriteString(stdout, b.String())
+	return err
+}
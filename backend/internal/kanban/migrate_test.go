@@ -0,0 +1,63 @@
+package kanban
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateCommandDryRunPrintsDiff(t *testing.T) {
+	t.Parallel()
+
+	cardsPath := t.TempDir()
+	projectDir := filepath.Join(cardsPath, "projects", "alpha")
+	require.NoError(t, os.MkdirAll(projectDir, 0o755))
+	legacy := "---\nname: Alpha\nslug: alpha\ncreated_at: 2025-01-02T10:00:00Z\nupdated_at: 2025-01-02T10:00:00Z\nnext_card_seq: 1\n---\n# Project\nAlpha\n"
+	projectPath := filepath.Join(projectDir, "project.md")
+	require.NoError(t, os.WriteFile(projectPath, []byte(legacy), 0o644))
+
+	run := func(args ...string) string {
+		t.Helper()
+		var out bytes.Buffer
+		cmd := newMigrateCommand(&Config{Output: OutputText, CardsPath: cardsPath}, &out)
+		cmd.SetArgs(args)
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	out := run("--dry-run")
+	require.Equal(t, `projects/alpha/project.md: format 0 -> 2
+--- a/projects/alpha/project.md
++++ b/projects/alpha/project.md
+@@ -1,4 +1,5 @@
+ ---
++format_version: 2
+ name: Alpha
+ slug: alpha
+ created_at: 2025-01-02T10:00:00Z
+1 file(s) would be upgraded
+`, out)
+	require.Equal(t, legacy, string(readTestFile(t, projectPath)))
+
+	require.Contains(t, run(), "1 file(s) upgraded")
+	require.Contains(t, string(readTestFile(t, projectPath)), "format_version: 2\n")
+	require.Equal(t, "all files are in the current format\n", run())
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, unifiedDiff("same", "a\nb\n", "a\nb\n"))
+	require.Equal(t, "--- a/f\n+++ b/f\n@@ -1,2 +1,2 @@\n-a\n+x\n b\n", unifiedDiff("f", "a\nb\n", "x\nb\n"))
+	require.Equal(t, "--- a/f\n+++ b/f\n@@ -0,0 +1,1 @@\n+new\n", unifiedDiff("f", "", "new\n"))
+}
+
+func readTestFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
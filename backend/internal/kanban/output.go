@@ -0,0 +1,725 @@
+package kanban
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type Output string
+
+const (
+	OutputText Output = "text"
+	OutputJSON Output = "json"
+)
+
+type cliError struct {
+	status  int
+	message string
+	rawJSON []byte
+}
+
+func (e *cliError) Error() string {
+	return e.message
+}
+
+func isValidOutput(v string) bool {
+	return v == string(OutputText) || v == string(OutputJSON)
+}
+
+func FormatError(output Output, status int, message string) string {
+	msg := strings.TrimSpace(message)
+	if msg == "" {
+		msg = http.StatusText(status)
+	}
+
+	if output == OutputJSON {
+		payload := map[string]any{
+			"status": status,
+			"error":  msg,
+		}
+		raw, _ := json.Marshal(payload)
+		return string(raw)
+	}
+
+	return fmt.Sprintf("error (%d): %s", status, msg)
+}
+
+func handleResponse(output Output, stdout io.Writer, resp *http.Response, reqErr error) error {
+	if reqErr != nil {
+		return &cliError{status: http.StatusBadGateway, message: reqErr.Error()}
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &cliError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return preconditionFailedError(output, raw)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := strings.TrimSpace(extractErrorMessage(raw))
+		if msg == "" {
+			msg = strings.TrimSpace(string(raw))
+		}
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		if output == OutputJSON && json.Valid(raw) {
+			return &cliError{status: resp.StatusCode, message: msg, rawJSON: compactJSON(raw)}
+		}
+		return &cliError{status: resp.StatusCode, message: msg}
+	}
+
+	if output == OutputJSON {
+		trimmed := strings.TrimSpace(string(raw))
+		if trimmed == "" {
+			_, _ = fmt.Fprintln(stdout, "{}")
+			return nil
+		}
+		if json.Valid(raw) {
+			_, _ = fmt.Fprintln(stdout, string(compactJSON(raw)))
+			return nil
+		}
+
+		encoded, _ := json.Marshal(map[string]any{"result": trimmed})
+		_, _ = fmt.Fprintln(stdout, string(encoded))
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" {
+		_, _ = fmt.Fprintln(stdout, "ok")
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(stdout, trimmed)
+	return nil
+}
+
+// preconditionFailedError reports a write rejected because --if-match no longer
+// matches. The server answers with the card as it is now; JSON output passes
+// it through under "current" so callers can retry against the new revision.
+func preconditionFailedError(output Output, raw []byte) *cliError {
+	msg := "card changed since the given revision"
+	var current struct {
+		ID       string `json:"id"`
+		Revision int    `json:"revision"`
+	}
+	if err := json.Unmarshal(raw, &current); err == nil && current.Revision > 0 {
+		msg = fmt.Sprintf("card %s changed: current revision is %d", current.ID, current.Revision)
+	}
+
+	cErr := &cliError{status: http.StatusPreconditionFailed, message: msg}
+	if output == OutputJSON && json.Valid(raw) {
+		cErr.rawJSON, _ = json.Marshal(map[string]any{
+			"status":  http.StatusPreconditionFailed,
+			"error":   msg,
+			"current": json.RawMessage(compactJSON(raw)),
+		})
+	}
+	return cErr
+}
+
+func extractErrorMessage(raw []byte) string {
+	var obj map[string]any
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return ""
+	}
+
+	if value, ok := obj["detail"].(string); ok && strings.TrimSpace(value) != "" {
+		return value
+	}
+	if value, ok := obj["title"].(string); ok && strings.TrimSpace(value) != "" {
+		return value
+	}
+	if value, ok := obj["error"].(string); ok && strings.TrimSpace(value) != "" {
+		return value
+	}
+	return ""
+}
+
+func compactJSON(raw []byte) []byte {
+	var out bytes.Buffer
+	if err := json.Compact(&out, raw); err != nil {
+		return raw
+	}
+	return out.Bytes()
+}
+
+func asCLIError(err error, target **cliError) bool {
+	e, ok := err.(*cliError)
+	if !ok {
+		return false
+	}
+	*target = e
+	return true
+}
+
+func FormatWatchLine(output Output, event map[string]any) (string, error) {
+	if output == OutputJSON {
+		raw, err := json.Marshal(event)
+		if err != nil {
+			return "", err
+		}
+		return string(raw), nil
+	}
+
+	parts := make([]string, 0, 4)
+	if value, ok := event["type"]; ok {
+		parts = append(parts, fmt.Sprintf("type=%v", value))
+	}
+	if value, ok := event["project"]; ok {
+		parts = append(parts, fmt.Sprintf("project=%v", value))
+	}
+	if value, ok := event["card_id"]; ok && fmt.Sprintf("%v", value) != "" {
+		parts = append(parts, fmt.Sprintf("card_id=%v", value))
+	}
+	if value, ok := event["card_number"]; ok {
+		parts = append(parts, fmt.Sprintf("card_number=%v", value))
+	}
+	if len(parts) == 0 {
+		return "(event)", nil
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+func printPrimer(output Output, stdout io.Writer) error {
+	executionRules := []string{
+		"Prefer `--output json` for any command whose output will be parsed.",
+		"Card operations are project-scoped and must include `--project` (`-p`).",
+		"Single-card operations must include `--id` (`-i`).",
+		"Use `card todo` and `card acceptance` commands for actionable checklists (not `card desc`).",
+		"Use project slug (for example `alpha`) in command arguments.",
+		"`watch` is long-running and must be explicitly stopped by the caller.",
+		"Pass the card `revision` as `--if-match` on writes that must not clobber concurrent edits; a stale revision fails with status 412 and the current card.",
+	}
+
+	commandTemplates := map[string]string{
+		"list_projects":                 "kanban --output json project ls",
+		"create_project":                "kanban --output json project create --name \"$NAME\"",
+		"update_project":                "kanban --output json project update \"$PROJECT\" [--name \"$NAME\"] [--local-path \"$LOCAL_PATH\"] [--remote-url \"$REMOTE_URL\"] [--wip-limit \"$STATUS=$LIMIT\"]",
+		"delete_project":                "kanban --output json project rm \"$PROJECT\"",
+		"list_trashed_projects":         "kanban --output json project trash ls",
+		"restore_trashed_project":       "kanban --output json project trash restore \"$TRASH_ID\"",
+		"purge_trashed_project":         "kanban --output json project trash purge \"$TRASH_ID\"",
+		"get_project_statuses":          "kanban --output json project statuses show \"$PROJECT\"",
+		"set_transition_rules":          "kanban --output json project rules set \"$PROJECT\" --rule \"$FROM->$TO:$CONDITIONS\" [--rule ...]",
+		"set_project_statuses":          "kanban --output json project statuses set \"$PROJECT\" -s \"$STATUS\" [-s \"$STATUS\" ...] [--map \"$OLD=$NEW\"]",
+		"list_cards":                    "kanban --output json card ls -p \"$PROJECT\"",
+		"list_cards_include_deleted":    "kanban --output json card ls -p \"$PROJECT\" --include-deleted",
+		"list_cards_by_label":           "kanban --output json card ls -p \"$PROJECT\" --label \"$LABEL\"",
+		"list_cards_sorted":             "kanban --output json card ls -p \"$PROJECT\" --sort \"$SORT\"",
+		"list_overdue_cards":            "kanban --output json card ls -p \"$PROJECT\" --overdue",
+		"create_card":                   "kanban --output json card create -p \"$PROJECT\" -t \"$TITLE\" -s \"$STATUS\" [--branch \"$BRANCH\"] [--force]",
+		"get_card":                      "kanban --output json card get -p \"$PROJECT\" -i \"$ID\"",
+		"create_child_card":             "kanban --output json card create -p \"$PROJECT\" -t \"$TITLE\" -s \"$STATUS\" --parent \"$PARENT_CARD_ID\"",
+		"card_tree":                     "kanban --output json card tree -p \"$PROJECT\" -i \"$ID\"",
+		"edit_card":                     "kanban --output json card edit -p \"$PROJECT\" -i \"$ID\" [-t \"$TITLE\"] [--branch \"$BRANCH\"] [-s \"$STATUS\"] [--force]",
+		"move_card":                     "kanban --output json card move -p \"$PROJECT\" -i \"$ID\" -s \"$STATUS\" [--force]",
+		"reorder_card":                  "kanban --output json card move -p \"$PROJECT\" -i \"$ID\" -s \"$STATUS\" --before \"$OTHER_ID\"|--after \"$OTHER_ID\"",
+		"comment_card":                  "kanban --output json card comment -p \"$PROJECT\" -i \"$ID\" -b \"$BODY\"",
+		"describe_card":                 "kanban --output json card desc -p \"$PROJECT\" -i \"$ID\" -b \"$BODY\"",
+		"list_todos":                    "kanban --output json card todo ls -p \"$PROJECT\" -i \"$ID\"",
+		"add_todo":                      "kanban --output json card todo add -p \"$PROJECT\" -i \"$ID\" -b \"$TEXT\"",
+		"complete_todo":                 "kanban --output json card todo done -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"undo_todo":                     "kanban --output json card todo undo -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"edit_todo":                     "kanban --output json card todo edit -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\" [-b \"$TEXT\"] [--position \"$POSITION\"]",
+		"delete_todo":                   "kanban --output json card todo rm -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"list_acceptance_criteria":      "kanban --output json card acceptance ls -p \"$PROJECT\" -i \"$ID\"",
+		"add_acceptance_criterion":      "kanban --output json card acceptance add -p \"$PROJECT\" -i \"$ID\" -b \"$TEXT\"",
+		"complete_acceptance_criterion": "kanban --output json card acceptance done -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"undo_acceptance_criterion":     "kanban --output json card acceptance undo -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"edit_acceptance_criterion":     "kanban --output json card acceptance edit -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\" [-b \"$TEXT\"] [--position \"$POSITION\"]",
+		"delete_acceptance_criterion":   "kanban --output json card acceptance rm -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"add_label":                     "kanban --output json card label add -p \"$PROJECT\" -i \"$ID\" -l \"$LABEL\"",
+		"remove_label":                  "kanban --output json card label rm -p \"$PROJECT\" -i \"$ID\" -l \"$LABEL\"",
+		"add_relation":                  "kanban --output json card relation add -p \"$PROJECT\" -i \"$ID\" -t \"$RELATION_TYPE\" -c \"$CARD_ID\"",
+		"remove_relation":               "kanban --output json card relation rm -p \"$PROJECT\" -i \"$ID\" -t \"$RELATION_TYPE\" -c \"$CARD_ID\"",
+		"attach_file":                   "kanban --output json card attach -p \"$PROJECT\" -i \"$ID\" -f \"$FILE\"",
+		"list_attachments":              "kanban --output json card attachments -p \"$PROJECT\" -i \"$ID\"",
+		"download_attachment":           "kanban --output json card attachments download -p \"$PROJECT\" -i \"$ID\" -n \"$FILENAME\" -o \"$PATH\"",
+		"delete_attachment":             "kanban --output json card attachments rm -p \"$PROJECT\" -i \"$ID\" -n \"$FILENAME\"",
+		"set_branch":                    "kanban --output json card branch -p \"$PROJECT\" -i \"$ID\" -b \"$BRANCH\"",
+		"set_priority":                  "kanban --output json card priority -p \"$PROJECT\" -i \"$ID\" --priority \"$PRIORITY\"",
+		"set_due":                       "kanban --output json card due -p \"$PROJECT\" -i \"$ID\" --due \"$DUE\"",
+		"delete_card":                   "kanban --output json card rm -p \"$PROJECT\" -i \"$ID\" [--hard]",
+		"restore_card":                  "kanban --output json card restore -p \"$PROJECT\" -i \"$ID\"",
+		"transfer_card":                 "kanban --output json card transfer -p \"$PROJECT\" -i \"$ID\" --to \"$TARGET_PROJECT\"",
+		"watch_events":                  "kanban --output json watch -p \"$PROJECT\"",
+		"check_data":                    "kanban --output json doctor [--fix] [--cards-path \"$CARDS_PATH\"]",
+		"migrate_data":                  "kanban --output json migrate [--dry-run] [--cards-path \"$CARDS_PATH\"]",
+	}
+
+	responseShapes := map[string]any{
+		"create_project": map[string]any{
+			"name":          "Alpha",
+			"slug":          "alpha",
+			"next_card_seq": 1,
+		},
+		"create_card": map[string]any{
+			"id":      "alpha/card-1",
+			"project": "alpha",
+			"number":  1,
+			"branch":  "feature/task",
+			"status":  "Todo",
+			"title":   "Task",
+		},
+		"add_todo": map[string]any{
+			"id":        1,
+			"text":      "Write tests",
+			"completed": false,
+		},
+		"list_todos": map[string]any{
+			"todos": []any{
+				map[string]any{"id": 1, "text": "Write tests", "completed": false},
+			},
+		},
+		"add_acceptance_criterion": map[string]any{
+			"id":        1,
+			"text":      "Requirement A",
+			"completed": false,
+		},
+		"list_acceptance_criteria": map[string]any{
+			"acceptance_criteria": []any{
+				map[string]any{"id": 1, "text": "Requirement A", "completed": false},
+			},
+		},
+		"get_card": map[string]any{
+			"id":       "alpha/card-1",
+			"project":  "alpha",
+			"number":   1,
+			"title":    "Task",
+			"branch":   "feature/task-v2",
+			"status":   "Doing",
+			"revision": 3,
+			"description": []any{
+				map[string]any{"timestamp": "2026-02-20T12:00:00Z", "body": "Initial context"},
+			},
+			"comments": []any{
+				map[string]any{"timestamp": "2026-02-20T12:05:00Z", "body": "Looks good"},
+			},
+			"todos": []any{
+				map[string]any{"id": 1, "text": "Write tests", "completed": false},
+			},
+			"acceptance_criteria": []any{
+				map[string]any{"id": 1, "text": "Requirement A", "completed": false},
+			},
+			"history": []any{
+				map[string]any{"timestamp": "2026-02-20T12:00:00Z", "type": "card.created", "details": "status=Todo"},
+				map[string]any{"timestamp": "2026-02-20T12:10:00Z", "type": "card.moved", "details": "status=Doing"},
+			},
+		},
+		"list_cards": map[string]any{
+			"cards": []any{
+				map[string]any{
+					"id":                                  "alpha/card-1",
+					"project":                             "alpha",
+					"number":                              1,
+					"title":                               "Task",
+					"branch":                              "feature/task-v2",
+					"status":                              "Todo",
+					"deleted":                             false,
+					"revision":                            3,
+					"comments_count":                      1,
+					"history_count":                       2,
+					"todos_count":                         1,
+					"todos_completed_count":               0,
+					"acceptance_criteria_count":           1,
+					"acceptance_criteria_completed_count": 0,
+				},
+			},
+		},
+	}
+
+	errorShape := map[string]any{
+		"backend_problem_json": map[string]any{
+			"type":   "about:blank",
+			"title":  "Unprocessable Entity",
+			"status": 422,
+			"detail": "validation error detail",
+		},
+		"cli_fallback_json": map[string]any{
+			"status": 502,
+			"error":  "gateway or CLI processing error",
+		},
+		"stale_revision_json": map[string]any{
+			"status":  412,
+			"error":   "card alpha/card-1 changed: current revision is 4",
+			"current": map[string]any{"id": "alpha/card-1", "revision": 4},
+		},
+	}
+
+	deleteSemantics := map[string]any{
+		"soft_delete_default": true,
+		"hard_delete_flag":    true,
+		"soft_delete_effect":  "card remains queryable when --include-deleted is enabled",
+		"hard_delete_effect":  "card is permanently removed",
+		"restore_supported":   true,
+		"restore_effect":      "clears the deleted flag of a soft-deleted card; hard-deleted cards cannot be restored",
+	}
+
+	descSemantics := map[string]any{
+		"mode":      "append",
+		"read_via":  "kanban --output json card get -p \"$PROJECT\" -i \"$ID\"",
+		"not_a_get": true,
+		"notes":     "`notes` on a card is the hand-written `# Notes` section of its markdown file; it is read-only over the API",
+	}
+
+	todoSemantics := map[string]any{
+		"model":                     "card todos are first-class items with fields {id:int,text:string,completed:bool}",
+		"id_scope":                  "todo IDs are scoped per card",
+		"id_stability":              "todo IDs are never reused within a card",
+		"create_order_preserved":    true,
+		"reorder":                   "card todo edit --position <n> moves a todo to that 1-based position; its id is unchanged",
+		"mutation_surface":          "CLI mutates todos via card todo add/done/undo/edit/rm",
+		"non_cli_clients":           "web and macOS clients render todos read-only",
+		"use_description_for_todos": false,
+	}
+
+	acceptanceSemantics := map[string]any{
+		"model":                  "card acceptance criteria are first-class items with fields {id:int,text:string,completed:bool}",
+		"id_scope":               "acceptance criterion IDs are scoped per card",
+		"id_stability":           "acceptance criterion IDs are never reused within a card",
+		"create_order_preserved": true,
+		"reorder":                "card acceptance edit --position <n> moves a criterion to that 1-based position; its id is unchanged",
+		"mutation_surface":       "CLI mutates acceptance criteria via card acceptance add/done/undo/edit/rm (alias: card ac ...)",
+		"non_cli_clients":        "web and macOS clients render acceptance criteria read-only",
+		"use_description_for_acceptance_criteria": false,
+	}
+
+	labelSemantics := map[string]any{
+		"format":           "lowercase letters, digits, '.', '_' and '-'; input is lowercased",
+		"order":            "labels are stored sorted and without duplicates",
+		"filter":           "card ls --label returns cards carrying that exact label",
+		"mutation_surface": "CLI mutates labels via card label add/rm",
+	}
+
+	scheduleSemantics := map[string]any{
+		"priority":     "P0 (most urgent) to P3; empty clears it and cards may have none",
+		"due_at":       "RFC 3339 timestamp in UTC; card due accepts YYYY-MM-DD (end of that day, UTC) or RFC 3339, empty clears it",
+		"sort_orders":  []string{"rank", "number", "priority", "due", "updated"},
+		"sort_missing": "cards without a priority or due date sort last",
+		"overdue":      "card ls --overdue returns cards whose due_at has passed and whose status is not the project's done status",
+	}
+
+	rankSemantics := map[string]any{
+		"model":        "card rank is a string ordering the card within its status; compare ranks byte-wise",
+		"default_sort": "card ls lists cards by status in workflow order, then rank (the board order)",
+		"placement":    "card move --before/--after <number> places the card next to another card of the target status; without them a card entering a status goes to the bottom and one staying keeps its place",
+		"events":       "a move within the same status publishes card.reordered; a status change publishes card.moved",
+	}
+
+	relationSemantics := map[string]any{
+		"types":         []string{"blocks", "blocked_by", "relates_to", "duplicates", "duplicated_by"},
+		"inverse":       "each relation is stored on both cards; the other card gets the inverse type (blocks<->blocked_by, duplicates<->duplicated_by, relates_to<->relates_to)",
+		"card_argument": "-c/--card takes a card_id (<project-slug>/card-<number>) and may name a card in another project",
+		"blocked":       "card ls reports blocked=true while a blocked_by card is neither in its project's done status nor deleted",
+		"move_guard":    "card move or card edit -s taking a blocked card out of its project's first status to any status but the done one fails with status 409 unless --force is given",
+		"delete_effect": "hard delete removes the relation from the other card; transfer repoints it at the new card_id",
+	}
+
+	attachmentSemantics := map[string]any{
+		"storage":         "blobs live in projects/<slug>/attachments/card-<number>/; the card frontmatter lists filename, size, content_type and sha256",
+		"filename":        "a single path segment that does not start with '.'; --name overrides the uploaded file's base name",
+		"replace":         "attaching a filename the card already has replaces that attachment",
+		"max_size_bytes":  32 << 20,
+		"download_output": "card attachments download writes the file (default: its filename in the current directory) and prints {filename,path,size}; -o - writes the raw content to stdout",
+		"delete_effect":   "soft delete keeps attachments; hard delete removes them; transfer moves them to the new card",
+	}
+
+	wipLimitSemantics := map[string]any{
+		"storage":     "optional per-status limits in the wip_limits map of project.md; statuses without a limit are unlimited",
+		"set":         "project update --wip-limit Status=N sets a limit; N=0 removes it",
+		"enforcement": "card create, card move and card edit -s into a status already holding its limit of live cards fail with status 409 unless --force is given",
+		"history":     "a card forced past a status's limit gets a card.wip_limit.exceeded history event; unforced writes over it are refused and change nothing",
+		"listing":     "card ls returns the project's limits as wip_limits next to cards",
+	}
+
+	transitionRuleSemantics := map[string]any{
+		"model":      "project transition_rules entries {from?:status,to:status,requires:[condition]}; a rule without from applies to moves from any status",
+		"conditions": []string{"acceptance_criteria_completed", "todos_completed", "branch"},
+		"rule_flag":  "project rules set --rule FROM->TO:CONDITION[,CONDITION...] replaces all rules; FROM may be *; no --rule removes them",
+		"violation":  "card move or card edit -s that breaks a rule fails with status 400 listing every unmet condition",
+		"override":   "card move --force or card edit -s --force moves anyway and adds a card.transition.overridden history event naming the unmet conditions; unforced moves never add it",
+	}
+
+	parentSemantics := map[string]any{
+		"parent_argument": "card create --parent takes a card_id (<project-slug>/card-<number>) of a live card, possibly in another project",
+		"summary_fields":  "card ls reports parent_id plus children_done/children_total, counting live children and those in their project's done status",
+		"tree":            "card tree returns the card with a children array, recursively; every node carries its own rollup",
+		"delete_effect":   "hard deleting a parent detaches its children; transferring it repoints them at the new card_id",
+	}
+
+	projectCommandSupport := map[string]any{
+		"supported":        []string{"project create", "project ls", "project update", "project rm", "project statuses show", "project statuses set", "project rules set", "project trash ls", "project trash restore", "project trash purge"},
+		"rename_supported": false,
+		"edit_supported":   true,
+		"editable_fields":  []string{"name", "local_path", "remote_url", "wip_limits"},
+		"delete_effect":    "project rm moves the project to the trash; restore it with project trash restore until it is purged",
+		"trash_id_shape":   "<project-slug>-<YYYYMMDDTHHMMSS.mmmZ>",
+	}
+
+	watchEventShape := map[string]any{
+		"type":        "card.created",
+		"project":     "alpha",
+		"card_id":     "alpha/card-1",
+		"card_number": 1,
+		"timestamp":   "2026-02-20T12:34:56Z",
+	}
+
+	statusRules := map[string]any{
+		"allowed":                            "the statuses of the card's project; see project statuses show",
+		"default_statuses":                   []string{"Todo", "Doing", "Review", "Done"},
+		"done_status":                        "the last status of a project counts as done; the second is where work starts",
+		"can_create_in_any_allowed_status":   true,
+		"status_required_for_create_command": true,
+		"replace":                            "project statuses set refuses to drop a status cards still use unless --map Old=New moves them",
+		"transfer":                           "card transfer keeps the status if the target project has it, maps done to done, and otherwise uses the target's first status",
+	}
+
+	idSemantics := map[string]any{
+		"card_id":     "card identifier string (<project-slug>/card-<number>)",
+		"card_number": "project-scoped integer sequence (1,2,3...)",
+		"id_argument": "all --id/-i flags expect card_number, not card_id",
+		"transfer":    "card transfer re-files a card under a new number in the target project; the old card is a deleted tombstone whose moved_to is the new card_id and card get on it follows the redirect",
+	}
+
+	if output == OutputJSON {
+		payload := map[string]any{
+			"name":                  "kanban",
+			"mode":                  "machine",
+			"purpose":               "HTTP-only kanban automation client.",
+			"default_output":        "json",
+			"default_card_statuses": []string{"Todo", "Doing", "Review", "Done"},
+			"usage": map[string]any{
+				"global_flags": []string{"--server-url", "--output"},
+				"commands": []string{
+					"project create|list|update|delete|statuses|rules|trash",
+					"card create|get|tree|list|edit|move|comment|describe|delete|restore|transfer",
+					"card todo add|list|done|undo|edit|delete",
+					"card acceptance add|list|done|undo|edit|delete",
+					"card label add|remove",
+					"card relation add|remove",
+					"card attach",
+					"card attachments [download|delete]",
+					"card branch",
+					"card priority",
+					"card due",
+					"watch [--project <slug>]",
+					"doctor [--fix]",
+					"migrate [--dry-run]",
+					"primer",
+				},
+			},
+			"execution_rules":           executionRules,
+			"command_templates":         commandTemplates,
+			"response_shapes":           responseShapes,
+			"id_semantics":              idSemantics,
+			"error_shape":               errorShape,
+			"delete_semantics":          deleteSemantics,
+			"desc_semantics":            descSemantics,
+			"todo_semantics":            todoSemantics,
+			"acceptance_semantics":      acceptanceSemantics,
+			"label_semantics":           labelSemantics,
+			"schedule_semantics":        scheduleSemantics,
+			"rank_semantics":            rankSemantics,
+			"relation_semantics":        relationSemantics,
+			"parent_semantics":          parentSemantics,
+			"wip_limit_semantics":       wipLimitSemantics,
+			"transition_rule_semantics": transitionRuleSemantics,
+			"attachment_semantics":      attachmentSemantics,
+			"project_command_support":   projectCommandSupport,
+			"watch_event_shape":         watchEventShape,
+			"status_rules":              statusRules,
+			"agent_prompt": strings.Join([]string{
+				"You are an automation agent controlling Kanban through the `kanban` CLI.",
+				"Prefer deterministic, scriptable invocations and parse JSON output.",
+				"Use `kanban --output json project ls` to discover project slugs before card operations.",
+				"Use only statuses of the card's project; read them with `kanban --output json project statuses show <slug>` (default: Todo, Doing, Review, Done).",
+			}, "\n"),
+		}
+		raw, _ := json.Marshal(payload)
+		_, _ = fmt.Fprintln(stdout, string(raw))
+		return nil
+	}
+
+	text := strings.Join([]string{
+		"KANBAN AGENT PRIMER (MACHINE MODE)",
+		"",
+		"SYSTEM PROMPT",
+		"You are an automation agent controlling the `kanban` CLI.",
+		"Produce deterministic commands and prefer machine-readable output.",
+		"",
+		"EXECUTION RULES",
+		"1. Always prefer `--output json` when output is parsed by tools.",
+		"2. Card commands require `--project` (`-p`).",
+		"3. Single-card commands require `--id` (`-i`).",
+		"4. Use `card todo` and `card acceptance` commands for checklists; do not store checklist items in `card desc`.",
+		"5. Use project slug, not display name.",
+		"6. Card statuses are per project (default: Todo | Doing | Review | Done); read them with `project statuses show`.",
+		"7. `watch` is long-running and must be interrupted by caller.",
+		"",
+		"COMMAND TEMPLATES",
+		"LIST_PROJECTS: kanban --output json project ls",
+		"CREATE_PROJECT: kanban --output json project create --name \"$NAME\"",
+		"UPDATE_PROJECT: kanban --output json project update \"$PROJECT\" [--name \"$NAME\"] [--local-path \"$LOCAL_PATH\"] [--remote-url \"$REMOTE_URL\"] [--wip-limit \"$STATUS=$LIMIT\"]",
+		"DELETE_PROJECT: kanban --output json project rm \"$PROJECT\"",
+		"LIST_TRASHED_PROJECTS: kanban --output json project trash ls",
+		"RESTORE_TRASHED_PROJECT: kanban --output json project trash restore \"$TRASH_ID\"",
+		"PURGE_TRASHED_PROJECT: kanban --output json project trash purge \"$TRASH_ID\"",
+		"GET_PROJECT_STATUSES: kanban --output json project statuses show \"$PROJECT\"",
+		"SET_TRANSITION_RULES: kanban --output json project rules set \"$PROJECT\" --rule \"$FROM->$TO:$CONDITIONS\" [--rule ...]",
+		"SET_PROJECT_STATUSES: kanban --output json project statuses set \"$PROJECT\" -s \"$STATUS\" [-s \"$STATUS\" ...] [--map \"$OLD=$NEW\"]",
+		"LIST_CARDS: kanban --output json card ls -p \"$PROJECT\"",
+		"LIST_CARDS_WITH_DELETED: kanban --output json card ls -p \"$PROJECT\" --include-deleted",
+		"LIST_CARDS_BY_LABEL: kanban --output json card ls -p \"$PROJECT\" --label \"$LABEL\"",
+		"LIST_CARDS_SORTED: kanban --output json card ls -p \"$PROJECT\" --sort \"$SORT\"",
+		"LIST_OVERDUE_CARDS: kanban --output json card ls -p \"$PROJECT\" --overdue",
+		"CREATE_CARD: kanban --output json card create -p \"$PROJECT\" -t \"$TITLE\" -s \"$STATUS\" [--branch \"$BRANCH\"] [--force]",
+		"GET_CARD: kanban --output json card get -p \"$PROJECT\" -i \"$ID\"",
+		"CREATE_CHILD_CARD: kanban --output json card create -p \"$PROJECT\" -t \"$TITLE\" -s \"$STATUS\" --parent \"$PARENT_CARD_ID\"",
+		"CARD_TREE: kanban --output json card tree -p \"$PROJECT\" -i \"$ID\"",
+		"EDIT_CARD: kanban --output json card edit -p \"$PROJECT\" -i \"$ID\" [-t \"$TITLE\"] [--branch \"$BRANCH\"] [-s \"$STATUS\"] [--force]",
+		"MOVE_CARD: kanban --output json card move -p \"$PROJECT\" -i \"$ID\" -s \"$STATUS\" [--force]",
+		"REORDER_CARD: kanban --output json card move -p \"$PROJECT\" -i \"$ID\" -s \"$STATUS\" --before \"$OTHER_ID\"|--after \"$OTHER_ID\"",
+		"COMMENT_CARD: kanban --output json card comment -p \"$PROJECT\" -i \"$ID\" -b \"$BODY\"",
+		"DESCRIBE_CARD: kanban --output json card desc -p \"$PROJECT\" -i \"$ID\" -b \"$BODY\"",
+		"LIST_TODOS: kanban --output json card todo ls -p \"$PROJECT\" -i \"$ID\"",
+		"ADD_TODO: kanban --output json card todo add -p \"$PROJECT\" -i \"$ID\" -b \"$TEXT\"",
+		"COMPLETE_TODO: kanban --output json card todo done -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"UNDO_TODO: kanban --output json card todo undo -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"EDIT_TODO: kanban --output json card todo edit -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\" [-b \"$TEXT\"] [--position \"$POSITION\"]",
+		"DELETE_TODO: kanban --output json card todo rm -p \"$PROJECT\" -i \"$ID\" --todo-id \"$TODO_ID\"",
+		"LIST_ACCEPTANCE_CRITERIA: kanban --output json card acceptance ls -p \"$PROJECT\" -i \"$ID\"",
+		"ADD_ACCEPTANCE_CRITERION: kanban --output json card acceptance add -p \"$PROJECT\" -i \"$ID\" -b \"$TEXT\"",
+		"COMPLETE_ACCEPTANCE_CRITERION: kanban --output json card acceptance done -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"UNDO_ACCEPTANCE_CRITERION: kanban --output json card acceptance undo -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"EDIT_ACCEPTANCE_CRITERION: kanban --output json card acceptance edit -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\" [-b \"$TEXT\"] [--position \"$POSITION\"]",
+		"DELETE_ACCEPTANCE_CRITERION: kanban --output json card acceptance rm -p \"$PROJECT\" -i \"$ID\" --criterion-id \"$CRITERION_ID\"",
+		"ADD_LABEL: kanban --output json card label add -p \"$PROJECT\" -i \"$ID\" -l \"$LABEL\"",
+		"REMOVE_LABEL: kanban --output json card label rm -p \"$PROJECT\" -i \"$ID\" -l \"$LABEL\"",
+		"ADD_RELATION: kanban --output json card relation add -p \"$PROJECT\" -i \"$ID\" -t \"$RELATION_TYPE\" -c \"$CARD_ID\"",
+		"REMOVE_RELATION: kanban --output json card relation rm -p \"$PROJECT\" -i \"$ID\" -t \"$RELATION_TYPE\" -c \"$CARD_ID\"",
+		"ATTACH_FILE: kanban --output json card attach -p \"$PROJECT\" -i \"$ID\" -f \"$FILE\"",
+		"LIST_ATTACHMENTS: kanban --output json card attachments -p \"$PROJECT\" -i \"$ID\"",
+		"DOWNLOAD_ATTACHMENT: kanban --output json card attachments download -p \"$PROJECT\" -i \"$ID\" -n \"$FILENAME\" -o \"$PATH\"",
+		"DELETE_ATTACHMENT: kanban --output json card attachments rm -p \"$PROJECT\" -i \"$ID\" -n \"$FILENAME\"",
+		"SET_BRANCH: kanban --output json card branch -p \"$PROJECT\" -i \"$ID\" -b \"$BRANCH\"",
+		"SET_PRIORITY: kanban --output json card priority -p \"$PROJECT\" -i \"$ID\" --priority \"$PRIORITY\"",
+		"SET_DUE: kanban --output json card due -p \"$PROJECT\" -i \"$ID\" --due \"$DUE\"",
+		"DELETE_CARD: kanban --output json card rm -p \"$PROJECT\" -i \"$ID\" [--hard]",
+		"RESTORE_CARD: kanban --output json card restore -p \"$PROJECT\" -i \"$ID\"",
+		"TRANSFER_CARD: kanban --output json card transfer -p \"$PROJECT\" -i \"$ID\" --to \"$TARGET_PROJECT\"",
+		"WATCH_EVENTS: kanban --output json watch -p \"$PROJECT\"",
+		"CHECK_DATA: kanban --output json doctor [--fix] [--cards-path \"$CARDS_PATH\"]",
+		"MIGRATE_DATA: kanban --output json migrate [--dry-run] [--cards-path \"$CARDS_PATH\"]",
+		"",
+		"RESPONSE SHAPES",
+		"CREATE_PROJECT => {\"name\":\"Alpha\",\"slug\":\"alpha\",\"next_card_seq\":1}",
+		"CREATE_CARD => {\"id\":\"alpha/card-1\",\"project\":\"alpha\",\"number\":1,\"branch\":\"feature/task\",\"status\":\"Todo\",\"title\":\"Task\"}",
+		"ADD_TODO => {\"id\":1,\"text\":\"Write tests\",\"completed\":false}",
+		"LIST_TODOS => {\"todos\":[{\"id\":1,\"text\":\"Write tests\",\"completed\":false}]}",
+		"ADD_ACCEPTANCE_CRITERION => {\"id\":1,\"text\":\"Requirement A\",\"completed\":false}",
+		"LIST_ACCEPTANCE_CRITERIA => {\"acceptance_criteria\":[{\"id\":1,\"text\":\"Requirement A\",\"completed\":false}]}",
+		"LIST_CARDS => {\"cards\":[{\"id\":\"alpha/card-1\",\"project\":\"alpha\",\"number\":1,\"branch\":\"feature/task\",\"status\":\"Todo\",\"deleted\":false,\"comments_count\":1,\"history_count\":2,\"todos_count\":1,\"todos_completed_count\":0,\"acceptance_criteria_count\":1,\"acceptance_criteria_completed_count\":0}]}",
+		"GET_CARD => {\"id\":\"alpha/card-1\",\"project\":\"alpha\",\"number\":1,\"branch\":\"feature/task-v2\",\"status\":\"Doing\",\"description\":[{\"timestamp\":\"...\",\"body\":\"...\"}],\"todos\":[{\"id\":1,\"text\":\"Write tests\",\"completed\":false}],\"acceptance_criteria\":[{\"id\":1,\"text\":\"Requirement A\",\"completed\":false}],\"comments\":[{\"timestamp\":\"...\",\"body\":\"...\"}],\"history\":[{\"timestamp\":\"...\",\"type\":\"card.moved\",\"details\":\"...\"}]}",
+		"",
+		"CARD ID SEMANTICS",
+		"- card_id = <project-slug>/card-<number> (string).",
+		"- card_number = per-project integer sequence.",
+		"- all --id/-i arguments use card_number, not card_id.",
+		"",
+		"ERROR SHAPE",
+		"- backend problem JSON typically includes: type, title, status, detail.",
+		"- CLI fallback JSON shape: {\"status\":<int>,\"error\":\"<message>\"}.",
+		"",
+		"DELETE SEMANTICS",
+		"- default delete is soft delete (card can still be listed with --include-deleted).",
+		"- --hard => permanent delete",
+		"- `card restore` brings back a soft-deleted card; hard-deleted cards cannot be restored.",
+		"- `card transfer` leaves a deleted tombstone (moved_to) in the source project; reads of it redirect to the new card.",
+		"",
+		"DESC SEMANTICS",
+		"- `card desc` appends description text; it does not fetch current description.",
+		"- read full card details via `kanban --output json card get -p \"$PROJECT\" -i \"$ID\"`.",
+		"- do not use `card desc` for actionable checklists; use `card todo` and `card acceptance` commands.",
+		"- `notes` is the hand-written `# Notes` section of the card file; it is read-only over the API.",
+		"",
+		"TODO SEMANTICS",
+		"- todo model: {id:int,text:string,completed:bool}.",
+		"- todo IDs are card-scoped, start at 1, and are never reused.",
+		"- `card todo edit` changes text (-b) or 1-based position (--position) without changing the id.",
+		"- web and macOS clients render todos read-only; CLI is the mutation surface.",
+		"",
+		"ACCEPTANCE CRITERIA SEMANTICS",
+		"- acceptance criterion model: {id:int,text:string,completed:bool}.",
+		"- acceptance criterion IDs are card-scoped, start at 1, and are never reused.",
+		"- `card acceptance edit` changes text (-b) or 1-based position (--position) without changing the id.",
+		"- web and macOS clients render acceptance criteria read-only; CLI is the mutation surface.",
+		"",
+		"LABEL SEMANTICS",
+		"- labels are lowercase (letters, digits, '.', '_', '-'), sorted and unique per card.",
+		"- `card ls --label` filters to cards carrying that exact label.",
+		"",
+		"SCHEDULE SEMANTICS",
+		"- priority is P0 (most urgent) to P3 or empty; due_at is an RFC 3339 UTC timestamp or absent.",
+		"- `card due` accepts YYYY-MM-DD (end of that day, UTC) or RFC 3339; an empty value clears it, as for `card priority`.",
+		"- `card ls --sort` orders by rank (default), number, priority, due or updated; cards missing the field sort last.",
+		"",
+		"RANK SEMANTICS",
+		"- `card ls` lists cards by status in workflow order, then by rank: the order of each board column.",
+		"- `card move -s STATUS --before N|--after N` places the card next to card N of that status; without them a card entering a status goes to the bottom.",
+		"- a move within the same status publishes card.reordered; clients re-sort by rank.",
+		"- `card ls --overdue` returns cards past their due date that are not in their project's done status.",
+		"",
+		"RELATION SEMANTICS",
+		"- types: blocks, blocked_by, relates_to, duplicates, duplicated_by; -c takes a card_id and may cross projects.",
+		"- relations are stored on both cards with the inverse type on the other card.",
+		"- `card ls` reports blocked=true while any blocked_by card is not done; moving it out of the project's first status to any but the done status fails (409) without --force.",
+		"",
+		"PARENT SEMANTICS",
+		"- `card create --parent <card_id>` makes the new card a child; the parent may live in another project.",
+		"- `card ls` reports parent_id and children_done/children_total; `card tree` nests children recursively.",
+		"- hard deleting a parent detaches its children; transferring it repoints them.",
+		"",
+		"WIP LIMIT SEMANTICS",
+		"- `project update --wip-limit Doing=3` caps the live cards in a status; 0 removes the limit.",
+		"- creating, moving or editing a card into a full status fails (409); `card create`, `card move` and `card edit` accept --force.",
+		"- each card forced past a limit gets a card.wip_limit.exceeded history event.",
+		"- `card ls` returns the limits as wip_limits.",
+		"",
+		"TRANSITION RULE SEMANTICS",
+		"- `project rules set --rule \"Review->Done:acceptance_criteria_completed\"` replaces a project's rules; FROM may be * and no --rule removes them.",
+		"- conditions: acceptance_criteria_completed, todos_completed, branch.",
+		"- a move that breaks a rule fails (400) listing every unmet condition; --force on `card move` or `card edit` overrides it and records card.transition.overridden in history.",
+		"",
+		"ATTACHMENT SEMANTICS",
+		"- files are stored under projects/<slug>/attachments/card-<number>/ and listed in the card with size, content_type and sha256.",
+		"- attaching an existing filename replaces it; uploads are limited to 32 MiB.",
+		"- `card attachments download -o -` writes the raw file to stdout; otherwise it prints {filename,path,size}.",
+		"- soft delete keeps attachments, hard delete removes them, transfer moves them with the card.",
+		"",
+		"PROJECT COMMAND SUPPORT",
+		"- supported: create, ls, update, rm, statuses show|set, rules set, trash ls|restore|purge",
+		"- update changes name, local_path, remote_url and wip limits; the slug never changes (no rename).",
+		"",
+		"WATCH EVENT SHAPE",
+		"- {\"type\":\"card.created\",\"project\":\"alpha\",\"card_id\":\"alpha/card-1\",\"card_number\":1,\"timestamp\":\"...\"}",
+		"",
+		"STATUS RULE",
+		"- Each project has an ordered list of statuses (default: Todo, Doing, Review, Done); the last one counts as done.",
+		"- Cards may be created directly in any status of their project.",
+		"- `project statuses set` refuses to drop a status cards still use; pass --map Old=New to move them.",
+		"- `card transfer` keeps the status if the target project has it, maps done to done, otherwise uses the target's first status.",
+	}, "\n")
+	_, _ = fmt.Fprintln(stdout, text)
+	return nil
+}
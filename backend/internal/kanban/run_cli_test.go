@@ -0,0 +1,320 @@
+package kanban
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type commandRequest struct {
+	method string
+	path   string
+	query  string
+	body   string
+}
+
+func TestRunExecutesProjectAndCardCommands(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var (
+		mu       sync.Mutex
+		requests []commandRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+
+		mu.Lock()
+		requests = append(requests, commandRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+			query:  r.URL.RawQuery,
+			body:   strings.TrimSpace(string(body)),
+		})
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/projects":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"name":"Alpha","slug":"alpha","next_card_seq":1}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"projects":[{"name":"Alpha","slug":"alpha"}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"Alpha","slug":"alpha","local_path":"/work/alpha","next_card_seq":1}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"project":"alpha","deleted":true}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/statuses":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"statuses":["Todo","Doing","Review","Done"]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/alpha/statuses":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"Alpha","slug":"alpha","statuses":["Backlog","Doing","Shipped"],"next_card_seq":2}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/projects/alpha/transition-rules":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"Alpha","slug":"alpha","transition_rules":[{"from":"Review","to":"Done","requires":["acceptance_criteria_completed"]}],"next_card_seq":2}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/trash/projects":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"projects":[{"id":"alpha-20260101T000000.000Z","slug":"alpha","name":"Alpha","cards_count":1}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/trash/projects/alpha-20260101T000000.000Z/restore":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"Alpha","slug":"alpha","next_card_seq":2}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/trash/projects/alpha-20260101T000000.000Z":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha-20260101T000000.000Z","purged":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","branch":"feature/task","status":"Todo"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"cards":[{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","deleted":false,"comments_count":0,"history_count":1}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","description":[],"comments":[],"history":[]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Renamed","status":"Todo"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/restore":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","deleted":false}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/labels":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","labels":["bug"]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1/labels/bug":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","labels":[]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1/tree":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","children_done":0,"children_total":1,"children":[{"id":"alpha/card-2","project":"alpha","number":2,"title":"Sub","status":"Todo","parent_id":"alpha/card-1","children":[]}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/attachments":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"filename":"build.log","size":3,"content_type":"text/plain; charset=utf-8","sha256":"abc","uploaded_at":"2026-01-01T00:00:00Z"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1/attachments":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"attachments":[{"filename":"build.log","size":3,"content_type":"text/plain; charset=utf-8","sha256":"abc","uploaded_at":"2026-01-01T00:00:00Z"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1/attachments/build.log":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("ok\n"))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1/attachments/build.log":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"filename":"build.log","size":3,"content_type":"text/plain; charset=utf-8","sha256":"abc","uploaded_at":"2026-01-01T00:00:00Z"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/relations":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","relations":[{"type":"blocked_by","card_id":"beta/card-2"}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1/relations/blocked_by/beta/2":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Todo","relations":[]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/transfer":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"beta/card-4","project":"beta","number":4,"title":"Task","status":"Todo","deleted":false}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/move":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Doing"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/comments":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Doing"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/description":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Doing"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/branch":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","branch":"feature/task-v2","status":"Doing"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/priority":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Doing","priority":"P1"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/due":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"title":"Task","status":"Doing","due_at":"2026-03-01T23:59:59Z"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"deleted":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/todos":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Write tests","completed":false}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1/todos":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"todos":[{"id":1,"text":"Write tests","completed":false}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/todos/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Write tests","completed":true}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1/todos/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Write tests","completed":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/alpha/cards/1/acceptance":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Criterion A","completed":false}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/projects/alpha/cards/1/acceptance":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"acceptance_criteria":[{"id":1,"text":"Criterion A","completed":false}]}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/projects/alpha/cards/1/acceptance/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Criterion A","completed":true}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/projects/alpha/cards/1/acceptance/1":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":1,"text":"Criterion A","completed":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	env := []string{"KANBAN_SERVER_URL=" + server.URL, "KANBAN_OUTPUT=json"}
+	workDir := t.TempDir()
+	uploadPath := filepath.Join(workDir, "build.log")
+	require.NoError(t, os.WriteFile(uploadPath, []byte("ok\n"), 0o644))
+	downloadPath := filepath.Join(workDir, "downloaded.log")
+
+	cases := [][]string{
+		{"project", "create", "--name", "Alpha"},
+		{"project", "ls"},
+		{"project", "update", "alpha", "--local-path", "/work/alpha"},
+		{"project", "update", "alpha", "--wip-limit", "Doing=3", "--wip-limit", "Review=0"},
+		{"project", "statuses", "show", "alpha"},
+		{"project", "rules", "set", "alpha", "--rule", "Review->Done:acceptance_criteria_completed,todos_completed", "--rule", "*->Review:branch"},
+		{"project", "statuses", "set", "alpha", "-s", "Backlog", "-s", "Doing", "-s", "Shipped", "--map", "Todo=Backlog", "--map", "Review=Doing", "--map", "Done=Shipped"},
+		{"card", "create", "-p", "alpha", "-t", "Task", "-s", "Todo", "--branch", "feature/task"},
+		{"card", "ls", "-p", "alpha"},
+		{"card", "get", "-p", "alpha", "-i", "1"},
+		{"card", "branch", "-p", "alpha", "-i", "1", "-b", "feature/task-v2"},
+		{"card", "edit", "-p", "alpha", "-i", "1", "-t", "Renamed"},
+		{"card", "move", "-p", "alpha", "-i", "1", "-s", "Doing"},
+		{"card", "comment", "-p", "alpha", "-i", "1", "-b", "note"},
+		{"card", "desc", "-p", "alpha", "-i", "1", "-b", "body"},
+		{"card", "todo", "add", "-p", "alpha", "-i", "1", "-b", "Write tests"},
+		{"card", "todo", "ls", "-p", "alpha", "-i", "1"},
+		{"card", "todo", "done", "-p", "alpha", "-i", "1", "--todo-id", "1"},
+		{"card", "todo", "undo", "-p", "alpha", "-i", "1", "--todo-id", "1"},
+		{"card", "todo", "edit", "-p", "alpha", "-i", "1", "--todo-id", "1", "-b", "Write more tests", "--position", "1"},
+		{"card", "todo", "rm", "-p", "alpha", "-i", "1", "--todo-id", "1"},
+		{"card", "acceptance", "add", "-p", "alpha", "-i", "1", "-b", "Criterion A"},
+		{"card", "ac", "ls", "-p", "alpha", "-i", "1"},
+		{"card", "acceptance", "done", "-p", "alpha", "-i", "1", "--criterion-id", "1"},
+		{"card", "ac", "undo", "-p", "alpha", "-i", "1", "--criterion-id", "1"},
+		{"card", "ac", "edit", "-p", "alpha", "-i", "1", "--criterion-id", "1", "-b", "Criterion B"},
+		{"card", "acceptance", "rm", "-p", "alpha", "-i", "1", "--criterion-id", "1"},
+		{"card", "restore", "-p", "alpha", "-i", "1"},
+		{"card", "transfer", "-p", "alpha", "-i", "1", "--to", "beta"},
+		{"card", "label", "add", "-p", "alpha", "-i", "1", "-l", "bug"},
+		{"card", "label", "rm", "-p", "alpha", "-i", "1", "-l", "bug"},
+		{"card", "ls", "-p", "alpha", "--label", "bug"},
+		{"card", "create", "-p", "alpha", "-t", "Sub", "-s", "Todo", "--parent", "alpha/card-1"},
+		{"card", "create", "-p", "alpha", "-t", "Extra", "-s", "Doing", "--force"},
+		{"card", "tree", "-p", "alpha", "-i", "1"},
+		{"card", "relation", "add", "-p", "alpha", "-i", "1", "-t", "blocked_by", "-c", "beta/card-2"},
+		{"card", "rel", "rm", "-p", "alpha", "-i", "1", "-t", "blocked_by", "-c", "beta/card-2"},
+		{"card", "move", "-p", "alpha", "-i", "1", "-s", "Doing", "--force"},
+		{"card", "edit", "-p", "alpha", "-i", "1", "-s", "Review", "--force"},
+		{"card", "move", "-p", "alpha", "-i", "1", "-s", "Todo", "--before", "2"},
+		{"card", "attach", "-p", "alpha", "-i", "1", "-f", uploadPath},
+		{"card", "attachments", "-p", "alpha", "-i", "1"},
+		{"card", "attachments", "download", "-p", "alpha", "-i", "1", "-n", "build.log", "-o", downloadPath},
+		{"card", "attachments", "rm", "-p", "alpha", "-i", "1", "-n", "build.log"},
+		{"card", "priority", "-p", "alpha", "-i", "1", "--priority", "P1"},
+		{"card", "due", "-p", "alpha", "-i", "1", "--due", "2026-03-01"},
+		{"card", "ls", "-p", "alpha", "--sort", "due", "--overdue"},
+		{"card", "rm", "-p", "alpha", "-i", "1", "--hard"},
+		{"project", "rm", "alpha"},
+		{"project", "trash", "ls"},
+		{"project", "trash", "restore", "alpha-20260101T000000.000Z"},
+		{"project", "trash", "purge", "alpha-20260101T000000.000Z"},
+	}
+
+	for _, args := range cases {
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		exitCode := Run(args, &stdout, &stderr, env)
+		require.Equal(t, 0, exitCode, strings.Join(args, " ")+" stderr="+stderr.String())
+		require.NotEmpty(t, strings.TrimSpace(stdout.String()))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, requests)
+	require.Contains(t, requests, commandRequest{method: http.MethodGet, path: "/projects/alpha/cards", query: "include_deleted=false&label=bug"})
+	require.Contains(t, requests, commandRequest{method: http.MethodGet, path: "/projects/alpha/cards", query: "include_deleted=false&overdue=true&sort=due"})
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPost && req.path == "/projects/alpha/cards" && strings.Contains(req.body, `"parent_id":"alpha/card-1"`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPost && req.path == "/projects/alpha/cards/1/attachments" && strings.Contains(req.body, "filename=build.log")
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPatch && req.path == "/projects/alpha/cards/1/todos/1" && strings.Contains(req.body, `"position":1`) && !strings.Contains(req.body, "completed")
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPatch && req.path == "/projects/alpha/cards/1" && strings.Contains(req.body, `"force":true`) && strings.Contains(req.body, `"status":"Review"`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPut && req.path == "/projects/alpha/statuses" && strings.Contains(req.body, `"statuses":["Backlog","Doing","Shipped"]`) && strings.Contains(req.body, `"Todo":"Backlog"`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPatch && req.path == "/projects/alpha" && strings.Contains(req.body, `"wip_limits":{"Doing":3,"Review":0}`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPost && req.path == "/projects/alpha/cards" && strings.Contains(req.body, `"force":true`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPut && req.path == "/projects/alpha/transition-rules" &&
+			strings.Contains(req.body, `{"from":"Review","requires":["acceptance_criteria_completed","todos_completed"],"to":"Done"}`) &&
+			strings.Contains(req.body, `{"requires":["branch"],"to":"Review"}`)
+	}))
+	require.True(t, slices.ContainsFunc(requests, func(req commandRequest) bool {
+		return req.method == http.MethodPatch && req.path == "/projects/alpha/cards/1/move" && strings.Contains(req.body, `"before":2`) && !strings.Contains(req.body, "after")
+	}))
+	downloaded, err := os.ReadFile(downloadPath)
+	require.NoError(t, err)
+	require.Equal(t, "ok\n", string(downloaded))
+}
+
+func TestRunSendsIfMatchAndReportsStaleRevision(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var ifMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = r.Header.Get("If-Match")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"5"`)
+		w.WriteHeader(http.StatusPreconditionFailed)
+		_, _ = w.Write([]byte(`{"id":"alpha/card-1","project":"alpha","number":1,"status":"Review","revision":5}`))
+	}))
+	defer server.Close()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	exitCode := Run([]string{"card", "move", "-p", "alpha", "-i", "1", "-s", "Doing", "--if-match", "4"}, &stdout, &stderr, []string{"KANBAN_SERVER_URL=" + server.URL})
+	require.Equal(t, 1, exitCode)
+	require.Equal(t, `"4"`, ifMatch)
+	require.Equal(t, "error (412): card alpha/card-1 changed: current revision is 5\n", stderr.String())
+}
+
+func TestRunReturnsJSONErrorForBackendProblem(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"bad input"}`))
+	}))
+	defer server.Close()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	exitCode := Run([]string{"project", "create", "--name", "Alpha"}, &stdout, &stderr, []string{"KANBAN_SERVER_URL=" + server.URL, "KANBAN_OUTPUT=json"})
+	require.Equal(t, 1, exitCode)
+	require.Contains(t, stderr.String(), `"detail":"bad input"`)
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var cardIDPattern = regexp.MustCompile(`^([a-z0-9]+(?:-[a-z0-9]+)*)/card-([1-9][0-9]*)$`)
+
+// ParseCardID splits a card ID of the form <project-slug>/card-<number>.
+func ParseCardID(id string) (string, int, error) {
+	match := cardIDPattern.FindStringSubmatch(strings.TrimSpace(id))
+	if match == nil {
+		return "", 0, fmt.Errorf("invalid card id %q: want <project>/card-<number>", id)
+	}
+	number, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid card id %q: %w", id, err)
+	}
+	return match[1], number, nil
+}
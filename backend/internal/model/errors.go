@@ -0,0 +1,54 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StaleRevisionError is returned by a card write made against a revision the
+// card has moved past. Current is the card as it is now.
+type StaleRevisionError struct {
+	Expected int
+	Current  Card
+}
+
+func (e *StaleRevisionError) Error() string {
+	return fmt.Sprintf("card %s changed: expected revision %d, current revision %d", e.Current.ID, e.Expected, e.Current.Revision)
+}
+
+// WIPLimitError is returned by a card write that would take a status past its
+// WIP limit without force.
+type WIPLimitError struct {
+	Status string
+	Limit  int
+}
+
+func (e *WIPLimitError) Error() string {
+	return fmt.Sprintf("status %s is at its WIP limit of %d; force the move to exceed it", e.Status, e.Limit)
+}
+
+// TransitionError is returned by a card write that would move a card against
+// its project's transition rules without force. Unmet describes each failed
+// condition.
+type TransitionError struct {
+	Number int
+	From   string
+	To     string
+	Unmet  []string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("card %d cannot move from %s to %s: %s; force the move to override", e.Number, e.From, e.To, strings.Join(e.Unmet, "; "))
+}
+
+// BlockedError is returned by a card write that would make a move
+// Project.GuardsBlockedMove guards, without force, while cards block the card.
+// Blockers lists their IDs.
+type BlockedError struct {
+	Number   int
+	Blockers []string
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf("card %d is blocked by %s; finish those first or move with force", e.Number, strings.Join(e.Blockers, ", "))
+}
@@ -4,10 +4,13 @@ type EventType string
 
 const (
 	EventTypeProjectCreated        EventType = "project.created"
+	EventTypeProjectUpdated        EventType = "project.updated"
 	EventTypeProjectDeleted        EventType = "project.deleted"
+	EventTypeProjectRestored       EventType = "project.restored"
 	EventTypeCardCreated           EventType = "card.created"
 	EventTypeCardBranchUpdated     EventType = "card.branch.updated"
 	EventTypeCardMoved             EventType = "card.moved"
+	EventTypeCardReordered         EventType = "card.reordered"
 	EventTypeCardCommented         EventType = "card.commented"
 	EventTypeCardUpdated           EventType = "card.updated"
 	EventTypeCardTodoAdded         EventType = "card.todo.added"
@@ -16,17 +19,32 @@ const (
 	EventTypeCardAcceptanceAdded   EventType = "card.acceptance.added"
 	EventTypeCardAcceptanceUpdated EventType = "card.acceptance.updated"
 	EventTypeCardAcceptanceDeleted EventType = "card.acceptance.deleted"
+	EventTypeCardAttachmentAdded   EventType = "card.attachment.added"
+	EventTypeCardAttachmentDeleted EventType = "card.attachment.deleted"
+	EventTypeCardLabelAdded        EventType = "card.label.added"
+	EventTypeCardLabelRemoved      EventType = "card.label.removed"
+	EventTypeCardPriorityUpdated   EventType = "card.priority.updated"
+	EventTypeCardDueUpdated        EventType = "card.due.updated"
+	EventTypeCardRelationAdded     EventType = "card.relation.added"
+	EventTypeCardRelationRemoved   EventType = "card.relation.removed"
+	EventTypeCardRelationUpdated   EventType = "card.relation.updated"
+	EventTypeCardParentUpdated     EventType = "card.parent.updated"
 	EventTypeCardDeletedSoft       EventType = "card.deleted_soft"
 	EventTypeCardDeletedHard       EventType = "card.deleted_hard"
+	EventTypeCardRestored          EventType = "card.restored"
+	EventTypeCardTransferred       EventType = "card.transferred"
 	EventTypeResyncRequired        EventType = "resync.required"
 )
 
 var websocketEventTypes = []EventType{
 	EventTypeProjectCreated,
+	EventTypeProjectUpdated,
 	EventTypeProjectDeleted,
+	EventTypeProjectRestored,
 	EventTypeCardCreated,
 	EventTypeCardBranchUpdated,
 	EventTypeCardMoved,
+	EventTypeCardReordered,
 	EventTypeCardCommented,
 	EventTypeCardUpdated,
 	EventTypeCardTodoAdded,
@@ -35,8 +53,20 @@ var websocketEventTypes = []EventType{
 	EventTypeCardAcceptanceAdded,
 	EventTypeCardAcceptanceUpdated,
 	EventTypeCardAcceptanceDeleted,
+	EventTypeCardAttachmentAdded,
+	EventTypeCardAttachmentDeleted,
+	EventTypeCardLabelAdded,
+	EventTypeCardLabelRemoved,
+	EventTypeCardPriorityUpdated,
+	EventTypeCardDueUpdated,
+	EventTypeCardRelationAdded,
+	EventTypeCardRelationRemoved,
+	EventTypeCardRelationUpdated,
+	EventTypeCardParentUpdated,
 	EventTypeCardDeletedSoft,
 	EventTypeCardDeletedHard,
+	EventTypeCardRestored,
+	EventTypeCardTransferred,
 	EventTypeResyncRequired,
 }
 
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Conditions a TransitionRule can require of a card.
+const (
+	ConditionAcceptanceCriteriaCompleted = "acceptance_criteria_completed"
+	ConditionTodosCompleted              = "todos_completed"
+	ConditionBranch                      = "branch"
+)
+
+// AllowedConditions lists the conditions a transition rule may require.
+var AllowedConditions = map[string]struct{}{
+	ConditionAcceptanceCriteriaCompleted: {},
+	ConditionTodosCompleted:              {},
+	ConditionBranch:                      {},
+}
+
+// TransitionRule requires conditions of a card moving to To. An empty From
+// applies the rule whatever status the card comes from.
+type TransitionRule struct {
+	From     string   `json:"from,omitempty" doc:"Status the card leaves; empty for any status"`
+	To       string   `json:"to" doc:"Status the card enters"`
+	Requires []string `json:"requires" doc:"Conditions the card must meet: acceptance_criteria_completed, todos_completed or branch"`
+}
+
+// Applies reports whether the rule guards a move from one status to another.
+func (r TransitionRule) Applies(from, to string) bool {
+	return r.To == to && (r.From == "" || r.From == from)
+}
+
+// UnmetConditions describes each condition of the project's transition rules
+// that card fails when moving to status, in rule order and without repeats.
+func (p Project) UnmetConditions(card Card, status string) []string {
+	var unmet []string
+	seen := map[string]bool{}
+	for _, rule := range p.TransitionRules {
+		if !rule.Applies(card.Status, status) {
+			continue
+		}
+		for _, condition := range rule.Requires {
+			if seen[condition] {
+				continue
+			}
+			seen[condition] = true
+			if reason := unmetCondition(card, condition); reason != "" {
+				unmet = append(unmet, reason)
+			}
+		}
+	}
+	return unmet
+}
+
+func unmetCondition(card Card, condition string) string {
+	switch condition {
+	case ConditionAcceptanceCriteriaCompleted:
+		open := 0
+		for _, criterion := range card.AcceptanceCriteria {
+			if !criterion.Completed {
+				open++
+			}
+		}
+		if open > 0 {
+			return fmt.Sprintf("%d of %d acceptance criteria open", open, len(card.AcceptanceCriteria))
+		}
+	case ConditionTodosCompleted:
+		open := 0
+		for _, todo := range card.Todos {
+			if !todo.Completed {
+				open++
+			}
+		}
+		if open > 0 {
+			return fmt.Sprintf("%d of %d todos open", open, len(card.Todos))
+		}
+	case ConditionBranch:
+		if strings.TrimSpace(card.Branch) == "" {
+			return "no branch set"
+		}
+	}
+	return ""
+}
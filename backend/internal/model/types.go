@@ -1,22 +1,134 @@
 package model
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
-var AllowedStatus = map[string]struct{}{
-	"Todo":   {},
-	"Doing":  {},
-	"Review": {},
-	"Done":   {},
+// DefaultStatuses is the workflow of projects that do not define their own.
+var DefaultStatuses = []string{"Todo", "Doing", "Review", "Done"}
+
+// AllowedPriority lists the card priorities, P0 being the most urgent. Cards
+// may also have no priority at all.
+var AllowedPriority = map[string]struct{}{
+	"P0": {},
+	"P1": {},
+	"P2": {},
+	"P3": {},
+}
+
+// Card relation types. Each relation is recorded on both cards, the other card
+// holding the inverse type.
+const (
+	RelationBlocks       = "blocks"
+	RelationBlockedBy    = "blocked_by"
+	RelationRelatesTo    = "relates_to"
+	RelationDuplicates   = "duplicates"
+	RelationDuplicatedBy = "duplicated_by"
+)
+
+// InverseRelation maps a relation type to the type recorded on the other card.
+var InverseRelation = map[string]string{
+	RelationBlocks:       RelationBlockedBy,
+	RelationBlockedBy:    RelationBlocks,
+	RelationRelatesTo:    RelationRelatesTo,
+	RelationDuplicates:   RelationDuplicatedBy,
+	RelationDuplicatedBy: RelationDuplicates,
+}
+
+// Card list sort orders accepted by CardListOptions.Sort.
+const (
+	CardSortRank     = "rank"
+	CardSortNumber   = "number"
+	CardSortPriority = "priority"
+	CardSortDue      = "due"
+	CardSortUpdated  = "updated"
+)
+
+var AllowedCardSort = map[string]struct{}{
+	CardSortRank:     {},
+	CardSortNumber:   {},
+	CardSortPriority: {},
+	CardSortDue:      {},
+	CardSortUpdated:  {},
 }
 
 type Project struct {
-	Name        string    `json:"name"`
-	Slug        string    `json:"slug"`
-	LocalPath   string    `json:"local_path,omitempty"`
-	RemoteURL   string    `json:"remote_url,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	NextCardSeq int       `json:"next_card_seq"`
+	Name            string           `json:"name"`
+	Slug            string           `json:"slug"`
+	LocalPath       string           `json:"local_path,omitempty"`
+	RemoteURL       string           `json:"remote_url,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	NextCardSeq     int              `json:"next_card_seq"`
+	Statuses        []string         `json:"statuses" doc:"Ordered workflow statuses; the first is where work starts and the last counts as done"`
+	WIPLimits       map[string]int   `json:"wip_limits,omitempty" doc:"Maximum number of live cards per status; statuses without an entry are unlimited"`
+	TransitionRules []TransitionRule `json:"transition_rules,omitempty" doc:"Conditions cards must meet to enter a status"`
+
+	// ExtraFrontmatter and ExtraSections hold what a hand edit added to
+	// project.md that the store does not manage, so rewrites keep it.
+	ExtraFrontmatter string         `json:"-"`
+	ExtraSections    []ExtraSection `json:"-"`
+}
+
+// ExtraSection is a "# " section of a markdown file the store does not manage,
+// such as a hand-written "# Notes". It is written back verbatim after the
+// managed section it followed; an empty After puts it before all of them.
+type ExtraSection struct {
+	Heading string
+	Body    string
+	After   string
+}
+
+// HasStatus reports whether status is part of the project's workflow.
+func (p Project) HasStatus(status string) bool {
+	return slices.Contains(p.Statuses, status)
+}
+
+// DoneStatus is the last status of the workflow. Cards in it count as done
+// for blockers, child rollups and overdue listings.
+func (p Project) DoneStatus() string {
+	if len(p.Statuses) == 0 {
+		return DefaultStatuses[len(DefaultStatuses)-1]
+	}
+	return p.Statuses[len(p.Statuses)-1]
+}
+
+// GuardsBlockedMove reports whether a card blocked by open cards needs force
+// to move from one status to another. Every move out of the first status is
+// guarded, except toward the done status, so a blocked card cannot be started
+// however many statuses the workflow has.
+func (p Project) GuardsBlockedMove(from, to string) bool {
+	first := DefaultStatuses[0]
+	if len(p.Statuses) > 0 {
+		first = p.Statuses[0]
+	}
+	return from == first && to != "" && to != first && to != p.DoneStatus()
+}
+
+// WIPLimit is the most live cards status may hold, or 0 when it is unlimited.
+func (p Project) WIPLimit(status string) int {
+	return p.WIPLimits[status]
+}
+
+// ProjectPatch names the project metadata to change. Nil fields are left as
+// is; the slug never changes. WIPLimits sets the limit of each status it
+// names, and a limit of 0 removes it.
+type ProjectPatch struct {
+	Name      *string
+	LocalPath *string
+	RemoteURL *string
+	WIPLimits map[string]int
+}
+
+// TrashedProject is a deleted project kept under the trash directory until it
+// is restored or purged. ID names the trash entry, not the project.
+type TrashedProject struct {
+	ID         string    `json:"id"`
+	Slug       string    `json:"slug"`
+	Name       string    `json:"name"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	CardsCount int       `json:"cards_count"`
 }
 
 type TextEvent struct {
@@ -36,6 +148,21 @@ type Todo struct {
 	Completed bool   `json:"completed"`
 }
 
+// CardRelation links a card to another card, possibly in another project.
+type CardRelation struct {
+	Type   string `json:"type"`
+	CardID string `json:"card_id"`
+}
+
+// Attachment describes a file stored alongside a card.
+type Attachment struct {
+	Filename    string    `json:"filename"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	SHA256      string    `json:"sha256"`
+	UploadedAt  time.Time `json:"uploaded_at"`
+}
+
 type AcceptanceCriterion struct {
 	ID        int    `json:"id"`
 	Text      string `json:"text"`
@@ -48,8 +175,13 @@ type Card struct {
 	Number                    int                   `json:"number"`
 	Title                     string                `json:"title"`
 	Branch                    string                `json:"branch"`
-	Status                    string                `json:"status"`
+	Status                    string                `json:"status" doc:"One of the project's statuses"`
+	Rank                      string                `json:"rank" doc:"Orders the card within its status; compare byte-wise"`
+	Labels                    []string              `json:"labels"`
+	Priority                  string                `json:"priority,omitempty"`
+	DueAt                     *time.Time            `json:"due_at,omitempty"`
 	Deleted                   bool                  `json:"deleted"`
+	Revision                  int                   `json:"revision"`
 	CreatedAt                 time.Time             `json:"created_at"`
 	UpdatedAt                 time.Time             `json:"updated_at"`
 	Description               []TextEvent           `json:"description"`
@@ -57,26 +189,85 @@ type Card struct {
 	History                   []HistoryEvent        `json:"history"`
 	Todos                     []Todo                `json:"todos"`
 	AcceptanceCriteria        []AcceptanceCriterion `json:"acceptance_criteria"`
+	Relations                 []CardRelation        `json:"relations"`
+	Attachments               []Attachment          `json:"attachments"`
+	ParentID                  string                `json:"parent_id,omitempty"`
+	MovedTo                   string                `json:"moved_to,omitempty"`
+	Notes                     string                `json:"notes,omitempty" readOnly:"true" doc:"The hand-written # Notes section of the card file; edit the file to change it"`
 	NextTodoID                int                   `json:"-"`
 	NextAcceptanceCriterionID int                   `json:"-"`
+
+	// ExtraFrontmatter and ExtraSections hold what a hand edit added to the
+	// card file that the store does not manage, so rewrites keep it.
+	ExtraFrontmatter string         `json:"-"`
+	ExtraSections    []ExtraSection `json:"-"`
+}
+
+// CardPatch names the scalar card fields to change. Nil fields are left as is.
+type CardPatch struct {
+	Title  *string
+	Branch *string
+	Status *string
+}
+
+// CardPosition places a card within its status column, directly before or
+// after another card of the project. The zero value leaves a card that stays
+// in its status where it is and puts one entering a status at the bottom.
+type CardPosition struct {
+	Before int
+	After  int
+}
+
+// ChecklistItemPatch names the todo or acceptance criterion fields to change.
+// Position is 1-based within the card's list. Nil fields are left as is.
+type ChecklistItemPatch struct {
+	Text      *string
+	Completed *bool
+	Position  *int
 }
 
 type CardSummary struct {
-	ID                               string    `json:"id"`
-	ProjectSlug                      string    `json:"project"`
-	Number                           int       `json:"number"`
-	Title                            string    `json:"title"`
-	Branch                           string    `json:"branch"`
-	Status                           string    `json:"status"`
-	Deleted                          bool      `json:"deleted"`
-	CreatedAt                        time.Time `json:"created_at"`
-	UpdatedAt                        time.Time `json:"updated_at"`
-	CommentsCount                    int       `json:"comments_count"`
-	HistoryCount                     int       `json:"history_count"`
-	TodosCount                       int       `json:"todos_count"`
-	TodosCompletedCount              int       `json:"todos_completed_count"`
-	AcceptanceCriteriaCount          int       `json:"acceptance_criteria_count"`
-	AcceptanceCriteriaCompletedCount int       `json:"acceptance_criteria_completed_count"`
+	ID                               string     `json:"id"`
+	ProjectSlug                      string     `json:"project"`
+	Number                           int        `json:"number"`
+	Title                            string     `json:"title"`
+	Branch                           string     `json:"branch"`
+	Status                           string     `json:"status" doc:"One of the project's statuses"`
+	Rank                             string     `json:"rank" doc:"Orders the card within its status; compare byte-wise"`
+	Labels                           []string   `json:"labels"`
+	Priority                         string     `json:"priority,omitempty"`
+	DueAt                            *time.Time `json:"due_at,omitempty"`
+	Blocked                          bool       `json:"blocked"`
+	ParentID                         string     `json:"parent_id,omitempty"`
+	ChildrenDone                     int        `json:"children_done"`
+	ChildrenTotal                    int        `json:"children_total"`
+	Deleted                          bool       `json:"deleted"`
+	Revision                         int        `json:"revision"`
+	CreatedAt                        time.Time  `json:"created_at"`
+	UpdatedAt                        time.Time  `json:"updated_at"`
+	CommentsCount                    int        `json:"comments_count"`
+	HistoryCount                     int        `json:"history_count"`
+	TodosCount                       int        `json:"todos_count"`
+	TodosCompletedCount              int        `json:"todos_completed_count"`
+	AcceptanceCriteriaCount          int        `json:"acceptance_criteria_count"`
+	AcceptanceCriteriaCompletedCount int        `json:"acceptance_criteria_completed_count"`
+	MovedTo                          string     `json:"moved_to,omitempty"`
+}
+
+// CardTreeNode is a card together with the cards below it.
+type CardTreeNode struct {
+	CardSummary
+	Children []CardTreeNode `json:"children"`
+}
+
+// CardListOptions narrows and orders a card listing. An empty Label matches
+// every card and an empty Sort orders by status, then rank. Overdue keeps only
+// cards that are past their due date and not yet done.
+type CardListOptions struct {
+	IncludeDeleted bool
+	Label          string
+	Sort           string
+	Overdue        bool
 }
 
 type Event struct {
@@ -0,0 +1,122 @@
+package server_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardRevisionIsExposedAsETag(t *testing.T) {
+	t.Parallel()
+
+	_, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Revisions"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	createCardResp := doJSON(t, httpServer.URL+"/projects/revisions/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, createCardResp.StatusCode)
+	require.EqualValues(t, 1, decodeMap(t, createCardResp.Body)["revision"])
+
+	getResp := doJSON(t, httpServer.URL+"/projects/revisions/cards/1", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, getResp.StatusCode)
+	require.Equal(t, `"1"`, getResp.Header.Get("ETag"))
+
+	moveResp := doJSONWithHeaders(t, httpServer.URL+"/projects/revisions/cards/1/move", http.MethodPatch, map[string]string{"If-Match": `"1"`}, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusOK, moveResp.StatusCode)
+	require.Equal(t, `"2"`, moveResp.Header.Get("ETag"))
+	require.EqualValues(t, 2, decodeMap(t, moveResp.Body)["revision"])
+
+	listResp := doJSON(t, httpServer.URL+"/projects/revisions/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	cards := decodeMap(t, listResp.Body)["cards"].([]any)
+	require.Len(t, cards, 1)
+	require.EqualValues(t, 2, cards[0].(map[string]any)["revision"])
+}
+
+func TestChecklistWritesReturnCardETag(t *testing.T) {
+	t.Parallel()
+
+	_, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Checklists"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	createCardResp := doJSON(t, httpServer.URL+"/projects/checklists/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, createCardResp.StatusCode)
+	etag := createCardResp.Header.Get("ETag")
+	require.Equal(t, `"1"`, etag)
+
+	// Each write is conditional on the ETag the previous one returned, the
+	// way a client that never re-reads the card works.
+	cardURL := httpServer.URL + "/projects/checklists/cards/1"
+	for _, step := range []struct {
+		method string
+		path   string
+		body   any
+		etag   string
+	}{
+		{http.MethodPost, "/todos", map[string]string{"text": "Write tests"}, `"2"`},
+		{http.MethodPatch, "/todos/1", map[string]bool{"completed": true}, `"3"`},
+		{http.MethodPost, "/acceptance", map[string]string{"text": "Works"}, `"4"`},
+		{http.MethodPatch, "/acceptance/1", map[string]bool{"completed": true}, `"5"`},
+		{http.MethodDelete, "/acceptance/1", nil, `"6"`},
+		{http.MethodDelete, "/todos/1", nil, `"7"`},
+	} {
+		resp := doJSONWithHeaders(t, cardURL+step.path, step.method, map[string]string{"If-Match": etag}, step.body)
+		require.Less(t, resp.StatusCode, 300, step.method+" "+step.path)
+		etag = resp.Header.Get("ETag")
+		require.Equal(t, step.etag, etag, step.method+" "+step.path)
+	}
+}
+
+func TestStaleIfMatchReturnsCurrentCard(t *testing.T) {
+	t.Parallel()
+
+	_, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Stale"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	createCardResp := doJSON(t, httpServer.URL+"/projects/stale/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, createCardResp.StatusCode)
+
+	// Another client moves the card after we read revision 1.
+	moveResp := doJSON(t, httpServer.URL+"/projects/stale/cards/1/move", http.MethodPatch, map[string]string{"status": "Review"})
+	require.Equal(t, http.StatusOK, moveResp.StatusCode)
+
+	staleHeaders := map[string]string{"If-Match": `"1"`}
+	requests := []struct {
+		name    string
+		method  string
+		path    string
+		payload any
+	}{
+		{name: "move", method: http.MethodPatch, path: "/move", payload: map[string]string{"status": "Doing"}},
+		{name: "comment", method: http.MethodPost, path: "/comments", payload: map[string]string{"body": "hi"}},
+		{name: "description", method: http.MethodPatch, path: "/description", payload: map[string]string{"body": "more"}},
+		{name: "branch", method: http.MethodPatch, path: "/branch", payload: map[string]string{"branch": "feature/x"}},
+		{name: "add todo", method: http.MethodPost, path: "/todos", payload: map[string]string{"text": "todo"}},
+		{name: "add acceptance", method: http.MethodPost, path: "/acceptance", payload: map[string]string{"text": "criterion"}},
+		{name: "delete", method: http.MethodDelete, path: ""},
+	}
+	for _, tc := range requests {
+		resp := doJSONWithHeaders(t, httpServer.URL+"/projects/stale/cards/1"+tc.path, tc.method, staleHeaders, tc.payload)
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode, tc.name)
+		require.Equal(t, `"2"`, resp.Header.Get("ETag"), tc.name)
+		current := decodeMap(t, resp.Body)
+		require.Equal(t, "stale/card-1", current["id"], tc.name)
+		require.Equal(t, "Review", current["status"], tc.name)
+		require.EqualValues(t, 2, current["revision"], tc.name)
+	}
+
+	getResp := doJSON(t, httpServer.URL+"/projects/stale/cards/1", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, getResp.StatusCode)
+	card := decodeMap(t, getResp.Body)
+	require.Equal(t, "Review", card["status"])
+	require.Len(t, card["comments"], 0)
+
+	invalidResp := doJSONWithHeaders(t, httpServer.URL+"/projects/stale/cards/1/move", http.MethodPatch, map[string]string{"If-Match": "latest"}, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusBadRequest, invalidResp.StatusCode)
+
+	wildcardResp := doJSONWithHeaders(t, httpServer.URL+"/projects/stale/cards/1/move", http.MethodPatch, map[string]string{"If-Match": "*"}, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusOK, wildcardResp.StatusCode)
+}
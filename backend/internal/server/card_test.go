@@ -1,7 +1,10 @@
 package server_test
 
 import (
+	"bytes"
 	"database/sql"
+	"fmt"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -175,6 +178,509 @@ func TestCardBranchCreateAndUpdate(t *testing.T) {
 	require.Equal(t, "feature/card-branch-v2", branch)
 }
 
+func TestCardPatchUpdatesScalarFields(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Patch Board"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	createCardResp := doJSON(t, httpServer.URL+"/projects/patch-board/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, createCardResp.StatusCode)
+
+	patchResp := doJSONWithHeaders(t, httpServer.URL+"/projects/patch-board/cards/1", http.MethodPatch, map[string]string{"If-Match": `"1"`}, map[string]string{
+		"title":  "Renamed",
+		"status": "Doing",
+	})
+	require.Equal(t, http.StatusOK, patchResp.StatusCode)
+	require.Equal(t, `"2"`, patchResp.Header.Get("ETag"))
+	patchBody := decodeMap(t, patchResp.Body)
+	require.Equal(t, "Renamed", patchBody["title"])
+	require.Equal(t, "Doing", patchBody["status"])
+	require.Equal(t, "", patchBody["branch"])
+	history := patchBody["history"].([]any)
+	last := history[len(history)-1].(map[string]any)
+	require.Equal(t, "card.updated", last["type"])
+	require.Contains(t, last["details"], `title: "Task" -> "Renamed"`)
+	require.Contains(t, last["details"], `status: "Todo" -> "Doing"`)
+
+	cardMarkdown := string(readFile(t, filepath.Join(dataDir, "projects", "patch-board", "card-1.md")))
+	require.Contains(t, cardMarkdown, "title: Renamed")
+
+	emptyResp := doJSON(t, httpServer.URL+"/projects/patch-board/cards/1", http.MethodPatch, map[string]string{})
+	require.Equal(t, http.StatusBadRequest, emptyResp.StatusCode)
+
+	invalidStatusResp := doJSON(t, httpServer.URL+"/projects/patch-board/cards/1", http.MethodPatch, map[string]string{"status": "Blocked"})
+	require.Equal(t, http.StatusBadRequest, invalidStatusResp.StatusCode)
+
+	staleResp := doJSONWithHeaders(t, httpServer.URL+"/projects/patch-board/cards/1", http.MethodPatch, map[string]string{"If-Match": `"1"`}, map[string]string{"title": "Again"})
+	require.Equal(t, http.StatusPreconditionFailed, staleResp.StatusCode)
+	require.Equal(t, "Renamed", decodeMap(t, staleResp.Body)["title"])
+}
+
+func TestCardRestoreAfterSoftDelete(t *testing.T) {
+	t.Parallel()
+
+	_, sqlitePath, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Restore Board"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	for _, title := range []string{"Soft", "Hard"} {
+		resp := doJSON(t, httpServer.URL+"/projects/restore-board/cards", http.MethodPost, map[string]string{"title": title, "status": "Todo"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	notDeletedResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/1/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusBadRequest, notDeletedResp.StatusCode)
+	require.Contains(t, string(readBody(t, notDeletedResp.Body)), "card 1 is not deleted")
+
+	softDeleteResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/1", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, softDeleteResp.StatusCode)
+
+	restoreResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/1/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusOK, restoreResp.StatusCode)
+	restoreBody := decodeMap(t, restoreResp.Body)
+	require.Equal(t, false, restoreBody["deleted"])
+	history := restoreBody["history"].([]any)
+	require.Equal(t, "card.restored", history[len(history)-1].(map[string]any)["type"])
+
+	listResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	require.Len(t, decodeMap(t, listResp.Body)["cards"], 2)
+
+	db, err := sql.Open("sqlite", sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	var deleted int
+	err = db.QueryRow(`SELECT deleted FROM cards WHERE project_slug = 'restore-board' AND number = 1`).Scan(&deleted)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+
+	hardDeleteResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/2?hard=true", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, hardDeleteResp.StatusCode)
+	hardResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/2/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusBadRequest, hardResp.StatusCode)
+	require.Contains(t, string(readBody(t, hardResp.Body)), "hard deleted and cannot be restored")
+
+	missingResp := doJSON(t, httpServer.URL+"/projects/restore-board/cards/99/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+}
+
+func TestCardLabelsCanBeAddedRemovedAndFiltered(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Labels"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	for _, title := range []string{"Crash", "Terraform", "Mockups"} {
+		resp := doJSON(t, httpServer.URL+"/projects/labels/cards", http.MethodPost, map[string]string{"title": title, "status": "Todo"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	for number, labels := range map[int][]string{1: {"bug", "infra"}, 2: {"infra"}, 3: {"needs-design"}} {
+		for _, label := range labels {
+			resp := doJSON(t, fmt.Sprintf("%s/projects/labels/cards/%d/labels", httpServer.URL, number), http.MethodPost, map[string]string{"label": label})
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+	}
+	duplicateResp := doJSON(t, httpServer.URL+"/projects/labels/cards/1/labels", http.MethodPost, map[string]string{"label": "Bug"})
+	require.Equal(t, http.StatusBadRequest, duplicateResp.StatusCode)
+	staleResp := doJSONWithHeaders(t, httpServer.URL+"/projects/labels/cards/1/labels", http.MethodPost, map[string]string{"If-Match": `"1"`}, map[string]string{"label": "p1"})
+	require.Equal(t, http.StatusPreconditionFailed, staleResp.StatusCode)
+
+	getResp := doJSON(t, httpServer.URL+"/projects/labels/cards/1", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, getResp.StatusCode)
+	require.Equal(t, []any{"bug", "infra"}, decodeMap(t, getResp.Body)["labels"])
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "labels", "card-1.md"))), "labels:")
+
+	listResp := doJSON(t, httpServer.URL+"/projects/labels/cards?label=infra", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	cards := decodeMap(t, listResp.Body)["cards"].([]any)
+	require.Len(t, cards, 2)
+	require.Equal(t, []any{"bug", "infra"}, cards[0].(map[string]any)["labels"])
+
+	removeResp := doJSON(t, httpServer.URL+"/projects/labels/cards/1/labels/infra", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, removeResp.StatusCode)
+	require.Equal(t, []any{"bug"}, decodeMap(t, removeResp.Body)["labels"])
+	missingResp := doJSON(t, httpServer.URL+"/projects/labels/cards/1/labels/infra", http.MethodDelete, nil)
+	require.Equal(t, http.StatusBadRequest, missingResp.StatusCode)
+
+	listResp = doJSON(t, httpServer.URL+"/projects/labels/cards?label=infra", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	require.Len(t, decodeMap(t, listResp.Body)["cards"], 1)
+}
+
+func TestCardPriorityAndDueDateDriveSortingAndOverdue(t *testing.T) {
+	t.Parallel()
+
+	_, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Dates"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	for _, title := range []string{"Late", "Urgent", "Someday"} {
+		resp := doJSON(t, httpServer.URL+"/projects/dates/cards", http.MethodPost, map[string]string{"title": title, "status": "Todo"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	priorityResp := doJSON(t, httpServer.URL+"/projects/dates/cards/2/priority", http.MethodPatch, map[string]string{"priority": "p0"})
+	require.Equal(t, http.StatusOK, priorityResp.StatusCode)
+	require.Equal(t, "P0", decodeMap(t, priorityResp.Body)["priority"])
+	invalidResp := doJSON(t, httpServer.URL+"/projects/dates/cards/2/priority", http.MethodPatch, map[string]string{"priority": "urgent"})
+	require.Equal(t, http.StatusBadRequest, invalidResp.StatusCode)
+
+	dueResp := doJSON(t, httpServer.URL+"/projects/dates/cards/1/due", http.MethodPatch, map[string]string{"due_at": "2020-01-31"})
+	require.Equal(t, http.StatusOK, dueResp.StatusCode)
+	require.Equal(t, "2020-01-31T23:59:59Z", decodeMap(t, dueResp.Body)["due_at"])
+	dueResp = doJSON(t, httpServer.URL+"/projects/dates/cards/2/due", http.MethodPatch, map[string]string{"due_at": "2999-01-01T09:00:00+01:00"})
+	require.Equal(t, http.StatusOK, dueResp.StatusCode)
+	require.Equal(t, "2999-01-01T08:00:00Z", decodeMap(t, dueResp.Body)["due_at"])
+	badDueResp := doJSON(t, httpServer.URL+"/projects/dates/cards/2/due", http.MethodPatch, map[string]string{"due_at": "tomorrow"})
+	require.Equal(t, http.StatusBadRequest, badDueResp.StatusCode)
+
+	listNumbers := func(query string) []any {
+		t.Helper()
+		resp := doJSON(t, httpServer.URL+"/projects/dates/cards?"+query, http.MethodGet, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		numbers := []any{}
+		for _, card := range decodeMap(t, resp.Body)["cards"].([]any) {
+			numbers = append(numbers, card.(map[string]any)["number"])
+		}
+		return numbers
+	}
+	require.Equal(t, []any{float64(2), float64(1), float64(3)}, listNumbers("sort=priority"))
+	require.Equal(t, []any{float64(1), float64(2), float64(3)}, listNumbers("sort=due"))
+	require.Equal(t, []any{float64(1)}, listNumbers("overdue=true"))
+
+	badSortResp := doJSON(t, httpServer.URL+"/projects/dates/cards?sort=title", http.MethodGet, nil)
+	require.Equal(t, http.StatusBadRequest, badSortResp.StatusCode)
+
+	clearResp := doJSON(t, httpServer.URL+"/projects/dates/cards/1/due", http.MethodPatch, map[string]string{"due_at": ""})
+	require.Equal(t, http.StatusOK, clearResp.StatusCode)
+	require.NotContains(t, decodeMap(t, clearResp.Body), "due_at")
+	require.Empty(t, listNumbers("overdue=true"))
+}
+
+func TestCardRelationsBlockMovingToDoing(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		resp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	for _, card := range []struct{ project, title string }{{"alpha", "API"}, {"beta", "Schema"}} {
+		resp := doJSON(t, httpServer.URL+"/projects/"+card.project+"/cards", http.MethodPost, map[string]string{"title": card.title, "status": "Todo"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	relateResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/relations", http.MethodPost, map[string]string{"type": "blocked_by", "card_id": "beta/card-1"})
+	require.Equal(t, http.StatusOK, relateResp.StatusCode)
+	require.Equal(t, []any{map[string]any{"type": "blocked_by", "card_id": "beta/card-1"}}, decodeMap(t, relateResp.Body)["relations"])
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "beta", "card-1.md"))), "type: blocks\n      card: alpha/card-1\n")
+	badResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/relations", http.MethodPost, map[string]string{"type": "depends_on", "card_id": "beta/card-1"})
+	require.Equal(t, http.StatusBadRequest, badResp.StatusCode)
+
+	listResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	require.Equal(t, true, decodeMap(t, listResp.Body)["cards"].([]any)[0].(map[string]any)["blocked"])
+
+	moveResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusConflict, moveResp.StatusCode)
+	require.Contains(t, decodeMap(t, moveResp.Body)["detail"], "blocked by beta/card-1")
+	patchResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodPatch, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusConflict, patchResp.StatusCode)
+	require.Contains(t, decodeMap(t, patchResp.Body)["detail"], "blocked by beta/card-1")
+	forcePatchResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodPatch, map[string]any{"status": "Doing", "force": true})
+	require.Equal(t, http.StatusOK, forcePatchResp.StatusCode)
+	forceResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]any{"status": "Review", "force": true})
+	require.Equal(t, http.StatusOK, forceResp.StatusCode)
+
+	doneResp := doJSON(t, httpServer.URL+"/projects/beta/cards/1/move", http.MethodPatch, map[string]string{"status": "Done"})
+	require.Equal(t, http.StatusOK, doneResp.StatusCode)
+	listResp = doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+	require.Equal(t, false, decodeMap(t, listResp.Body)["cards"].([]any)[0].(map[string]any)["blocked"])
+	moveResp = doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusOK, moveResp.StatusCode)
+
+	removeResp := doJSON(t, httpServer.URL+"/projects/beta/cards/1/relations/blocks/alpha/1", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, removeResp.StatusCode)
+	require.Empty(t, decodeMap(t, removeResp.Body)["relations"])
+	getResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodGet, nil)
+	require.Empty(t, decodeMap(t, getResp.Body)["relations"])
+}
+
+func TestChildCardsRollUpUnderTheirParent(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		resp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	for _, card := range []struct{ project, title, parent string }{
+		{"alpha", "Epic", ""},
+		{"alpha", "Schema", "alpha/card-1"},
+		{"beta", "Client", "alpha/card-1"},
+		{"beta", "Client tests", "beta/card-1"},
+	} {
+		body := map[string]string{"title": card.title, "status": "Todo"}
+		if card.parent != "" {
+			body["parent_id"] = card.parent
+		}
+		resp := doJSON(t, httpServer.URL+"/projects/"+card.project+"/cards", http.MethodPost, body)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		parent, _ := decodeMap(t, resp.Body)["parent_id"].(string)
+		require.Equal(t, card.parent, parent)
+	}
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "beta", "card-1.md"))), "parent: alpha/card-1\n")
+	badResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Orphan", "status": "Todo", "parent_id": "alpha/card-9"})
+	require.Equal(t, http.StatusBadRequest, badResp.StatusCode)
+
+	doneResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/2/move", http.MethodPatch, map[string]string{"status": "Done"})
+	require.Equal(t, http.StatusOK, doneResp.StatusCode)
+
+	listResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	epic := decodeMap(t, listResp.Body)["cards"].([]any)[0].(map[string]any)
+	require.Equal(t, float64(1), epic["children_done"])
+	require.Equal(t, float64(2), epic["children_total"])
+
+	treeResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/tree", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, treeResp.StatusCode)
+	tree := decodeMap(t, treeResp.Body)
+	require.Equal(t, "alpha/card-1", tree["id"])
+	children := tree["children"].([]any)
+	require.Len(t, children, 2)
+	require.Equal(t, "alpha/card-2", children[0].(map[string]any)["id"])
+	client := children[1].(map[string]any)
+	require.Equal(t, "beta/card-1", client["id"])
+	require.Equal(t, float64(1), client["children_total"])
+	require.Equal(t, "beta/card-2", client["children"].([]any)[0].(map[string]any)["id"])
+
+	missingResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/9/tree", http.MethodGet, nil)
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+}
+
+func TestCardTransferLeavesRedirectingTombstone(t *testing.T) {
+	t.Parallel()
+
+	dataDir, sqlitePath, httpServer := newTestServer(t)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		resp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": name})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	for _, title := range []string{"Beta one", "Beta two"} {
+		resp := doJSON(t, httpServer.URL+"/projects/beta/cards", http.MethodPost, map[string]string{"title": title, "status": "Todo"})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	createResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Misfiled", "description": "belongs to beta", "status": "Doing"})
+	require.Equal(t, http.StatusCreated, createResp.StatusCode)
+	commentResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/comments", http.MethodPost, map[string]string{"body": "noticed"})
+	require.Equal(t, http.StatusOK, commentResp.StatusCode)
+	todoResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/todos", http.MethodPost, map[string]string{"text": "refile"})
+	require.Equal(t, http.StatusCreated, todoResp.StatusCode)
+
+	sameResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/transfer", http.MethodPost, map[string]string{"target_project": "alpha"})
+	require.Equal(t, http.StatusBadRequest, sameResp.StatusCode)
+	missingResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/transfer", http.MethodPost, map[string]string{"target_project": "gamma"})
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+
+	transferResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/transfer", http.MethodPost, map[string]string{"target_project": "beta"})
+	require.Equal(t, http.StatusOK, transferResp.StatusCode)
+	require.Equal(t, `"1"`, transferResp.Header.Get("ETag"))
+	moved := decodeMap(t, transferResp.Body)
+	require.Equal(t, "beta/card-3", moved["id"])
+	require.Equal(t, "beta", moved["project"])
+	require.Equal(t, "Doing", moved["status"])
+	require.Len(t, moved["description"], 1)
+	require.Len(t, moved["comments"], 1)
+	require.Len(t, moved["todos"], 1)
+	history := moved["history"].([]any)
+	require.Equal(t, "card.transferred", history[len(history)-1].(map[string]any)["type"])
+
+	tombstone := string(readFile(t, filepath.Join(dataDir, "projects", "alpha", "card-1.md")))
+	require.Contains(t, tombstone, "moved_to: beta/card-3")
+	require.Contains(t, tombstone, "deleted: true")
+
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	redirectResp, err := noRedirect.Get(httpServer.URL + "/projects/alpha/cards/1")
+	require.NoError(t, err)
+	defer redirectResp.Body.Close()
+	require.Equal(t, http.StatusPermanentRedirect, redirectResp.StatusCode)
+	require.Equal(t, "/projects/beta/cards/3", redirectResp.Header.Get("Location"))
+	require.Equal(t, "beta/card-3", decodeMap(t, redirectResp.Body)["moved_to"])
+
+	followResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, followResp.StatusCode)
+	require.Equal(t, "beta/card-3", decodeMap(t, followResp.Body)["id"])
+
+	againResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/transfer", http.MethodPost, map[string]string{"target_project": "beta"})
+	require.Equal(t, http.StatusBadRequest, againResp.StatusCode)
+	restoreResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusBadRequest, restoreResp.StatusCode)
+
+	db, err := sql.Open("sqlite", sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	var movedTo string
+	err = db.QueryRow(`SELECT moved_to FROM cards WHERE project_slug = 'alpha' AND number = 1`).Scan(&movedTo)
+	require.NoError(t, err)
+	require.Equal(t, "beta/card-3", movedTo)
+	var nextSeq int
+	err = db.QueryRow(`SELECT next_card_seq FROM projects WHERE slug = 'beta'`).Scan(&nextSeq)
+	require.NoError(t, err)
+	require.Equal(t, 4, nextSeq)
+}
+
+func TestCardWIPLimitsRefuseUnlessForced(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Alpha")
+
+	limitResp := doJSON(t, httpServer.URL+"/projects/alpha", http.MethodPatch, map[string]any{"wip_limits": map[string]int{"Doing": 1}})
+	require.Equal(t, http.StatusOK, limitResp.StatusCode)
+	require.Equal(t, map[string]any{"Doing": float64(1)}, decodeMap(t, limitResp.Body)["wip_limits"])
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "alpha", "project.md"))), "wip_limits:\n    Doing: 1\n")
+
+	for _, status := range []string{"Doing", "Todo"} {
+		resp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": status})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	createResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Doing"})
+	require.Equal(t, http.StatusConflict, createResp.StatusCode)
+	require.Contains(t, decodeMap(t, createResp.Body)["detail"], "WIP limit of 1")
+	moveResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/2/move", http.MethodPatch, map[string]string{"status": "Doing"})
+	require.Equal(t, http.StatusConflict, moveResp.StatusCode)
+
+	forceResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/2/move", http.MethodPatch, map[string]any{"status": "Doing", "force": true})
+	require.Equal(t, http.StatusOK, forceResp.StatusCode)
+	history := decodeMap(t, forceResp.Body)["history"].([]any)
+	require.Equal(t, "card.wip_limit.exceeded", history[len(history)-1].(map[string]any)["type"])
+
+	listResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	require.Equal(t, map[string]any{"Doing": float64(1)}, decodeMap(t, listResp.Body)["wip_limits"])
+
+	badResp := doJSON(t, httpServer.URL+"/projects/alpha", http.MethodPatch, map[string]any{"wip_limits": map[string]int{"QA": 1}})
+	require.Equal(t, http.StatusBadRequest, badResp.StatusCode)
+}
+
+func TestCardTransitionRulesRefuseUnlessForced(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Alpha")
+
+	rulesResp := doJSON(t, httpServer.URL+"/projects/alpha/transition-rules", http.MethodPut, map[string]any{
+		"rules": []map[string]any{{"from": "Review", "to": "Done", "requires": []string{"acceptance_criteria_completed"}}},
+	})
+	require.Equal(t, http.StatusOK, rulesResp.StatusCode)
+	require.Len(t, decodeMap(t, rulesResp.Body)["transition_rules"], 1)
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "alpha", "project.md"))), "transition_rules:\n    - from: Review\n      to: Done\n")
+
+	badResp := doJSON(t, httpServer.URL+"/projects/alpha/transition-rules", http.MethodPut, map[string]any{
+		"rules": []map[string]any{{"to": "Done", "requires": []string{"reviewed"}}},
+	})
+	require.Equal(t, http.StatusBadRequest, badResp.StatusCode)
+	missingResp := doJSON(t, httpServer.URL+"/projects/missing/transition-rules", http.MethodPut, map[string]any{"rules": []any{}})
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+
+	createResp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Review"})
+	require.Equal(t, http.StatusCreated, createResp.StatusCode)
+	criterionResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/acceptance", http.MethodPost, map[string]string{"text": "Works"})
+	require.Equal(t, http.StatusCreated, criterionResp.StatusCode)
+
+	moveResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]string{"status": "Done"})
+	require.Equal(t, http.StatusBadRequest, moveResp.StatusCode)
+	require.Contains(t, decodeMap(t, moveResp.Body)["detail"], "1 of 1 acceptance criteria open")
+	patchResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodPatch, map[string]string{"status": "Done"})
+	require.Equal(t, http.StatusBadRequest, patchResp.StatusCode)
+	getResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodGet, nil)
+	for _, event := range decodeMap(t, getResp.Body)["history"].([]any) {
+		require.NotEqual(t, "card.transition.overridden", event.(map[string]any)["type"], "refused moves are not overrides")
+	}
+
+	forceResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]any{"status": "Done", "force": true})
+	require.Equal(t, http.StatusOK, forceResp.StatusCode)
+	history := decodeMap(t, forceResp.Body)["history"].([]any)
+	last := history[len(history)-1].(map[string]any)
+	require.Equal(t, "card.transition.overridden", last["type"])
+	require.Equal(t, "Review -> Done: 1 of 1 acceptance criteria open", last["details"])
+
+	backResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]string{"status": "Review"})
+	require.Equal(t, http.StatusOK, backResp.StatusCode)
+	forcePatchResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodPatch, map[string]any{"status": "Done", "force": true})
+	require.Equal(t, http.StatusOK, forcePatchResp.StatusCode)
+	history = decodeMap(t, forcePatchResp.Body)["history"].([]any)
+	require.Equal(t, "card.transition.overridden", history[len(history)-1].(map[string]any)["type"])
+}
+
+func TestCardExposesHandWrittenNotes(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Alpha")
+	created := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, created.StatusCode)
+	require.NotContains(t, decodeMap(t, created.Body), "notes")
+
+	cardPath := filepath.Join(dataDir, "projects", "alpha", "card-1.md")
+	require.NoError(t, os.WriteFile(cardPath, append(readFile(t, cardPath), "\n# Notes\nAsk ops about the rollout.\n"...), 0o644))
+
+	card := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, card.StatusCode)
+	require.Equal(t, "Ask ops about the rollout.", decodeMap(t, card.Body)["notes"])
+
+	comment := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/comments", http.MethodPost, map[string]string{"body": "noted"})
+	require.Equal(t, http.StatusOK, comment.StatusCode)
+	require.Equal(t, "Ask ops about the rollout.", decodeMap(t, comment.Body)["notes"])
+	require.Contains(t, string(readFile(t, cardPath)), "# Notes\nAsk ops about the rollout.\n")
+}
+
+func TestMoveCardPlacesCardWithinStatus(t *testing.T) {
+	t.Parallel()
+
+	_, _, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Alpha")
+	for _, status := range []string{"Todo", "Todo", "Todo", "Doing"} {
+		resp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": status})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+	order := func() []float64 {
+		t.Helper()
+		resp := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var numbers []float64
+		for _, card := range decodeMap(t, resp.Body)["cards"].([]any) {
+			numbers = append(numbers, card.(map[string]any)["number"].(float64))
+		}
+		return numbers
+	}
+	require.Equal(t, []float64{1, 2, 3, 4}, order())
+
+	moveResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/3/move", http.MethodPatch, map[string]any{"status": "Todo", "before": 1})
+	require.Equal(t, http.StatusOK, moveResp.StatusCode)
+	require.NotEmpty(t, decodeMap(t, moveResp.Body)["rank"])
+	require.Equal(t, []float64{3, 1, 2, 4}, order())
+
+	moveResp = doJSON(t, httpServer.URL+"/projects/alpha/cards/1/move", http.MethodPatch, map[string]any{"status": "Doing", "before": 4})
+	require.Equal(t, http.StatusOK, moveResp.StatusCode)
+	require.Equal(t, []float64{3, 2, 1, 4}, order())
+
+	badResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/2/move", http.MethodPatch, map[string]any{"status": "Todo", "after": 4})
+	require.Equal(t, http.StatusBadRequest, badResp.StatusCode)
+	require.Contains(t, decodeMap(t, badResp.Body)["detail"], "card 4 is not in status Todo")
+
+	numberResp := doJSON(t, httpServer.URL+"/projects/alpha/cards?sort=number", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, numberResp.StatusCode)
+	require.Equal(t, float64(1), decodeMap(t, numberResp.Body)["cards"].([]any)[0].(map[string]any)["number"])
+}
+
 func TestCardResponsesUseEmptyCollectionsWhenUnset(t *testing.T) {
 	t.Parallel()
 
@@ -269,6 +775,19 @@ func TestCardTodoLifecycle(t *testing.T) {
 	require.Equal(t, float64(2), todos[0].(map[string]any)["id"])
 	require.Equal(t, float64(3), todos[1].(map[string]any)["id"])
 
+	edit := doJSON(t, httpServer.URL+"/projects/todo-board/cards/1/todos/3", http.MethodPatch, map[string]any{"text": "Ship it", "position": 1})
+	require.Equal(t, http.StatusOK, edit.StatusCode)
+	editBody := decodeMap(t, edit.Body)
+	require.Equal(t, "Ship it", editBody["text"])
+	require.Equal(t, false, editBody["completed"])
+
+	badPosition := doJSON(t, httpServer.URL+"/projects/todo-board/cards/1/todos/3", http.MethodPatch, map[string]any{"position": 5})
+	require.Equal(t, http.StatusBadRequest, badPosition.StatusCode)
+
+	reordered := decodeMap(t, doJSON(t, httpServer.URL+"/projects/todo-board/cards/1/todos", http.MethodGet, nil).Body)["todos"].([]any)
+	require.Equal(t, float64(3), reordered[0].(map[string]any)["id"])
+	require.Equal(t, float64(2), reordered[1].(map[string]any)["id"])
+
 	getCard := doJSON(t, httpServer.URL+"/projects/todo-board/cards/1", http.MethodGet, nil)
 	require.Equal(t, http.StatusOK, getCard.StatusCode)
 	getCardBody := decodeMap(t, getCard.Body)
@@ -340,3 +859,58 @@ func TestCardAcceptanceCriteriaLifecycle(t *testing.T) {
 	require.Equal(t, float64(2), summary["acceptance_criteria_count"])
 	require.Equal(t, float64(0), summary["acceptance_criteria_completed_count"])
 }
+
+func TestCardAttachmentsUploadDownloadAndDelete(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	resp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Alpha"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp = doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodPost, map[string]string{"title": "Task", "status": "Todo"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	upload := func(filename, content string) *http.Response {
+		t.Helper()
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("file", filename)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return doRaw(t, httpServer.URL+"/projects/alpha/cards/1/attachments", http.MethodPost, body.String(), writer.FormDataContentType())
+	}
+
+	uploadResp := upload("build.log", "all green\n")
+	require.Equal(t, http.StatusCreated, uploadResp.StatusCode)
+	require.Equal(t, `"2"`, uploadResp.Header.Get("ETag"))
+	attachment := decodeMap(t, uploadResp.Body)
+	require.Equal(t, "build.log", attachment["filename"])
+	require.Equal(t, float64(10), attachment["size"])
+	require.Equal(t, "text/plain; charset=utf-8", attachment["content_type"])
+	require.Len(t, attachment["sha256"], 64)
+	require.Equal(t, "all green\n", string(readFile(t, filepath.Join(dataDir, "projects", "alpha", "attachments", "card-1", "build.log"))))
+	require.Equal(t, http.StatusBadRequest, upload(".env", "x").StatusCode)
+
+	listResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/attachments", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	require.Len(t, decodeMap(t, listResp.Body)["attachments"], 1)
+	getResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1", http.MethodGet, nil)
+	require.Len(t, decodeMap(t, getResp.Body)["attachments"], 1)
+
+	downloadResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/attachments/build.log", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, downloadResp.StatusCode)
+	require.Equal(t, "text/plain; charset=utf-8", downloadResp.Header.Get("Content-Type"))
+	require.Equal(t, `attachment; filename=build.log`, downloadResp.Header.Get("Content-Disposition"))
+	require.Equal(t, "all green\n", string(readBody(t, downloadResp.Body)))
+	missingResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/attachments/other.log", http.MethodGet, nil)
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+
+	deleteResp := doJSON(t, httpServer.URL+"/projects/alpha/cards/1/attachments/build.log", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, deleteResp.StatusCode)
+	require.Equal(t, `"3"`, deleteResp.Header.Get("ETag"))
+	require.NoFileExists(t, filepath.Join(dataDir, "projects", "alpha", "attachments", "card-1", "build.log"))
+	listResp = doJSON(t, httpServer.URL+"/projects/alpha/cards/1/attachments", http.MethodGet, nil)
+	require.Equal(t, []any{}, decodeMap(t, listResp.Body)["attachments"])
+}
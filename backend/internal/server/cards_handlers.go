@@ -3,6 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/simonjohansson/kanban/backend/internal/model"
@@ -12,7 +17,9 @@ type createCardRequest struct {
 	Title       string  `json:"title"`
 	Description *string `json:"description,omitempty"`
 	Branch      *string `json:"branch,omitempty"`
-	Status      string  `json:"status"`
+	Status      string  `json:"status" doc:"One of the project's statuses"`
+	ParentID    *string `json:"parent_id,omitempty"`
+	Force       bool    `json:"force,omitempty"`
 }
 
 type createCardInput struct {
@@ -21,37 +28,47 @@ type createCardInput struct {
 }
 
 type createCardOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
 func (s *Server) createCard(_ context.Context, input *createCardInput) (*createCardOutput, error) {
-	card, err := s.service.CreateCard(input.Project, input.Body.Title, stringOrEmpty(input.Body.Description), stringOrEmpty(input.Body.Branch), input.Body.Status)
+	card, err := s.service.CreateCard(input.Project, input.Body.Title, stringOrEmpty(input.Body.Description), stringOrEmpty(input.Body.Branch), input.Body.Status, stringOrEmpty(input.Body.ParentID), input.Body.Force)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
 
-	out := &createCardOutput{Body: card}
-	return out, nil
+	return &createCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
 }
 
 type listCardsInput struct {
 	Project        string `path:"project"`
 	IncludeDeleted bool   `query:"include_deleted"`
+	Label          string `query:"label"`
+	Sort           string `query:"sort"`
+	Overdue        bool   `query:"overdue"`
 }
 
 type listCardsOutput struct {
 	Body struct {
-		Cards []model.CardSummary `json:"cards"`
+		Cards     []model.CardSummary `json:"cards"`
+		WIPLimits map[string]int      `json:"wip_limits,omitempty" doc:"The project's WIP limits by status"`
 	}
 }
 
 func (s *Server) listCards(_ context.Context, input *listCardsInput) (*listCardsOutput, error) {
-	cards, err := s.service.ListCards(input.Project, input.IncludeDeleted)
+	cards, err := s.service.ListCards(input.Project, model.CardListOptions{
+		IncludeDeleted: input.IncludeDeleted,
+		Label:          input.Label,
+		Sort:           input.Sort,
+		Overdue:        input.Overdue,
+	})
 	if err != nil {
 		return nil, toHumaError(err)
 	}
 	out := &listCardsOutput{}
 	out.Body.Cards = cards
+	out.Body.WIPLimits = s.service.WIPLimits(input.Project)
 	return out, nil
 }
 
@@ -61,6 +78,7 @@ type cardPathInput struct {
 }
 
 type getCardOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -73,20 +91,41 @@ func (s *Server) getCard(_ context.Context, input *cardPathInput) (*getCardOutpu
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &getCardOutput{Body: card}, nil
+	return &getCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type cardTreeOutput struct {
+	Body model.CardTreeNode
+}
+
+func (s *Server) getCardTree(_ context.Context, input *cardPathInput) (*cardTreeOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	tree, err := s.service.CardTree(input.Project, number)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &cardTreeOutput{Body: tree}, nil
 }
 
 type moveCardRequest struct {
-	Status string `json:"status"`
+	Status string `json:"status" doc:"One of the project's statuses"`
+	Before int    `json:"before,omitempty" doc:"Place the card directly before this card of the status"`
+	After  int    `json:"after,omitempty" doc:"Place the card directly after this card of the status"`
+	Force  bool   `json:"force,omitempty"`
 }
 
 type moveCardInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    moveCardRequest
 }
 
 type moveCardOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -95,11 +134,16 @@ func (s *Server) moveCard(_ context.Context, input *moveCardInput) (*moveCardOut
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	card, err := s.service.MoveCard(input.Project, number, input.Body.Status)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	position := model.CardPosition{Before: input.Body.Before, After: input.Body.After}
+	card, err := s.service.MoveCard(input.Project, number, input.Body.Status, position, input.Body.Force, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &moveCardOutput{Body: card}, nil
+	return &moveCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
 }
 
 type textBodyRequest struct {
@@ -109,10 +153,12 @@ type textBodyRequest struct {
 type commentCardInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    textBodyRequest
 }
 
 type commentCardOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -121,20 +167,26 @@ func (s *Server) commentCard(_ context.Context, input *commentCardInput) (*comme
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	card, err := s.service.CommentCard(input.Project, number, input.Body.Body)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.CommentCard(input.Project, number, input.Body.Body, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &commentCardOutput{Body: card}, nil
+	return &commentCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
 }
 
 type appendDescriptionInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    textBodyRequest
 }
 
 type appendDescriptionOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -143,11 +195,15 @@ func (s *Server) appendDescription(_ context.Context, input *appendDescriptionIn
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	card, err := s.service.AppendDescription(input.Project, number, input.Body.Body)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.AppendDescription(input.Project, number, input.Body.Body, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &appendDescriptionOutput{Body: card}, nil
+	return &appendDescriptionOutput{ETag: cardETag(card.Revision), Body: card}, nil
 }
 
 type setCardBranchRequest struct {
@@ -157,10 +213,12 @@ type setCardBranchRequest struct {
 type setCardBranchInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    setCardBranchRequest
 }
 
 type setCardBranchOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -169,20 +227,130 @@ func (s *Server) setCardBranch(_ context.Context, input *setCardBranchInput) (*s
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	card, err := s.service.SetCardBranch(input.Project, number, input.Body.Branch)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.SetCardBranch(input.Project, number, input.Body.Branch, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &setCardBranchOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type setCardPriorityRequest struct {
+	Priority string `json:"priority"`
+}
+
+type setCardPriorityInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    setCardPriorityRequest
+}
+
+type setCardPriorityOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) setCardPriority(_ context.Context, input *setCardPriorityInput) (*setCardPriorityOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.SetCardPriority(input.Project, number, input.Body.Priority, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &setCardPriorityOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type setCardDueRequest struct {
+	DueAt string `json:"due_at"`
+}
+
+type setCardDueInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    setCardDueRequest
+}
+
+type setCardDueOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) setCardDue(_ context.Context, input *setCardDueInput) (*setCardDueOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	dueAt, err := parseDueAt(input.Body.DueAt)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.SetCardDue(input.Project, number, dueAt, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &setCardDueOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type updateCardRequest struct {
+	Title  *string `json:"title,omitempty"`
+	Branch *string `json:"branch,omitempty"`
+	Status *string `json:"status,omitempty" doc:"One of the project's statuses"`
+	Force  bool    `json:"force,omitempty"`
+}
+
+type updateCardInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    updateCardRequest
+}
+
+type updateCardOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) updateCard(_ context.Context, input *updateCardInput) (*updateCardOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	patch := model.CardPatch{Title: input.Body.Title, Branch: input.Body.Branch, Status: input.Body.Status}
+	card, err := s.service.UpdateCard(input.Project, number, patch, input.Body.Force, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &setCardBranchOutput{Body: card}, nil
+	return &updateCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
 }
 
 type deleteCardInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Hard    bool   `query:"hard"`
 }
 
 type deleteCardOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Card
 }
 
@@ -191,12 +359,229 @@ func (s *Server) deleteCard(_ context.Context, input *deleteCardInput) (*deleteC
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	card, err := s.service.DeleteCard(input.Project, number, input.Hard)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.DeleteCard(input.Project, number, input.Hard, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+
+	return &deleteCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type restoreCardInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+}
+
+type restoreCardOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) restoreCard(_ context.Context, input *restoreCardInput) (*restoreCardOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.RestoreCard(input.Project, number, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+
+	return &restoreCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type transferCardRequest struct {
+	TargetProject string `json:"target_project"`
+}
+
+type transferCardInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    transferCardRequest
+}
+
+type transferCardOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) transferCard(_ context.Context, input *transferCardInput) (*transferCardOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.MoveCardToProject(input.Project, number, input.Body.TargetProject, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
 
-	return &deleteCardOutput{Body: card}, nil
+	return &transferCardOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type addCardLabelRequest struct {
+	Label string `json:"label"`
+}
+
+type addCardLabelInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    addCardLabelRequest
+}
+
+type cardLabelOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) addCardLabel(_ context.Context, input *addCardLabelInput) (*cardLabelOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.AddCardLabel(input.Project, number, input.Body.Label, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &cardLabelOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type removeCardLabelInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	Label   string `path:"label"`
+	IfMatch string `header:"If-Match"`
+}
+
+func (s *Server) removeCardLabel(_ context.Context, input *removeCardLabelInput) (*cardLabelOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.RemoveCardLabel(input.Project, number, input.Label, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &cardLabelOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+type addCardRelationRequest struct {
+	Type   string `json:"type"`
+	CardID string `json:"card_id"`
+}
+
+type addCardRelationInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	Body    addCardRelationRequest
+}
+
+type cardRelationOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Card
+}
+
+func (s *Server) addCardRelation(_ context.Context, input *addCardRelationInput) (*cardRelationOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	card, err := s.service.AddCardRelation(input.Project, number, input.Body.Type, input.Body.CardID, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &cardRelationOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+// removeCardRelationInput names the related card by project and number, as a
+// card ID contains a slash.
+type removeCardRelationInput struct {
+	Project       string `path:"project"`
+	Number        int    `path:"number"`
+	Type          string `path:"type"`
+	TargetProject string `path:"target_project"`
+	TargetNumber  int    `path:"target_number"`
+	IfMatch       string `header:"If-Match"`
+}
+
+func (s *Server) removeCardRelation(_ context.Context, input *removeCardRelationInput) (*cardRelationOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	targetID := fmt.Sprintf("%s/card-%d", input.TargetProject, input.TargetNumber)
+	card, err := s.service.RemoveCardRelation(input.Project, number, input.Type, targetID, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &cardRelationOutput{ETag: cardETag(card.Revision), Body: card}, nil
+}
+
+// parseIfMatch returns the card revision named by an If-Match header, or 0
+// when the header is absent or "*" and the write is unconditional.
+func parseIfMatch(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+	revision, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(value, "W/"), `"`))
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header")
+	}
+	return revision, nil
+}
+
+// parseDueAt accepts an RFC 3339 timestamp or a plain YYYY-MM-DD date, which
+// means the end of that day in UTC. An empty value clears the due date.
+func parseDueAt(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if dueAt, err := time.Parse(time.RFC3339, value); err == nil {
+		return &dueAt, nil
+	}
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due_at %q: use YYYY-MM-DD or an RFC 3339 timestamp", value)
+	}
+	dueAt := day.Add(24*time.Hour - time.Second)
+	return &dueAt, nil
+}
+
+func cardETag(revision int) string {
+	return strconv.Quote(strconv.Itoa(revision))
 }
 
 func normalizeCardNumber(number int) (int, error) {
@@ -213,10 +598,12 @@ type addTodoRequest struct {
 type addTodoInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    addTodoRequest
 }
 
 type addTodoOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Todo
 }
 
@@ -225,11 +612,15 @@ func (s *Server) addTodo(_ context.Context, input *addTodoInput) (*addTodoOutput
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	todo, err := s.service.AddTodo(input.Project, number, input.Body.Text)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	todo, card, err := s.service.AddTodo(input.Project, number, input.Body.Text, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &addTodoOutput{Body: todo}, nil
+	return &addTodoOutput{ETag: cardETag(card.Revision), Body: todo}, nil
 }
 
 type listTodosInput struct {
@@ -258,23 +649,28 @@ func (s *Server) listTodos(_ context.Context, input *listTodosInput) (*listTodos
 }
 
 type updateTodoRequest struct {
-	Completed bool `json:"completed"`
+	Text      *string `json:"text,omitempty"`
+	Completed *bool   `json:"completed,omitempty"`
+	Position  *int    `json:"position,omitempty"`
 }
 
 type todoPathInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
 	TodoID  int    `path:"todo_id"`
+	IfMatch string `header:"If-Match"`
 }
 
 type updateTodoInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
 	TodoID  int    `path:"todo_id"`
+	IfMatch string `header:"If-Match"`
 	Body    updateTodoRequest
 }
 
 type updateTodoOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Todo
 }
 
@@ -287,14 +683,20 @@ func (s *Server) updateTodo(_ context.Context, input *updateTodoInput) (*updateT
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	todo, err := s.service.SetTodoCompleted(input.Project, number, todoID, input.Body.Completed)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	patch := model.ChecklistItemPatch{Text: input.Body.Text, Completed: input.Body.Completed, Position: input.Body.Position}
+	todo, card, err := s.service.UpdateTodo(input.Project, number, todoID, patch, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &updateTodoOutput{Body: todo}, nil
+	return &updateTodoOutput{ETag: cardETag(card.Revision), Body: todo}, nil
 }
 
 type deleteTodoOutput struct {
+	ETag string `header:"ETag"`
 	Body model.Todo
 }
 
@@ -307,11 +709,15 @@ func (s *Server) deleteTodo(_ context.Context, input *todoPathInput) (*deleteTod
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	todo, err := s.service.DeleteTodo(input.Project, number, todoID)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	todo, card, err := s.service.DeleteTodo(input.Project, number, todoID, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &deleteTodoOutput{Body: todo}, nil
+	return &deleteTodoOutput{ETag: cardETag(card.Revision), Body: todo}, nil
 }
 
 func normalizeTodoID(todoID int) (int, error) {
@@ -328,10 +734,12 @@ type addAcceptanceCriterionRequest struct {
 type addAcceptanceCriterionInput struct {
 	Project string `path:"project"`
 	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
 	Body    addAcceptanceCriterionRequest
 }
 
 type addAcceptanceCriterionOutput struct {
+	ETag string `header:"ETag"`
 	Body model.AcceptanceCriterion
 }
 
@@ -340,11 +748,15 @@ func (s *Server) addAcceptanceCriterion(_ context.Context, input *addAcceptanceC
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	criterion, err := s.service.AddAcceptanceCriterion(input.Project, number, input.Body.Text)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	criterion, card, err := s.service.AddAcceptanceCriterion(input.Project, number, input.Body.Text, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &addAcceptanceCriterionOutput{Body: criterion}, nil
+	return &addAcceptanceCriterionOutput{ETag: cardETag(card.Revision), Body: criterion}, nil
 }
 
 type listAcceptanceCriteriaInput struct {
@@ -376,20 +788,25 @@ type criterionPathInput struct {
 	Project     string `path:"project"`
 	Number      int    `path:"number"`
 	CriterionID int    `path:"criterion_id"`
+	IfMatch     string `header:"If-Match"`
 }
 
 type updateAcceptanceCriterionRequest struct {
-	Completed bool `json:"completed"`
+	Text      *string `json:"text,omitempty"`
+	Completed *bool   `json:"completed,omitempty"`
+	Position  *int    `json:"position,omitempty"`
 }
 
 type updateAcceptanceCriterionInput struct {
 	Project     string `path:"project"`
 	Number      int    `path:"number"`
 	CriterionID int    `path:"criterion_id"`
+	IfMatch     string `header:"If-Match"`
 	Body        updateAcceptanceCriterionRequest
 }
 
 type updateAcceptanceCriterionOutput struct {
+	ETag string `header:"ETag"`
 	Body model.AcceptanceCriterion
 }
 
@@ -402,14 +819,20 @@ func (s *Server) updateAcceptanceCriterion(_ context.Context, input *updateAccep
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	criterion, err := s.service.SetAcceptanceCriterionCompleted(input.Project, number, criterionID, input.Body.Completed)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	patch := model.ChecklistItemPatch{Text: input.Body.Text, Completed: input.Body.Completed, Position: input.Body.Position}
+	criterion, card, err := s.service.UpdateAcceptanceCriterion(input.Project, number, criterionID, patch, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &updateAcceptanceCriterionOutput{Body: criterion}, nil
+	return &updateAcceptanceCriterionOutput{ETag: cardETag(card.Revision), Body: criterion}, nil
 }
 
 type deleteAcceptanceCriterionOutput struct {
+	ETag string `header:"ETag"`
 	Body model.AcceptanceCriterion
 }
 
@@ -422,11 +845,15 @@ func (s *Server) deleteAcceptanceCriterion(_ context.Context, input *criterionPa
 	if err != nil {
 		return nil, huma.Error400BadRequest(err.Error())
 	}
-	criterion, err := s.service.DeleteAcceptanceCriterion(input.Project, number, criterionID)
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	criterion, card, err := s.service.DeleteAcceptanceCriterion(input.Project, number, criterionID, revision)
 	if err != nil {
 		return nil, toHumaError(err)
 	}
-	return &deleteAcceptanceCriterionOutput{Body: criterion}, nil
+	return &deleteAcceptanceCriterionOutput{ETag: cardETag(card.Revision), Body: criterion}, nil
 }
 
 func normalizeCriterionID(criterionID int) (int, error) {
@@ -435,3 +862,121 @@ func normalizeCriterionID(criterionID int) (int, error) {
 	}
 	return criterionID, nil
 }
+
+// maxAttachmentBytes caps a single upload; the whole request body is held in
+// memory while it is checksummed and written.
+const maxAttachmentBytes = 32 << 20
+
+type uploadAttachmentForm struct {
+	File huma.FormFile `form:"file" required:"true"`
+}
+
+type uploadAttachmentInput struct {
+	Project string `path:"project"`
+	Number  int    `path:"number"`
+	IfMatch string `header:"If-Match"`
+	RawBody huma.MultipartFormFiles[uploadAttachmentForm]
+}
+
+type uploadAttachmentOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Attachment
+}
+
+func (s *Server) uploadAttachment(_ context.Context, input *uploadAttachmentInput) (*uploadAttachmentOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	file := input.RawBody.Data().File
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, huma.Error400BadRequest("read upload: " + err.Error())
+	}
+	attachment, card, err := s.service.AddAttachment(input.Project, number, file.Filename, file.ContentType, data, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &uploadAttachmentOutput{ETag: cardETag(card.Revision), Body: attachment}, nil
+}
+
+type listAttachmentsOutput struct {
+	Body struct {
+		Attachments []model.Attachment `json:"attachments"`
+	}
+}
+
+func (s *Server) listAttachments(_ context.Context, input *cardPathInput) (*listAttachmentsOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	attachments, err := s.service.ListAttachments(input.Project, number)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	out := &listAttachmentsOutput{}
+	out.Body.Attachments = attachments
+	return out, nil
+}
+
+type attachmentPathInput struct {
+	Project  string `path:"project"`
+	Number   int    `path:"number"`
+	Filename string `path:"filename"`
+}
+
+type downloadAttachmentOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+func (s *Server) downloadAttachment(_ context.Context, input *attachmentPathInput) (*downloadAttachmentOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	attachment, data, err := s.service.ReadAttachment(input.Project, number, input.Filename)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &downloadAttachmentOutput{
+		ContentType:        attachment.ContentType,
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+		Body:               data,
+	}, nil
+}
+
+type deleteAttachmentInput struct {
+	Project  string `path:"project"`
+	Number   int    `path:"number"`
+	Filename string `path:"filename"`
+	IfMatch  string `header:"If-Match"`
+}
+
+type deleteAttachmentOutput struct {
+	ETag string `header:"ETag"`
+	Body model.Attachment
+}
+
+func (s *Server) deleteAttachment(_ context.Context, input *deleteAttachmentInput) (*deleteAttachmentOutput, error) {
+	number, err := normalizeCardNumber(input.Number)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	revision, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	attachment, card, err := s.service.DeleteAttachment(input.Project, number, input.Filename, revision)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &deleteAttachmentOutput{ETag: cardETag(card.Revision), Body: attachment}, nil
+}
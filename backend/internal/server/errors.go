@@ -1,10 +1,13 @@
 package server
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/simonjohansson/kanban/backend/internal/model"
 	"github.com/simonjohansson/kanban/backend/internal/service"
 )
 
@@ -19,6 +22,16 @@ func toHumaError(err error) error {
 	code := service.CodeOf(err)
 	msg := service.MessageOf(err)
 	switch code {
+	case service.CodePreconditionFailed:
+		if card, ok := service.CurrentCardOf(err); ok {
+			return &preconditionFailedError{card: card}
+		}
+		return huma.Error412PreconditionFailed(msg)
+	case service.CodeMoved:
+		if card, ok := service.CurrentCardOf(err); ok {
+			return &cardMovedError{tombstone: card}
+		}
+		return huma.Error404NotFound(msg)
 	case service.CodeConflict:
 		return huma.Error409Conflict(msg)
 	case service.CodeNotFound:
@@ -33,6 +46,10 @@ func toHumaError(err error) error {
 func statusForError(err error) int {
 	code := service.CodeOf(err)
 	switch code {
+	case service.CodePreconditionFailed:
+		return http.StatusPreconditionFailed
+	case service.CodeMoved:
+		return http.StatusPermanentRedirect
 	case service.CodeConflict:
 		return http.StatusConflict
 	case service.CodeNotFound:
@@ -43,3 +60,54 @@ func statusForError(err error) int {
 		return http.StatusInternalServerError
 	}
 }
+
+// preconditionFailedError answers a stale If-Match with the card as it is now,
+// so the caller can reapply its change without another round trip.
+type preconditionFailedError struct {
+	card model.Card
+}
+
+func (e *preconditionFailedError) Error() string {
+	return http.StatusText(http.StatusPreconditionFailed)
+}
+
+func (e *preconditionFailedError) GetStatus() int {
+	return http.StatusPreconditionFailed
+}
+
+func (e *preconditionFailedError) GetHeaders() http.Header {
+	headers := http.Header{}
+	headers.Set("ETag", cardETag(e.card.Revision))
+	return headers
+}
+
+func (e *preconditionFailedError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.card)
+}
+
+// cardMovedError redirects a read of a transferred card to its new location.
+// The body is the tombstone, so clients that do not follow redirects still
+// learn the new card ID from moved_to.
+type cardMovedError struct {
+	tombstone model.Card
+}
+
+func (e *cardMovedError) Error() string {
+	return http.StatusText(http.StatusPermanentRedirect)
+}
+
+func (e *cardMovedError) GetStatus() int {
+	return http.StatusPermanentRedirect
+}
+
+func (e *cardMovedError) GetHeaders() http.Header {
+	headers := http.Header{}
+	if slug, number, ok := strings.Cut(e.tombstone.MovedTo, "/card-"); ok {
+		headers.Set("Location", "/projects/"+url.PathEscape(slug)+"/cards/"+number)
+	}
+	return headers
+}
+
+func (e *cardMovedError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.tombstone)
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"github.com/simonjohansson/kanban/backend/internal/service"
+	"github.com/simonjohansson/kanban/backend/internal/store"
+)
+
+// ingestFileChange applies a hand edit of the markdown data dir to the
+// projection. Failures are logged rather than returned: a half-saved or broken
+// file is picked up again on the next write or rebuild.
+func (s *Server) ingestFileChange(change store.FileChange) {
+	var err error
+	switch {
+	case change.CardNumber > 0 && change.Removed:
+		err = s.service.IngestCardRemoval(change.ProjectSlug, change.CardNumber)
+	case change.CardNumber > 0:
+		err = s.service.IngestCard(change.ProjectSlug, change.CardNumber)
+	case change.Removed:
+		err = s.service.IngestProjectRemoval(change.ProjectSlug)
+	default:
+		err = s.service.IngestProject(change.ProjectSlug)
+	}
+	if err != nil {
+		s.logger.Warn("ingest markdown change failed",
+			"project", change.ProjectSlug,
+			"card_number", change.CardNumber,
+			"removed", change.Removed,
+			"error", service.MessageOf(err),
+		)
+	}
+}
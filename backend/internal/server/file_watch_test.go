@@ -0,0 +1,93 @@
+package server_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandEditedCardFileIsIngested(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Hand Edits"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+	createCardResp := doJSON(t, httpServer.URL+"/projects/hand-edits/cards", http.MethodPost, map[string]string{
+		"title":  "Original",
+		"status": "Todo",
+	})
+	require.Equal(t, http.StatusCreated, createCardResp.StatusCode)
+
+	wsURL := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/ws?project=hand-edits"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	cardPath := filepath.Join(dataDir, "projects", "hand-edits", "card-1.md")
+	raw := readFile(t, cardPath)
+	edited := strings.Replace(string(raw), "title: Original", "title: Edited in editor", 1)
+	edited = strings.Replace(edited, "status: Todo", "status: Review", 1)
+	require.NoError(t, os.WriteFile(cardPath, []byte(edited), 0o644))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(3*time.Second)))
+	var event map[string]any
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "card.updated", event["type"])
+	require.Equal(t, "hand-edits/card-1", event["card_id"])
+
+	listResp := doJSON(t, httpServer.URL+"/projects/hand-edits/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	cards := decodeMap(t, listResp.Body)["cards"].([]any)
+	require.Len(t, cards, 1)
+	card := cards[0].(map[string]any)
+	require.Equal(t, "Edited in editor", card["title"])
+	require.Equal(t, "Review", card["status"])
+
+	require.NoError(t, os.Remove(cardPath))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(3*time.Second)))
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "card.deleted_hard", event["type"])
+
+	listAfterRemoveResp := doJSON(t, httpServer.URL+"/projects/hand-edits/cards?include_deleted=true", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listAfterRemoveResp.StatusCode)
+	require.Len(t, decodeMap(t, listAfterRemoveResp.Body)["cards"].([]any), 0)
+}
+
+func TestHandEditedProjectFileIsIngestedAsUpdate(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+
+	createProjectResp := doJSON(t, httpServer.URL+"/projects", http.MethodPost, map[string]string{"name": "Hand Edits"})
+	require.Equal(t, http.StatusCreated, createProjectResp.StatusCode)
+
+	wsURL := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/ws?project=hand-edits"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	projectPath := filepath.Join(dataDir, "projects", "hand-edits", "project.md")
+	raw := readFile(t, projectPath)
+	edited := strings.Replace(string(raw), "name: Hand Edits", "name: Edited in editor", 1)
+	require.NoError(t, os.WriteFile(projectPath, []byte(edited), 0o644))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(3*time.Second)))
+	var event map[string]any
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "project.updated", event["type"])
+	require.Equal(t, "hand-edits", event["project"])
+
+	listResp := doJSON(t, httpServer.URL+"/projects", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listResp.StatusCode)
+	projects := decodeMap(t, listResp.Body)["projects"].([]any)
+	require.Len(t, projects, 1)
+	require.Equal(t, "Edited in editor", projects[0].(map[string]any)["name"])
+}
@@ -6,9 +6,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	_ "modernc.org/sqlite"
 
+	"github.com/simonjohansson/kanban/backend/internal/server"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +37,9 @@ func TestDeleteProjectRemovesMarkdownAndProjection(t *testing.T) {
 
 	_, err = os.Stat(filepath.Join(dataDir, "projects", "to-remove"))
 	require.ErrorIs(t, err, os.ErrNotExist)
+	trashEntries, err := os.ReadDir(filepath.Join(dataDir, ".trash"))
+	require.NoError(t, err)
+	require.Len(t, trashEntries, 1)
 
 	listProjects := doJSON(t, httpServer.URL+"/projects", http.MethodGet, nil)
 	require.Equal(t, http.StatusOK, listProjects.StatusCode)
@@ -52,3 +57,103 @@ func TestDeleteProjectRemovesMarkdownAndProjection(t *testing.T) {
 	deleteMissing := doJSON(t, httpServer.URL+"/projects/to-remove", http.MethodDelete, nil)
 	require.Equal(t, http.StatusNotFound, deleteMissing.StatusCode)
 }
+
+func TestDeletedProjectIsKeptInTrashAndCanBeRestored(t *testing.T) {
+	t.Parallel()
+
+	dataDir, sqlitePath, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Keep Me")
+	createCard := doJSON(t, httpServer.URL+"/projects/keep-me/cards", http.MethodPost, map[string]string{"title": "survivor", "status": "Doing"})
+	require.Equal(t, http.StatusCreated, createCard.StatusCode)
+
+	deleteProject := doJSON(t, httpServer.URL+"/projects/keep-me", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, deleteProject.StatusCode)
+
+	listTrash := doJSON(t, httpServer.URL+"/trash/projects", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listTrash.StatusCode)
+	trashed := decodeMap(t, listTrash.Body)["projects"].([]any)
+	require.Len(t, trashed, 1)
+	entry := trashed[0].(map[string]any)
+	require.Equal(t, "keep-me", entry["slug"])
+	require.Equal(t, "Keep Me", entry["name"])
+	require.EqualValues(t, 1, entry["cards_count"])
+	trashID := entry["id"].(string)
+	require.FileExists(t, filepath.Join(dataDir, ".trash", trashID, "card-1.md"))
+
+	// A new project took the slug in the meantime, so restoring must conflict.
+	mustCreateProject(t, httpServer.URL, "Keep Me")
+	conflict := doJSON(t, httpServer.URL+"/trash/projects/"+trashID+"/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusConflict, conflict.StatusCode)
+	require.Contains(t, string(readBody(t, conflict.Body)), `project \"keep-me\" already exists`)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(dataDir, "projects", "keep-me")))
+	rebuild := doJSON(t, httpServer.URL+"/admin/rebuild", http.MethodPost, nil)
+	require.Equal(t, http.StatusOK, rebuild.StatusCode)
+
+	restore := doJSON(t, httpServer.URL+"/trash/projects/"+trashID+"/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusOK, restore.StatusCode)
+	require.Equal(t, "keep-me", decodeMap(t, restore.Body)["slug"])
+
+	listCards := doJSON(t, httpServer.URL+"/projects/keep-me/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listCards.StatusCode)
+	cards := decodeMap(t, listCards.Body)["cards"].([]any)
+	require.Len(t, cards, 1)
+	require.Equal(t, "survivor", cards[0].(map[string]any)["title"])
+
+	db, err := sql.Open("sqlite", sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	var cardCount int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM cards WHERE project_slug = 'keep-me'`).Scan(&cardCount))
+	require.Equal(t, 1, cardCount)
+
+	missing := doJSON(t, httpServer.URL+"/trash/projects/"+trashID+"/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusNotFound, missing.StatusCode)
+	invalid := doJSON(t, httpServer.URL+"/trash/projects/..%2Fprojects/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusBadRequest, invalid.StatusCode)
+}
+
+func TestPurgeTrashedProject(t *testing.T) {
+	t.Parallel()
+
+	dataDir, _, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Doomed")
+	deleteProject := doJSON(t, httpServer.URL+"/projects/doomed", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, deleteProject.StatusCode)
+
+	listTrash := doJSON(t, httpServer.URL+"/trash/projects", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, listTrash.StatusCode)
+	trashID := decodeMap(t, listTrash.Body)["projects"].([]any)[0].(map[string]any)["id"].(string)
+
+	purge := doJSON(t, httpServer.URL+"/trash/projects/"+trashID, http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, purge.StatusCode)
+	require.Equal(t, true, decodeMap(t, purge.Body)["purged"])
+	_, err := os.Stat(filepath.Join(dataDir, ".trash", trashID))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	purgeAgain := doJSON(t, httpServer.URL+"/trash/projects/"+trashID, http.MethodDelete, nil)
+	require.Equal(t, http.StatusNotFound, purgeAgain.StatusCode)
+}
+
+func TestServerStartupPurgesExpiredTrash(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	expired := filepath.Join(dataDir, ".trash", "old-20000101T000000.000Z")
+	fresh := filepath.Join(dataDir, ".trash", "new-"+time.Now().UTC().Format("20060102T150405.000Z"))
+	for _, dir := range []string{expired, fresh} {
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+	}
+
+	app, err := server.New(server.Options{
+		DataDir:        dataDir,
+		SQLitePath:     filepath.Join(dataDir, "projection.db"),
+		TrashRetention: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = app.Close() })
+
+	_, err = os.Stat(expired)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.DirExists(t, fresh)
+}
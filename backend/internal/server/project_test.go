@@ -4,8 +4,11 @@ import (
 	"database/sql"
 	"net/http"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	_ "modernc.org/sqlite"
 
 	"github.com/stretchr/testify/require"
@@ -45,3 +48,107 @@ func TestProjectLifecyclePersistsMarkdownAndProjection(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, count)
 }
+
+func TestProjectUpdateRewritesMarkdownAndProjection(t *testing.T) {
+	t.Parallel()
+
+	dataDir, sqlitePath, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Moving Repo")
+
+	wsURL := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/ws?project=moving-repo"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	updateResp := doJSON(t, httpServer.URL+"/projects/moving-repo", http.MethodPatch, map[string]string{
+		"local_path": "/work/moved",
+		"remote_url": "git@example.com:org/renamed.git",
+	})
+	require.Equal(t, http.StatusOK, updateResp.StatusCode)
+	updateBody := decodeMap(t, updateResp.Body)
+	require.Equal(t, "moving-repo", updateBody["slug"])
+	require.Equal(t, "Moving Repo", updateBody["name"])
+	require.Equal(t, "/work/moved", updateBody["local_path"])
+	require.Equal(t, "git@example.com:org/renamed.git", updateBody["remote_url"])
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	var event map[string]any
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, "project.updated", event["type"])
+	require.Equal(t, "moving-repo", event["project"])
+
+	rawProjectMarkdown := string(readFile(t, filepath.Join(dataDir, "projects", "moving-repo", "project.md")))
+	require.Contains(t, rawProjectMarkdown, "local_path: /work/moved")
+	require.Contains(t, rawProjectMarkdown, "remote_url: git@example.com:org/renamed.git")
+
+	db, err := sql.Open("sqlite", sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	var remoteURL string
+	err = db.QueryRow(`SELECT remote_url FROM projects WHERE slug = 'moving-repo'`).Scan(&remoteURL)
+	require.NoError(t, err)
+	require.Equal(t, "git@example.com:org/renamed.git", remoteURL)
+
+	clearResp := doJSON(t, httpServer.URL+"/projects/moving-repo", http.MethodPatch, map[string]string{"local_path": ""})
+	require.Equal(t, http.StatusOK, clearResp.StatusCode)
+	require.NotContains(t, decodeMap(t, clearResp.Body), "local_path")
+
+	emptyResp := doJSON(t, httpServer.URL+"/projects/moving-repo", http.MethodPatch, map[string]string{})
+	require.Equal(t, http.StatusBadRequest, emptyResp.StatusCode)
+
+	blankNameResp := doJSON(t, httpServer.URL+"/projects/moving-repo", http.MethodPatch, map[string]string{"name": "  "})
+	require.Equal(t, http.StatusBadRequest, blankNameResp.StatusCode)
+
+	missingResp := doJSON(t, httpServer.URL+"/projects/missing", http.MethodPatch, map[string]string{"name": "Missing"})
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+}
+
+func TestProjectStatusesReplaceWorkflowAndMigrateCards(t *testing.T) {
+	t.Parallel()
+
+	dataDir, sqlitePath, httpServer := newTestServer(t)
+	mustCreateProject(t, httpServer.URL, "Flow")
+	for _, status := range []string{"Todo", "Done"} {
+		resp := doJSON(t, httpServer.URL+"/projects/flow/cards", http.MethodPost, map[string]string{"title": "Task " + status, "status": status})
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	}
+
+	getResp := doJSON(t, httpServer.URL+"/projects/flow/statuses", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, getResp.StatusCode)
+	require.Equal(t, []any{"Todo", "Doing", "Review", "Done"}, decodeMap(t, getResp.Body)["statuses"])
+
+	refusedResp := doJSON(t, httpServer.URL+"/projects/flow/statuses", http.MethodPut, map[string]any{
+		"statuses": []string{"Backlog", "Doing", "Shipped"},
+	})
+	require.Equal(t, http.StatusBadRequest, refusedResp.StatusCode)
+	require.Contains(t, string(readBody(t, refusedResp.Body)), "cards still use status Done, Todo")
+
+	emptyResp := doJSON(t, httpServer.URL+"/projects/flow/statuses", http.MethodPut, map[string]any{
+		"statuses": []string{},
+	})
+	require.Equal(t, http.StatusBadRequest, emptyResp.StatusCode)
+	require.Contains(t, string(readBody(t, emptyResp.Body)), "at least two statuses")
+
+	setResp := doJSON(t, httpServer.URL+"/projects/flow/statuses", http.MethodPut, map[string]any{
+		"statuses":   []string{"Backlog", "Doing", "Shipped"},
+		"status_map": map[string]string{"Todo": "Backlog", "Done": "Shipped"},
+	})
+	require.Equal(t, http.StatusOK, setResp.StatusCode)
+	require.Equal(t, []any{"Backlog", "Doing", "Shipped"}, decodeMap(t, setResp.Body)["statuses"])
+	require.Contains(t, string(readFile(t, filepath.Join(dataDir, "projects", "flow", "project.md"))), "- Shipped")
+
+	db, err := sql.Open("sqlite", sqlitePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	var status, doneStatus string
+	require.NoError(t, db.QueryRow(`SELECT status FROM cards WHERE project_slug = 'flow' AND number = 2`).Scan(&status))
+	require.Equal(t, "Shipped", status)
+	require.NoError(t, db.QueryRow(`SELECT done_status FROM projects WHERE slug = 'flow'`).Scan(&doneStatus))
+	require.Equal(t, "Shipped", doneStatus)
+
+	invalidResp := doJSON(t, httpServer.URL+"/projects/flow/cards", http.MethodPost, map[string]string{"title": "Old", "status": "Todo"})
+	require.Equal(t, http.StatusBadRequest, invalidResp.StatusCode)
+
+	missingResp := doJSON(t, httpServer.URL+"/projects/missing/statuses", http.MethodGet, nil)
+	require.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+}
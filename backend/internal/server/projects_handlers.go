@@ -46,6 +46,98 @@ func (s *Server) listProjects(_ context.Context, _ *struct{}) (*listProjectsOutp
 	return out, nil
 }
 
+type updateProjectRequest struct {
+	Name      *string        `json:"name,omitempty"`
+	LocalPath *string        `json:"local_path,omitempty"`
+	RemoteURL *string        `json:"remote_url,omitempty"`
+	WIPLimits map[string]int `json:"wip_limits,omitempty" doc:"WIP limit per status to set; 0 removes a limit and unnamed statuses keep theirs"`
+}
+
+type updateProjectInput struct {
+	Project string `path:"project"`
+	Body    updateProjectRequest
+}
+
+type updateProjectOutput struct {
+	Body model.Project
+}
+
+func (s *Server) updateProject(_ context.Context, input *updateProjectInput) (*updateProjectOutput, error) {
+	project, err := s.service.UpdateProject(input.Project, model.ProjectPatch{
+		Name:      input.Body.Name,
+		LocalPath: input.Body.LocalPath,
+		RemoteURL: input.Body.RemoteURL,
+		WIPLimits: input.Body.WIPLimits,
+	})
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &updateProjectOutput{Body: project}, nil
+}
+
+type projectStatusesInput struct {
+	Project string `path:"project"`
+}
+
+type projectStatusesOutput struct {
+	Body struct {
+		Statuses []string `json:"statuses" doc:"Ordered workflow statuses; the first is where work starts and the last counts as done"`
+	}
+}
+
+func (s *Server) getProjectStatuses(_ context.Context, input *projectStatusesInput) (*projectStatusesOutput, error) {
+	statuses, err := s.service.ProjectStatuses(input.Project)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	out := &projectStatusesOutput{}
+	out.Body.Statuses = statuses
+	return out, nil
+}
+
+type setProjectStatusesRequest struct {
+	Statuses  []string          `json:"statuses" doc:"New ordered workflow statuses"`
+	StatusMap map[string]string `json:"status_map,omitempty" doc:"Where cards in a dropped status go, keyed by the dropped status"`
+}
+
+type setProjectStatusesInput struct {
+	Project string `path:"project"`
+	Body    setProjectStatusesRequest
+}
+
+type setProjectStatusesOutput struct {
+	Body model.Project
+}
+
+func (s *Server) setProjectStatuses(_ context.Context, input *setProjectStatusesInput) (*setProjectStatusesOutput, error) {
+	project, err := s.service.SetProjectStatuses(input.Project, input.Body.Statuses, input.Body.StatusMap)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &setProjectStatusesOutput{Body: project}, nil
+}
+
+type setTransitionRulesRequest struct {
+	Rules []model.TransitionRule `json:"rules" doc:"Rules replacing the project's current ones; empty removes them all"`
+}
+
+type setTransitionRulesInput struct {
+	Project string `path:"project"`
+	Body    setTransitionRulesRequest
+}
+
+type setTransitionRulesOutput struct {
+	Body model.Project
+}
+
+func (s *Server) setTransitionRules(_ context.Context, input *setTransitionRulesInput) (*setTransitionRulesOutput, error) {
+	project, err := s.service.SetTransitionRules(input.Project, input.Body.Rules)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &setTransitionRulesOutput{Body: project}, nil
+}
+
 type deleteProjectInput struct {
 	Project string `path:"project"`
 }
@@ -67,3 +159,53 @@ func (s *Server) deleteProject(_ context.Context, input *deleteProjectInput) (*d
 	out.Body.Deleted = true
 	return out, nil
 }
+
+type listTrashedProjectsOutput struct {
+	Body struct {
+		Projects []model.TrashedProject `json:"projects"`
+	}
+}
+
+func (s *Server) listTrashedProjects(_ context.Context, _ *struct{}) (*listTrashedProjectsOutput, error) {
+	trashed, err := s.service.ListTrashedProjects()
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	out := &listTrashedProjectsOutput{}
+	out.Body.Projects = trashed
+	return out, nil
+}
+
+type trashedProjectInput struct {
+	ID string `path:"id"`
+}
+
+type restoreTrashedProjectOutput struct {
+	Body model.Project
+}
+
+func (s *Server) restoreTrashedProject(_ context.Context, input *trashedProjectInput) (*restoreTrashedProjectOutput, error) {
+	project, err := s.service.RestoreTrashedProject(input.ID)
+	if err != nil {
+		return nil, toHumaError(err)
+	}
+	return &restoreTrashedProjectOutput{Body: project}, nil
+}
+
+type purgeTrashedProjectOutput struct {
+	Body struct {
+		ID     string `json:"id"`
+		Purged bool   `json:"purged"`
+	}
+}
+
+func (s *Server) purgeTrashedProject(_ context.Context, input *trashedProjectInput) (*purgeTrashedProjectOutput, error) {
+	if err := s.service.PurgeTrashedProject(input.ID); err != nil {
+		return nil, toHumaError(err)
+	}
+
+	out := &purgeTrashedProjectOutput{}
+	out.Body.ID = input.ID
+	out.Body.Purged = true
+	return out, nil
+}
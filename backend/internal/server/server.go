@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humachi"
@@ -19,12 +22,23 @@ type Options struct {
 	DataDir    string
 	SQLitePath string
 	Logger     *slog.Logger
+
+	// TrashRetention is how long deleted projects stay in the trash before
+	// they are purged. Zero keeps them until purged by hand.
+	TrashRetention time.Duration
 }
 
+// trashPurgeInterval is how often expired trash is looked for while running.
+var trashPurgeInterval = time.Hour
+
 type Server struct {
 	service    *service.Service
+	markdown   *store.MarkdownStore
 	projection *store.SQLiteProjection
+	watcher    *store.Watcher
 	hub        *hub
+	stopPurge  chan struct{}
+	purgeDone  chan struct{}
 	logger     *slog.Logger
 	router     *chi.Mux
 	api        huma.API
@@ -36,10 +50,34 @@ func New(opts Options) (*Server, error) {
 		logger = slog.Default()
 	}
 
+	// The store holds the data directory lock from here on, so a second
+	// server on the same directory fails now, naming the process that has it.
 	markdownStore, err := store.NewMarkdownStore(opts.DataDir)
 	if err != nil {
 		return nil, err
 	}
+	started := false
+	defer func() {
+		if !started {
+			_ = markdownStore.Close()
+		}
+	}()
+	// A file that cannot be parsed would fail the rebuild below; set it aside
+	// so the rest of the board still loads. kanban doctor reports the rest.
+	quarantined, err := markdownStore.QuarantineUnreadable()
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range quarantined {
+		logger.Warn("quarantined unreadable markdown", "path", issue.Path, "error", issue.Detail, "repair", issue.Repair)
+	}
+	migrated, err := markdownStore.Migrate(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range migrated {
+		logger.Info("upgraded markdown format", "path", file.Path, "from_version", file.FromVersion, "to_version", file.ToVersion)
+	}
 	if err := os.MkdirAll(filepath.Dir(opts.SQLitePath), 0o755); err != nil {
 		return nil, err
 	}
@@ -56,6 +94,7 @@ func New(opts Options) (*Server, error) {
 	router := chi.NewRouter()
 	s := &Server{
 		service:    service.New(markdownStore, projection, hub, logger),
+		markdown:   markdownStore,
 		projection: projection,
 		hub:        hub,
 		logger:     logger,
@@ -69,8 +108,17 @@ func New(opts Options) (*Server, error) {
 		s.logger.Info("projection rebuilt on startup", "projects_rebuilt", result.ProjectsRebuilt, "cards_rebuilt", result.CardsRebuilt)
 	}
 
+	watcher, err := store.NewWatcher(markdownStore, logger, s.ingestFileChange)
+	if err != nil {
+		_ = projection.Close()
+		return nil, err
+	}
+	s.watcher = watcher
+	s.startTrashPurge(opts.TrashRetention)
+
 	s.routes()
 	s.logger.Info("server initialized", "data_dir", opts.DataDir, "sqlite_path", opts.SQLitePath)
+	started = true
 	return s, nil
 }
 
@@ -83,10 +131,49 @@ func (s *Server) OpenAPI() *huma.OpenAPI {
 }
 
 func (s *Server) Close() error {
+	if s.stopPurge != nil {
+		close(s.stopPurge)
+		<-s.purgeDone
+	}
+	if err := s.watcher.Close(); err != nil {
+		s.logger.Warn("close markdown watcher failed", "error", err)
+	}
 	s.hub.Close()
+	if err := s.markdown.Close(); err != nil {
+		s.logger.Warn("release data directory lock failed", "error", err)
+	}
 	return s.projection.Close()
 }
 
+// startTrashPurge purges expired trash now and then periodically until Close.
+func (s *Server) startTrashPurge(retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+	s.purgeExpiredTrash(retention)
+	s.stopPurge = make(chan struct{})
+	s.purgeDone = make(chan struct{})
+	go func() {
+		defer close(s.purgeDone)
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopPurge:
+				return
+			case <-ticker.C:
+				s.purgeExpiredTrash(retention)
+			}
+		}
+	}()
+}
+
+func (s *Server) purgeExpiredTrash(retention time.Duration) {
+	if _, err := s.service.PurgeExpiredTrash(retention); err != nil {
+		s.logger.Warn("purge expired trash failed", "error", err)
+	}
+}
+
 func (s *Server) routes() {
 	s.router.Use(s.requestLoggingMiddleware)
 
@@ -131,6 +218,14 @@ func (s *Server) registerOperations() {
 		Errors:      []int{http.StatusInternalServerError},
 	}, s.listProjects)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID: "updateProject",
+		Method:      http.MethodPatch,
+		Path:        "/projects/{project}",
+		Summary:     "Update project",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.updateProject)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID: "deleteProject",
 		Method:      http.MethodDelete,
@@ -139,13 +234,61 @@ func (s *Server) registerOperations() {
 		Errors:      []int{http.StatusNotFound, http.StatusInternalServerError},
 	}, s.deleteProject)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID: "getProjectStatuses",
+		Method:      http.MethodGet,
+		Path:        "/projects/{project}/statuses",
+		Summary:     "Get project workflow statuses",
+		Errors:      []int{http.StatusNotFound, http.StatusInternalServerError},
+	}, s.getProjectStatuses)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "setProjectStatuses",
+		Method:      http.MethodPut,
+		Path:        "/projects/{project}/statuses",
+		Summary:     "Replace project workflow statuses",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.setProjectStatuses)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "setProjectTransitionRules",
+		Method:      http.MethodPut,
+		Path:        "/projects/{project}/transition-rules",
+		Summary:     "Replace project transition rules",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.setTransitionRules)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "listTrashedProjects",
+		Method:      http.MethodGet,
+		Path:        "/trash/projects",
+		Summary:     "List trashed projects",
+		Errors:      []int{http.StatusInternalServerError},
+	}, s.listTrashedProjects)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "restoreTrashedProject",
+		Method:      http.MethodPost,
+		Path:        "/trash/projects/{id}/restore",
+		Summary:     "Restore trashed project",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+	}, s.restoreTrashedProject)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "purgeTrashedProject",
+		Method:      http.MethodDelete,
+		Path:        "/trash/projects/{id}",
+		Summary:     "Permanently delete trashed project",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.purgeTrashedProject)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID:   "createCard",
 		Method:        http.MethodPost,
 		Path:          "/projects/{project}/cards",
 		DefaultStatus: http.StatusCreated,
 		Summary:       "Create card",
-		Errors:        []int{http.StatusBadRequest, http.StatusInternalServerError},
+		Errors:        []int{http.StatusBadRequest, http.StatusConflict, http.StatusInternalServerError},
 	}, s.createCard)
 
 	huma.Register(s.api, huma.Operation{
@@ -162,14 +305,25 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}",
 		Summary:     "Get card",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardMovedResponses(),
 	}, s.getCard)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID: "getCardTree",
+		Method:      http.MethodGet,
+		Path:        "/projects/{project}/cards/{number}/tree",
+		Summary:     "Get card with its child cards",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.getCardTree)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID: "moveCard",
 		Method:      http.MethodPatch,
 		Path:        "/projects/{project}/cards/{number}/move",
 		Summary:     "Move card",
-		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Description: "Moves a card to a status or reorders it within one. Without force the move is refused when the card fails its project's transition rules, when the target status is at its WIP limit, or when open blocked_by cards remain and the card is leaving the project's first status for any status but the done one.",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.moveCard)
 
 	huma.Register(s.api, huma.Operation{
@@ -178,6 +332,7 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/comments",
 		Summary:     "Append card comment",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.commentCard)
 
 	huma.Register(s.api, huma.Operation{
@@ -186,6 +341,7 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/description",
 		Summary:     "Append card description entry",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.appendDescription)
 
 	huma.Register(s.api, huma.Operation{
@@ -195,6 +351,7 @@ func (s *Server) registerOperations() {
 		DefaultStatus: http.StatusCreated,
 		Summary:       "Add card todo",
 		Errors:        []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:     s.cardPreconditionResponses(),
 	}, s.addTodo)
 
 	huma.Register(s.api, huma.Operation{
@@ -211,6 +368,7 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/todos/{todo_id}",
 		Summary:     "Update card todo",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.updateTodo)
 
 	huma.Register(s.api, huma.Operation{
@@ -219,6 +377,7 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/todos/{todo_id}",
 		Summary:     "Delete card todo",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.deleteTodo)
 
 	huma.Register(s.api, huma.Operation{
@@ -228,6 +387,7 @@ func (s *Server) registerOperations() {
 		DefaultStatus: http.StatusCreated,
 		Summary:       "Add acceptance criterion",
 		Errors:        []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:     s.cardPreconditionResponses(),
 	}, s.addAcceptanceCriterion)
 
 	huma.Register(s.api, huma.Operation{
@@ -244,6 +404,7 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/acceptance/{criterion_id}",
 		Summary:     "Update acceptance criterion",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.updateAcceptanceCriterion)
 
 	huma.Register(s.api, huma.Operation{
@@ -252,24 +413,146 @@ func (s *Server) registerOperations() {
 		Path:        "/projects/{project}/cards/{number}/acceptance/{criterion_id}",
 		Summary:     "Delete acceptance criterion",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.deleteAcceptanceCriterion)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID:   "uploadAttachment",
+		Method:        http.MethodPost,
+		Path:          "/projects/{project}/cards/{number}/attachments",
+		DefaultStatus: http.StatusCreated,
+		Summary:       "Upload card attachment",
+		MaxBodyBytes:  maxAttachmentBytes,
+		Errors:        []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusInternalServerError},
+		Responses:     s.cardPreconditionResponses(),
+	}, s.uploadAttachment)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "listAttachments",
+		Method:      http.MethodGet,
+		Path:        "/projects/{project}/cards/{number}/attachments",
+		Summary:     "List card attachments",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+	}, s.listAttachments)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "downloadAttachment",
+		Method:      http.MethodGet,
+		Path:        "/projects/{project}/cards/{number}/attachments/{filename}",
+		Summary:     "Download card attachment",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   attachmentDownloadResponses(),
+	}, s.downloadAttachment)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "deleteAttachment",
+		Method:      http.MethodDelete,
+		Path:        "/projects/{project}/cards/{number}/attachments/{filename}",
+		Summary:     "Delete card attachment",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.deleteAttachment)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID: "setCardBranch",
 		Method:      http.MethodPatch,
 		Path:        "/projects/{project}/cards/{number}/branch",
 		Summary:     "Set card branch metadata",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.setCardBranch)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID: "setCardPriority",
+		Method:      http.MethodPatch,
+		Path:        "/projects/{project}/cards/{number}/priority",
+		Summary:     "Set or clear card priority",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.setCardPriority)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "setCardDue",
+		Method:      http.MethodPatch,
+		Path:        "/projects/{project}/cards/{number}/due",
+		Summary:     "Set or clear card due date",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.setCardDue)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "updateCard",
+		Method:      http.MethodPatch,
+		Path:        "/projects/{project}/cards/{number}",
+		Summary:     "Update card fields",
+		Description: "Changing status is checked like a move: without force it is refused against transition rules, WIP limits, and open blocked_by cards when the card leaves the project's first status for any status but the done one.",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.updateCard)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "addCardLabel",
+		Method:      http.MethodPost,
+		Path:        "/projects/{project}/cards/{number}/labels",
+		Summary:     "Add card label",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.addCardLabel)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "removeCardLabel",
+		Method:      http.MethodDelete,
+		Path:        "/projects/{project}/cards/{number}/labels/{label}",
+		Summary:     "Remove card label",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.removeCardLabel)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "addCardRelation",
+		Method:      http.MethodPost,
+		Path:        "/projects/{project}/cards/{number}/relations",
+		Summary:     "Relate card to another card",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.addCardRelation)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "removeCardRelation",
+		Method:      http.MethodDelete,
+		Path:        "/projects/{project}/cards/{number}/relations/{type}/{target_project}/{target_number}",
+		Summary:     "Remove card relation",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.removeCardRelation)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID: "deleteCard",
 		Method:      http.MethodDelete,
 		Path:        "/projects/{project}/cards/{number}",
 		Summary:     "Delete card",
 		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
 	}, s.deleteCard)
 
+	huma.Register(s.api, huma.Operation{
+		OperationID: "restoreCard",
+		Method:      http.MethodPost,
+		Path:        "/projects/{project}/cards/{number}/restore",
+		Summary:     "Restore soft-deleted card",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.restoreCard)
+
+	huma.Register(s.api, huma.Operation{
+		OperationID: "transferCard",
+		Method:      http.MethodPost,
+		Path:        "/projects/{project}/cards/{number}/transfer",
+		Summary:     "Move card to another project",
+		Errors:      []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError},
+		Responses:   s.cardPreconditionResponses(),
+	}, s.transferCard)
+
 	huma.Register(s.api, huma.Operation{
 		OperationID: "rebuildProjection",
 		Method:      http.MethodPost,
@@ -279,6 +562,53 @@ func (s *Server) registerOperations() {
 	}, s.rebuildProjection)
 }
 
+// cardPreconditionResponses documents the 412 returned by card writes with a
+// stale If-Match header. The body is the current card, not an error model.
+func (s *Server) cardPreconditionResponses() map[string]*huma.Response {
+	schema := s.api.OpenAPI().Components.Schemas.Schema(reflect.TypeOf(model.Card{}), true, "")
+	return map[string]*huma.Response{
+		strconv.Itoa(http.StatusPreconditionFailed): {
+			Description: "Card changed since the If-Match revision",
+			Content: map[string]*huma.MediaType{
+				"application/json": {Schema: schema},
+			},
+		},
+	}
+}
+
+// attachmentDownloadResponses documents the raw attachment body, which is
+// served with the content type recorded at upload.
+func attachmentDownloadResponses() map[string]*huma.Response {
+	return map[string]*huma.Response{
+		strconv.Itoa(http.StatusOK): {
+			Description: "Attachment content",
+			Content: map[string]*huma.MediaType{
+				"application/octet-stream": {Schema: &huma.Schema{Type: huma.TypeString, Format: "binary"}},
+			},
+		},
+	}
+}
+
+// cardMovedResponses documents the redirect returned when reading a card that
+// was transferred to another project. The body is the tombstone card.
+func (s *Server) cardMovedResponses() map[string]*huma.Response {
+	schema := s.api.OpenAPI().Components.Schemas.Schema(reflect.TypeOf(model.Card{}), true, "")
+	return map[string]*huma.Response{
+		strconv.Itoa(http.StatusPermanentRedirect): {
+			Description: "Card was moved to another project",
+			Headers: map[string]*huma.Param{
+				"Location": {
+					Description: "Path of the card in its new project",
+					Schema:      &huma.Schema{Type: huma.TypeString},
+				},
+			},
+			Content: map[string]*huma.MediaType{
+				"application/json": {Schema: schema},
+			},
+		},
+	}
+}
+
 func (s *Server) registerWebSocketOperationDocs() {
 	oapi := s.api.OpenAPI()
 	if oapi.Paths == nil {
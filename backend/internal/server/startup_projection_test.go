@@ -2,8 +2,10 @@ package server_test
 
 import (
 	"database/sql"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -24,11 +26,12 @@ func TestServerStartupRecreatesProjectionFromMarkdown(t *testing.T) {
 
 	_, err = markdownStore.CreateProject("Alpha", "", "")
 	require.NoError(t, err)
-	_, err = markdownStore.CreateCard("alpha", "Recovered card", "from markdown", "", "Todo")
+	_, err = markdownStore.CreateCard("alpha", "Recovered card", "from markdown", "", "Todo", "", false)
 	require.NoError(t, err)
 
 	createLegacyProjectionDB(t, sqlitePath)
 
+	require.NoError(t, markdownStore.Close())
 	app, err := server.New(server.Options{DataDir: dataDir, SQLitePath: sqlitePath})
 	require.NoError(t, err)
 	t.Cleanup(func() { _ = app.Close() })
@@ -80,6 +83,53 @@ func TestServerStartupRecreatesProjectionFromMarkdown(t *testing.T) {
 	require.False(t, foundColumnOld)
 }
 
+func TestServerStartupQuarantinesUnreadableMarkdown(t *testing.T) {
+	dataDir := t.TempDir()
+	sqlitePath := filepath.Join(dataDir, "projection.db")
+
+	markdownStore, err := store.NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = markdownStore.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = markdownStore.CreateProject("Broken", "", "")
+	require.NoError(t, err)
+	_, err = markdownStore.CreateCard("alpha", "Healthy", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, err = markdownStore.CreateCard("alpha", "Garbled", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "projects", "alpha", "card-2.md"), []byte("---\ntitle: [unclosed\n---\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "projects", "broken", "project.md"), []byte("not-frontmatter"), 0o644))
+
+	require.NoError(t, markdownStore.Close())
+	app, err := server.New(server.Options{DataDir: dataDir, SQLitePath: sqlitePath})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = app.Close() })
+
+	httpServer := httptest.NewServer(app.Handler())
+	t.Cleanup(httpServer.Close)
+
+	projects := doJSON(t, httpServer.URL+"/projects", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, projects.StatusCode)
+	rawProjects, ok := decodeMap(t, projects.Body)["projects"].([]any)
+	require.True(t, ok)
+	require.Len(t, rawProjects, 1)
+
+	cards := doJSON(t, httpServer.URL+"/projects/alpha/cards", http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, cards.StatusCode)
+	rawCards, ok := decodeMap(t, cards.Body)["cards"].([]any)
+	require.True(t, ok)
+	require.Len(t, rawCards, 1)
+
+	quarantined, err := filepath.Glob(filepath.Join(dataDir, ".quarantine", "*", "projects", "alpha", "card-2.md"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	quarantined, err = filepath.Glob(filepath.Join(dataDir, ".quarantine", "*", "projects", "broken", "project.md"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	require.NoDirExists(t, filepath.Join(dataDir, "projects", "broken"))
+}
+
 func createLegacyProjectionDB(t *testing.T, sqlitePath string) {
 	t.Helper()
 
@@ -118,3 +168,21 @@ VALUES ('legacy/card-1', 'legacy', 1, 'legacy card', 'Todo', 'Todo', 0, ?, ?, 0,
 `, now, now, now, now)
 	require.NoError(t, err)
 }
+
+func TestServerStartupFailsWhileDataDirIsLocked(t *testing.T) {
+	dataDir := t.TempDir()
+	markdownStore, err := store.NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+
+	_, err = server.New(server.Options{DataDir: dataDir, SQLitePath: filepath.Join(dataDir, "projection.db")})
+	require.ErrorIs(t, err, store.ErrDataDirLocked)
+	require.ErrorContains(t, err, fmt.Sprintf("locked by process %d", os.Getpid()))
+
+	require.NoError(t, markdownStore.Close())
+	app, err := server.New(server.Options{DataDir: dataDir, SQLitePath: filepath.Join(dataDir, "projection.db")})
+	require.NoError(t, err)
+	require.NoError(t, app.Close())
+	markdownStore, err = store.NewMarkdownStore(dataDir)
+	require.NoError(t, err, "closing the server releases the lock")
+	require.NoError(t, markdownStore.Close())
+}
@@ -35,6 +35,11 @@ func newHTTPTestServer(t *testing.T, handler http.Handler) *httptest.Server {
 
 func doJSON(t *testing.T, url, method string, payload any) *http.Response {
 	t.Helper()
+	return doJSONWithHeaders(t, url, method, nil, payload)
+}
+
+func doJSONWithHeaders(t *testing.T, url, method string, headers map[string]string, payload any) *http.Response {
+	t.Helper()
 
 	var body io.Reader
 	if payload != nil {
@@ -47,6 +52,9 @@ func doJSON(t *testing.T, url, method string, payload any) *http.Response {
 	if payload != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 	resp, err := http.DefaultClient.Do(req)
 	require.NoError(t, err)
 	t.Cleanup(func() { _ = resp.Body.Close() })
@@ -40,9 +40,18 @@ func TestWebsocketReceivesProjectAndCardEvents(t *testing.T) {
 	moveResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1/move", http.MethodPatch, map[string]string{"status": "Doing"})
 	require.Equal(t, http.StatusOK, moveResp.StatusCode)
 
+	addLabelResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1/labels", http.MethodPost, map[string]string{"label": "infra"})
+	require.Equal(t, http.StatusOK, addLabelResp.StatusCode)
+
+	removeLabelResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1/labels/infra", http.MethodDelete, nil)
+	require.Equal(t, http.StatusOK, removeLabelResp.StatusCode)
+
 	softDeleteResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1", http.MethodDelete, nil)
 	require.Equal(t, http.StatusOK, softDeleteResp.StatusCode)
 
+	restoreResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1/restore", http.MethodPost, nil)
+	require.Equal(t, http.StatusOK, restoreResp.StatusCode)
+
 	hardDeleteResp := doJSON(t, httpServer.URL+"/projects/realtime/cards/1?hard=true", http.MethodDelete, nil)
 	require.Equal(t, http.StatusOK, hardDeleteResp.StatusCode)
 
@@ -52,7 +61,10 @@ func TestWebsocketReceivesProjectAndCardEvents(t *testing.T) {
 		"card.commented",
 		"card.updated",
 		"card.moved",
+		"card.label.added",
+		"card.label.removed",
 		"card.deleted_soft",
+		"card.restored",
 		"card.deleted_hard",
 	}
 	actual := make([]string, 0, len(expected))
@@ -1,6 +1,11 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
 
 type Code string
 
@@ -9,12 +14,21 @@ const (
 	CodeNotFound   Code = "not_found"
 	CodeConflict   Code = "conflict"
 	CodeInternal   Code = "internal"
+
+	// CodePreconditionFailed means an If-Match revision no longer matches the
+	// card. The error carries the card's current state.
+	CodePreconditionFailed Code = "precondition_failed"
+
+	// CodeMoved means the card was transferred to another project. The error
+	// carries the tombstone left behind, whose MovedTo names the new card.
+	CodeMoved Code = "moved"
 )
 
 type Error struct {
 	Code    Code
 	Message string
 	Err     error
+	Current *model.Card
 }
 
 func (e *Error) Error() string {
@@ -45,6 +59,53 @@ func newError(code Code, msg string, err error) *Error {
 	}
 }
 
+func newPreconditionError(current model.Card, expectedRevision int) *Error {
+	return &Error{
+		Code:    CodePreconditionFailed,
+		Message: fmt.Sprintf("card %s changed: expected revision %d, current revision %d", current.ID, expectedRevision, current.Revision),
+		Current: &current,
+	}
+}
+
+// preconditionErrorOf turns a store's stale revision error into a
+// CodePreconditionFailed error, and returns nil for any other error.
+func preconditionErrorOf(err error) *Error {
+	var stale *model.StaleRevisionError
+	if !errors.As(err, &stale) {
+		return nil
+	}
+	return newPreconditionError(normalizeCardDefaults(stale.Current), stale.Expected)
+}
+
+// refusalErrorOf turns a store's refusal of an unforced card move into an
+// Error: breaking transition rules is a CodeValidation error, while a WIP
+// limit or open blockers are a CodeConflict. It returns nil for any other
+// error.
+func refusalErrorOf(err error) *Error {
+	var (
+		rules   *model.TransitionError
+		wip     *model.WIPLimitError
+		blocked *model.BlockedError
+	)
+	switch {
+	case errors.As(err, &rules):
+		return newError(CodeValidation, rules.Error(), err)
+	case errors.As(err, &wip):
+		return newError(CodeConflict, wip.Error(), err)
+	case errors.As(err, &blocked):
+		return newError(CodeConflict, blocked.Error(), err)
+	}
+	return nil
+}
+
+func newMovedError(tombstone model.Card) *Error {
+	return &Error{
+		Code:    CodeMoved,
+		Message: fmt.Sprintf("card %s moved to %s", tombstone.ID, tombstone.MovedTo),
+		Current: &tombstone,
+	}
+}
+
 func CodeOf(err error) Code {
 	var appErr *Error
 	if errors.As(err, &appErr) {
@@ -63,3 +124,13 @@ func MessageOf(err error) string {
 	}
 	return err.Error()
 }
+
+// CurrentCardOf returns the card attached to a CodePreconditionFailed or
+// CodeMoved error.
+func CurrentCardOf(err error) (model.Card, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) && appErr.Current != nil {
+		return *appErr.Current, true
+	}
+	return model.Card{}, false
+}
@@ -15,31 +15,53 @@ type MarkdownStore interface {
 	CreateProject(name, localPath, remoteURL string) (model.Project, error)
 	ListProjects() ([]model.Project, error)
 	GetProject(slug string) (model.Project, error)
+	UpdateProject(slug string, patch model.ProjectPatch) (model.Project, error)
+	SetProjectStatuses(slug string, statuses []string, statusMap map[string]string) (model.Project, []model.Card, error)
+	SetTransitionRules(slug string, rules []model.TransitionRule) (model.Project, error)
 	DeleteProject(slug string) error
-	CreateCard(projectSlug, title, description, branch, status string) (model.Card, error)
+	ListTrashedProjects() ([]model.TrashedProject, error)
+	RestoreTrashedProject(id string) (model.Project, []model.Card, error)
+	PurgeTrashedProject(id string) error
+	PurgeTrashBefore(cutoff time.Time) ([]model.TrashedProject, error)
+	CreateCard(projectSlug, title, description, branch, status, parentID string, force bool) (model.Card, error)
 	GetCard(projectSlug string, number int) (model.Card, error)
-	MoveCard(projectSlug string, number int, status string) (model.Card, error)
-	SetCardBranch(projectSlug string, number int, branch string) (model.Card, error)
-	AddComment(projectSlug string, number int, body string) (model.Card, error)
-	AppendDescription(projectSlug string, number int, body string) (model.Card, error)
-	AddTodo(projectSlug string, number int, text string) (model.Todo, error)
+	MoveCard(projectSlug string, number int, status string, position model.CardPosition, force bool, expectedRevision int) (model.Card, error)
+	SetCardBranch(projectSlug string, number int, branch string, expectedRevision int) (model.Card, error)
+	UpdateCard(projectSlug string, number int, patch model.CardPatch, force bool, expectedRevision int) (model.Card, error)
+	AddComment(projectSlug string, number int, body string, expectedRevision int) (model.Card, error)
+	AppendDescription(projectSlug string, number int, body string, expectedRevision int) (model.Card, error)
+	AddTodo(projectSlug string, number int, text string, expectedRevision int) (model.Todo, model.Card, error)
 	ListTodos(projectSlug string, number int) ([]model.Todo, error)
-	SetTodoCompleted(projectSlug string, number int, todoID int, completed bool) (model.Todo, error)
-	DeleteTodo(projectSlug string, number int, todoID int) (model.Todo, error)
-	AddAcceptanceCriterion(projectSlug string, number int, text string) (model.AcceptanceCriterion, error)
+	UpdateTodo(projectSlug string, number int, todoID int, patch model.ChecklistItemPatch, expectedRevision int) (model.Todo, model.Card, error)
+	DeleteTodo(projectSlug string, number int, todoID int, expectedRevision int) (model.Todo, model.Card, error)
+	AddAcceptanceCriterion(projectSlug string, number int, text string, expectedRevision int) (model.AcceptanceCriterion, model.Card, error)
 	ListAcceptanceCriteria(projectSlug string, number int) ([]model.AcceptanceCriterion, error)
-	SetAcceptanceCriterionCompleted(projectSlug string, number int, criterionID int, completed bool) (model.AcceptanceCriterion, error)
-	DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int) (model.AcceptanceCriterion, error)
-	DeleteCard(projectSlug string, number int, hard bool) (model.Card, error)
+	UpdateAcceptanceCriterion(projectSlug string, number int, criterionID int, patch model.ChecklistItemPatch, expectedRevision int) (model.AcceptanceCriterion, model.Card, error)
+	DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int, expectedRevision int) (model.AcceptanceCriterion, model.Card, error)
+	DeleteCard(projectSlug string, number int, hard bool, expectedRevision int) (model.Card, error)
+	RestoreCard(projectSlug string, number int, expectedRevision int) (model.Card, error)
+	MoveCardToProject(projectSlug string, number int, targetSlug string, expectedRevision int) (model.Card, model.Card, error)
+	AddLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error)
+	RemoveLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error)
+	SetCardPriority(projectSlug string, number int, priority string, expectedRevision int) (model.Card, error)
+	SetCardDue(projectSlug string, number int, dueAt *time.Time, expectedRevision int) (model.Card, error)
+	AddRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (model.Card, model.Card, error)
+	RemoveRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (model.Card, model.Card, error)
+	AddAttachment(projectSlug string, number int, filename, contentType string, data []byte, expectedRevision int) (model.Attachment, model.Card, error)
+	ReadAttachment(projectSlug string, number int, filename string) (model.Attachment, []byte, error)
+	DeleteAttachment(projectSlug string, number int, filename string, expectedRevision int) (model.Attachment, model.Card, error)
 	Snapshot() ([]model.Project, []model.Card, error)
 }
 
 type Projection interface {
+	HasProject(slug string) (bool, error)
 	UpsertProject(project model.Project) error
 	UpsertCard(card model.Card) error
 	HardDeleteCard(projectSlug string, number int) error
 	DeleteProject(projectSlug string) error
-	ListCards(projectSlug string, includeDeleted bool) ([]model.CardSummary, error)
+	ListCards(projectSlug string, opts model.CardListOptions) ([]model.CardSummary, error)
+	GetCardSummary(cardID string) (model.CardSummary, error)
+	ListChildCards(parentID string) ([]model.CardSummary, error)
 	RebuildFromMarkdown(projects []model.Project, cards []model.Card) error
 }
 
@@ -99,6 +121,107 @@ func (s *Service) ListProjects() ([]model.Project, error) {
 	return projects, nil
 }
 
+func (s *Service) UpdateProject(slug string, patch model.ProjectPatch) (model.Project, error) {
+	project, err := s.store.UpdateProject(slug, patch)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Project{}, newError(CodeNotFound, "project not found", err)
+		}
+		return model.Project{}, newError(CodeValidation, err.Error(), err)
+	}
+	if err := s.projection.UpsertProject(project); err != nil {
+		return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("project updated", "project", project.Slug)
+	s.publish(model.Event{
+		Type:      model.EventTypeProjectUpdated,
+		Project:   project.Slug,
+		Timestamp: time.Now().UTC(),
+	})
+	return project, nil
+}
+
+// ProjectStatuses returns the ordered workflow statuses of a project.
+func (s *Service) ProjectStatuses(slug string) ([]string, error) {
+	project, err := s.store.GetProject(slug)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, newError(CodeNotFound, "project not found", err)
+		}
+		return nil, newError(CodeInternal, "load project failed", err)
+	}
+	return project.Statuses, nil
+}
+
+// WIPLimits returns a project's WIP limits for card listings. A project that
+// cannot be read has none.
+func (s *Service) WIPLimits(slug string) map[string]int {
+	project, err := s.store.GetProject(slug)
+	if err != nil {
+		return nil
+	}
+	return project.WIPLimits
+}
+
+// SetProjectStatuses replaces a project's workflow, moving cards out of
+// dropped statuses as statusMap says.
+func (s *Service) SetProjectStatuses(slug string, statuses []string, statusMap map[string]string) (model.Project, error) {
+	project, migrated, err := s.store.SetProjectStatuses(slug, statuses, statusMap)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Project{}, newError(CodeNotFound, "project not found", err)
+		}
+		return model.Project{}, newError(CodeValidation, err.Error(), err)
+	}
+	if err := s.projection.UpsertProject(project); err != nil {
+		return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	for _, card := range migrated {
+		if err := s.projection.UpsertCard(normalizeCardDefaults(card)); err != nil {
+			return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+		}
+	}
+	s.logger.Info("project statuses updated", "project", project.Slug, "statuses", strings.Join(project.Statuses, ","), "cards_migrated", len(migrated))
+	now := time.Now().UTC()
+	s.publish(model.Event{
+		Type:      model.EventTypeProjectUpdated,
+		Project:   project.Slug,
+		Timestamp: now,
+	})
+	for _, card := range migrated {
+		s.publish(model.Event{
+			Type:      model.EventTypeCardMoved,
+			Project:   card.ProjectSlug,
+			CardID:    card.ID,
+			CardNum:   card.Number,
+			Timestamp: now,
+		})
+	}
+	return project, nil
+}
+
+// SetTransitionRules replaces the conditions cards of a project must meet to
+// enter a status.
+func (s *Service) SetTransitionRules(slug string, rules []model.TransitionRule) (model.Project, error) {
+	project, err := s.store.SetTransitionRules(slug, rules)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Project{}, newError(CodeNotFound, "project not found", err)
+		}
+		return model.Project{}, newError(CodeValidation, err.Error(), err)
+	}
+	if err := s.projection.UpsertProject(project); err != nil {
+		return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("project transition rules updated", "project", project.Slug, "rules", len(project.TransitionRules))
+	s.publish(model.Event{
+		Type:      model.EventTypeProjectUpdated,
+		Project:   project.Slug,
+		Timestamp: time.Now().UTC(),
+	})
+	return project, nil
+}
+
 func (s *Service) DeleteProject(slug string) error {
 	if err := s.store.DeleteProject(slug); err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -118,12 +241,83 @@ func (s *Service) DeleteProject(slug string) error {
 	return nil
 }
 
-func (s *Service) CreateCard(projectSlug, title, description, branch, status string) (model.Card, error) {
-	card, err := s.store.CreateCard(projectSlug, title, description, branch, status)
+func (s *Service) ListTrashedProjects() ([]model.TrashedProject, error) {
+	trashed, err := s.store.ListTrashedProjects()
+	if err != nil {
+		return nil, newError(CodeInternal, "list trash failed", err)
+	}
+	return trashed, nil
+}
+
+func (s *Service) RestoreTrashedProject(id string) (model.Project, error) {
+	project, cards, err := s.store.RestoreTrashedProject(id)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return model.Project{}, newError(CodeConflict, fmt.Sprintf("cannot restore %s: %s", id, err.Error()), err)
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Project{}, newError(CodeNotFound, "trashed project not found", err)
+		}
+		return model.Project{}, newError(CodeValidation, err.Error(), err)
+	}
+	if err := s.projection.UpsertProject(project); err != nil {
+		return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	for _, card := range cards {
+		if err := s.projection.UpsertCard(normalizeCardDefaults(card)); err != nil {
+			return model.Project{}, newError(CodeInternal, "projection sync failed", err)
+		}
+	}
+	s.logger.Info("project restored from trash", "project", project.Slug, "trash_id", id, "cards", len(cards))
+	s.publish(model.Event{
+		Type:      model.EventTypeProjectRestored,
+		Project:   project.Slug,
+		Timestamp: time.Now().UTC(),
+	})
+	return project, nil
+}
+
+func (s *Service) PurgeTrashedProject(id string) error {
+	if err := s.store.PurgeTrashedProject(id); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return newError(CodeNotFound, "trashed project not found", err)
+		}
+		return newError(CodeValidation, err.Error(), err)
+	}
+	s.logger.Info("trashed project purged", "trash_id", id)
+	return nil
+}
+
+// PurgeExpiredTrash removes trashed projects deleted more than retention ago.
+// A non-positive retention keeps the trash forever.
+func (s *Service) PurgeExpiredTrash(retention time.Duration) (int, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+	purged, err := s.store.PurgeTrashBefore(time.Now().UTC().Add(-retention))
+	for _, item := range purged {
+		s.logger.Info("expired trashed project purged", "trash_id", item.ID, "deleted_at", item.DeletedAt)
+	}
+	if err != nil {
+		return len(purged), newError(CodeInternal, "purge trash failed", err)
+	}
+	return len(purged), nil
+}
+
+// CreateCard adds a card to a project, optionally as a child of parentID.
+// Creating it in a status at its WIP limit is refused unless force is set.
+func (s *Service) CreateCard(projectSlug, title, description, branch, status, parentID string, force bool) (model.Card, error) {
+	card, err := s.store.CreateCard(projectSlug, title, description, branch, status, parentID, force)
 	if err != nil {
+		if refused := refusalErrorOf(err); refused != nil {
+			return model.Card{}, refused
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeValidation, "project not found", err)
 		}
+		if errors.Is(err, os.ErrExist) {
+			return model.Card{}, newError(CodeConflict, err.Error(), err)
+		}
 		return model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
 	card = normalizeCardDefaults(card)
@@ -148,9 +342,12 @@ func (s *Service) CreateCard(projectSlug, title, description, branch, status str
 	return card, nil
 }
 
-func (s *Service) SetCardBranch(projectSlug string, number int, branch string) (model.Card, error) {
-	card, err := s.store.SetCardBranch(projectSlug, number, branch)
+func (s *Service) SetCardBranch(projectSlug string, number int, branch string, expectedRevision int) (model.Card, error) {
+	card, err := s.store.SetCardBranch(projectSlug, number, branch, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
@@ -171,8 +368,268 @@ func (s *Service) SetCardBranch(projectSlug string, number int, branch string) (
 	return card, nil
 }
 
-func (s *Service) ListCards(projectSlug string, includeDeleted bool) ([]model.CardSummary, error) {
-	cards, err := s.projection.ListCards(projectSlug, includeDeleted)
+// UpdateCard changes the fields patch names. A status change is checked like
+// a move: against transition rules, blockers and WIP limits, unless force is
+// set.
+func (s *Service) UpdateCard(projectSlug string, number int, patch model.CardPatch, force bool, expectedRevision int) (model.Card, error) {
+	card, err := s.store.UpdateCard(projectSlug, number, patch, force, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if refused := refusalErrorOf(err); refused != nil {
+			return model.Card{}, refused
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card updated", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "revision", card.Revision)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardUpdated,
+		Project:   card.ProjectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return card, nil
+}
+
+func (s *Service) AddCardLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error) {
+	return s.changeCardLabel(projectSlug, number, label, expectedRevision, s.store.AddLabel, model.EventTypeCardLabelAdded)
+}
+
+func (s *Service) RemoveCardLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error) {
+	return s.changeCardLabel(projectSlug, number, label, expectedRevision, s.store.RemoveLabel, model.EventTypeCardLabelRemoved)
+}
+
+func (s *Service) changeCardLabel(projectSlug string, number int, label string, expectedRevision int, change func(string, int, string, int) (model.Card, error), eventType model.EventType) (model.Card, error) {
+	card, err := change(projectSlug, number, label, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card labels changed", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "labels", card.Labels)
+	s.publish(model.Event{
+		Type:      eventType,
+		Project:   card.ProjectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return card, nil
+}
+
+func (s *Service) SetCardPriority(projectSlug string, number int, priority string, expectedRevision int) (model.Card, error) {
+	card, err := s.store.SetCardPriority(projectSlug, number, priority, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card priority updated", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "priority", card.Priority)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardPriorityUpdated,
+		Project:   card.ProjectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return card, nil
+}
+
+// SetCardDue sets the card's due date; a nil dueAt clears it.
+func (s *Service) SetCardDue(projectSlug string, number int, dueAt *time.Time, expectedRevision int) (model.Card, error) {
+	card, err := s.store.SetCardDue(projectSlug, number, dueAt, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card due date updated", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "due_at", card.DueAt)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardDueUpdated,
+		Project:   card.ProjectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return card, nil
+}
+
+func (s *Service) AddCardRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (model.Card, error) {
+	return s.changeCardRelation(projectSlug, number, relationType, targetID, expectedRevision, s.store.AddRelation, model.EventTypeCardRelationAdded)
+}
+
+func (s *Service) RemoveCardRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (model.Card, error) {
+	return s.changeCardRelation(projectSlug, number, relationType, targetID, expectedRevision, s.store.RemoveRelation, model.EventTypeCardRelationRemoved)
+}
+
+func (s *Service) changeCardRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int, change func(string, int, string, string, int) (model.Card, model.Card, error), eventType model.EventType) (model.Card, error) {
+	card, related, err := change(projectSlug, number, relationType, targetID, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	changed := []model.Card{card}
+	if related.ID != "" {
+		changed = append(changed, normalizeCardDefaults(related))
+	}
+	for _, c := range changed {
+		if err := s.projection.UpsertCard(c); err != nil {
+			return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+		}
+	}
+	s.logger.Info("card relations changed", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "type", relationType, "target", targetID)
+	now := time.Now().UTC()
+	for _, c := range changed {
+		s.publish(model.Event{
+			Type:      eventType,
+			Project:   c.ProjectSlug,
+			CardID:    c.ID,
+			CardNum:   c.Number,
+			Timestamp: now,
+		})
+	}
+	return card, nil
+}
+
+// syncRelatedCards refreshes the projection of the cards related to card after
+// the store rewrote their side of the relation.
+func (s *Service) syncRelatedCards(card model.Card, eventType model.EventType) error {
+	ids := make([]string, 0, len(card.Relations))
+	for _, relation := range card.Relations {
+		ids = append(ids, relation.CardID)
+	}
+	return s.syncCards(ids, eventType)
+}
+
+// syncChildCards refreshes the projection of children after the store
+// rewrote their parent.
+func (s *Service) syncChildCards(children []model.CardSummary, eventType model.EventType) error {
+	ids := make([]string, 0, len(children))
+	for _, child := range children {
+		ids = append(ids, child.ID)
+	}
+	return s.syncCards(ids, eventType)
+}
+
+func (s *Service) syncCards(ids []string, eventType model.EventType) error {
+	for _, id := range ids {
+		slug, number, err := model.ParseCardID(id)
+		if err != nil {
+			continue
+		}
+		related, err := s.store.GetCard(slug, number)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return newError(CodeInternal, "get card failed", err)
+		}
+		related = normalizeCardDefaults(related)
+		if err := s.projection.UpsertCard(related); err != nil {
+			return newError(CodeInternal, "projection sync failed", err)
+		}
+		s.publish(model.Event{
+			Type:      eventType,
+			Project:   related.ProjectSlug,
+			CardID:    related.ID,
+			CardNum:   related.Number,
+			Timestamp: time.Now().UTC(),
+		})
+	}
+	return nil
+}
+
+// CardTree returns a card with its children, their children and so on, each
+// carrying its own child rollup.
+func (s *Service) CardTree(projectSlug string, number int) (model.CardTreeNode, error) {
+	card, err := s.store.GetCard(projectSlug, number)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.CardTreeNode{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.CardTreeNode{}, newError(CodeInternal, "get card failed", err)
+	}
+	root, err := s.projection.GetCardSummary(card.ID)
+	if err != nil {
+		return model.CardTreeNode{}, newError(CodeInternal, "get card failed", err)
+	}
+	node, err := s.cardTree(root, map[string]bool{})
+	if err != nil {
+		return model.CardTreeNode{}, newError(CodeInternal, "list child cards failed", err)
+	}
+	return node, nil
+}
+
+// cardTree expands a card's children depth first. seen guards against a parent
+// cycle left behind by a hand-edited file.
+func (s *Service) cardTree(card model.CardSummary, seen map[string]bool) (model.CardTreeNode, error) {
+	seen[card.ID] = true
+	node := model.CardTreeNode{CardSummary: card, Children: []model.CardTreeNode{}}
+	children, err := s.projection.ListChildCards(card.ID)
+	if err != nil {
+		return model.CardTreeNode{}, err
+	}
+	for _, child := range children {
+		if seen[child.ID] {
+			continue
+		}
+		childNode, err := s.cardTree(child, seen)
+		if err != nil {
+			return model.CardTreeNode{}, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+	return node, nil
+}
+
+func (s *Service) ListCards(projectSlug string, opts model.CardListOptions) ([]model.CardSummary, error) {
+	opts.Label = strings.ToLower(strings.TrimSpace(opts.Label))
+	opts.Sort = strings.ToLower(strings.TrimSpace(opts.Sort))
+	if opts.Sort != "" {
+		if _, ok := model.AllowedCardSort[opts.Sort]; !ok {
+			return nil, newError(CodeValidation, fmt.Sprintf("invalid sort %q: use rank, number, priority, due or updated", opts.Sort), nil)
+		}
+	}
+	cards, err := s.projection.ListCards(projectSlug, opts)
 	if err != nil {
 		return nil, newError(CodeInternal, "list cards failed", err)
 	}
@@ -187,12 +644,30 @@ func (s *Service) GetCard(projectSlug string, number int) (model.Card, error) {
 		}
 		return model.Card{}, newError(CodeInternal, "get card failed", err)
 	}
+	if card.MovedTo != "" {
+		return model.Card{}, newMovedError(normalizeCardDefaults(card))
+	}
 	return normalizeCardDefaults(card), nil
 }
 
-func (s *Service) MoveCard(projectSlug string, number int, status string) (model.Card, error) {
-	card, err := s.store.MoveCard(projectSlug, number, status)
+// MoveCard changes a card's status and places it within that status; a move
+// within the same status reorders the card. Moving a card that fails its
+// project's transition rules, that is blocked by open cards and leaving the
+// first status for any but the done status, or into a status at its WIP limit
+// is refused unless force is set.
+func (s *Service) MoveCard(projectSlug string, number int, status string, position model.CardPosition, force bool, expectedRevision int) (model.Card, error) {
+	current, currentErr := s.store.GetCard(projectSlug, number)
+	if currentErr == nil && expectedRevision > 0 && current.Revision != expectedRevision {
+		return model.Card{}, newPreconditionError(normalizeCardDefaults(current), expectedRevision)
+	}
+	card, err := s.store.MoveCard(projectSlug, number, status, position, force, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if refused := refusalErrorOf(err); refused != nil {
+			return model.Card{}, refused
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
@@ -202,9 +677,13 @@ func (s *Service) MoveCard(projectSlug string, number int, status string) (model
 	if err := s.projection.UpsertCard(card); err != nil {
 		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
-	s.logger.Info("card moved", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "status", card.Status)
+	eventType := model.EventTypeCardMoved
+	if currentErr == nil && current.Status == card.Status && position != (model.CardPosition{}) {
+		eventType = model.EventTypeCardReordered
+	}
+	s.logger.Info("card moved", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number, "status", card.Status, "rank", card.Rank)
 	s.publish(model.Event{
-		Type:      model.EventTypeCardMoved,
+		Type:      eventType,
 		Project:   card.ProjectSlug,
 		CardID:    card.ID,
 		CardNum:   card.Number,
@@ -213,9 +692,12 @@ func (s *Service) MoveCard(projectSlug string, number int, status string) (model
 	return card, nil
 }
 
-func (s *Service) CommentCard(projectSlug string, number int, body string) (model.Card, error) {
-	card, err := s.store.AddComment(projectSlug, number, body)
+func (s *Service) CommentCard(projectSlug string, number int, body string, expectedRevision int) (model.Card, error) {
+	card, err := s.store.AddComment(projectSlug, number, body, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
@@ -236,9 +718,12 @@ func (s *Service) CommentCard(projectSlug string, number int, body string) (mode
 	return card, nil
 }
 
-func (s *Service) AppendDescription(projectSlug string, number int, body string) (model.Card, error) {
-	card, err := s.store.AppendDescription(projectSlug, number, body)
+func (s *Service) AppendDescription(projectSlug string, number int, body string, expectedRevision int) (model.Card, error) {
+	card, err := s.store.AppendDescription(projectSlug, number, body, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
@@ -259,16 +744,20 @@ func (s *Service) AppendDescription(projectSlug string, number int, body string)
 	return card, nil
 }
 
-func (s *Service) AddTodo(projectSlug string, number int, text string) (model.Todo, error) {
-	todo, err := s.store.AddTodo(projectSlug, number, text)
+func (s *Service) AddTodo(projectSlug string, number int, text string, expectedRevision int) (model.Todo, model.Card, error) {
+	todo, card, err := s.store.AddTodo(projectSlug, number, text, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Todo{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.Todo{}, newError(CodeNotFound, "card not found", err)
+			return model.Todo{}, model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
-		return model.Todo{}, newError(CodeValidation, err.Error(), err)
+		return model.Todo{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.Todo{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Todo{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
 	s.logger.Info("card todo added", "project", projectSlug, "card_number", number, "todo_id", todo.ID)
 	s.publish(model.Event{
@@ -278,7 +767,7 @@ func (s *Service) AddTodo(projectSlug string, number int, text string) (model.To
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return todo, nil
+	return todo, card, nil
 }
 
 func (s *Service) ListTodos(projectSlug string, number int) ([]model.Todo, error) {
@@ -295,18 +784,22 @@ func (s *Service) ListTodos(projectSlug string, number int) ([]model.Todo, error
 	return todos, nil
 }
 
-func (s *Service) SetTodoCompleted(projectSlug string, number int, todoID int, completed bool) (model.Todo, error) {
-	todo, err := s.store.SetTodoCompleted(projectSlug, number, todoID, completed)
+func (s *Service) UpdateTodo(projectSlug string, number int, todoID int, patch model.ChecklistItemPatch, expectedRevision int) (model.Todo, model.Card, error) {
+	todo, card, err := s.store.UpdateTodo(projectSlug, number, todoID, patch, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Todo{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.Todo{}, newError(CodeNotFound, "todo not found", err)
+			return model.Todo{}, model.Card{}, newError(CodeNotFound, "todo not found", err)
 		}
-		return model.Todo{}, newError(CodeValidation, err.Error(), err)
+		return model.Todo{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.Todo{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Todo{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
-	s.logger.Info("card todo updated", "project", projectSlug, "card_number", number, "todo_id", todoID, "completed", completed)
+	s.logger.Info("card todo updated", "project", projectSlug, "card_number", number, "todo_id", todoID, "completed", todo.Completed)
 	s.publish(model.Event{
 		Type:      model.EventTypeCardTodoUpdated,
 		Project:   projectSlug,
@@ -314,19 +807,23 @@ func (s *Service) SetTodoCompleted(projectSlug string, number int, todoID int, c
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return todo, nil
+	return todo, card, nil
 }
 
-func (s *Service) DeleteTodo(projectSlug string, number int, todoID int) (model.Todo, error) {
-	todo, err := s.store.DeleteTodo(projectSlug, number, todoID)
+func (s *Service) DeleteTodo(projectSlug string, number int, todoID int, expectedRevision int) (model.Todo, model.Card, error) {
+	todo, card, err := s.store.DeleteTodo(projectSlug, number, todoID, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Todo{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.Todo{}, newError(CodeNotFound, "todo not found", err)
+			return model.Todo{}, model.Card{}, newError(CodeNotFound, "todo not found", err)
 		}
-		return model.Todo{}, newError(CodeValidation, err.Error(), err)
+		return model.Todo{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.Todo{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Todo{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
 	s.logger.Info("card todo deleted", "project", projectSlug, "card_number", number, "todo_id", todoID)
 	s.publish(model.Event{
@@ -336,19 +833,23 @@ func (s *Service) DeleteTodo(projectSlug string, number int, todoID int) (model.
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return todo, nil
+	return todo, card, nil
 }
 
-func (s *Service) AddAcceptanceCriterion(projectSlug string, number int, text string) (model.AcceptanceCriterion, error) {
-	criterion, err := s.store.AddAcceptanceCriterion(projectSlug, number, text)
+func (s *Service) AddAcceptanceCriterion(projectSlug string, number int, text string, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, card, err := s.store.AddAcceptanceCriterion(projectSlug, number, text, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.AcceptanceCriterion{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.AcceptanceCriterion{}, newError(CodeNotFound, "card not found", err)
+			return model.AcceptanceCriterion{}, model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
-		return model.AcceptanceCriterion{}, newError(CodeValidation, err.Error(), err)
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.AcceptanceCriterion{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
 	s.logger.Info("card acceptance criterion added", "project", projectSlug, "card_number", number, "criterion_id", criterion.ID)
 	s.publish(model.Event{
@@ -358,7 +859,7 @@ func (s *Service) AddAcceptanceCriterion(projectSlug string, number int, text st
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return criterion, nil
+	return criterion, card, nil
 }
 
 func (s *Service) ListAcceptanceCriteria(projectSlug string, number int) ([]model.AcceptanceCriterion, error) {
@@ -375,18 +876,22 @@ func (s *Service) ListAcceptanceCriteria(projectSlug string, number int) ([]mode
 	return criteria, nil
 }
 
-func (s *Service) SetAcceptanceCriterionCompleted(projectSlug string, number int, criterionID int, completed bool) (model.AcceptanceCriterion, error) {
-	criterion, err := s.store.SetAcceptanceCriterionCompleted(projectSlug, number, criterionID, completed)
+func (s *Service) UpdateAcceptanceCriterion(projectSlug string, number int, criterionID int, patch model.ChecklistItemPatch, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, card, err := s.store.UpdateAcceptanceCriterion(projectSlug, number, criterionID, patch, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.AcceptanceCriterion{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.AcceptanceCriterion{}, newError(CodeNotFound, "acceptance criterion not found", err)
+			return model.AcceptanceCriterion{}, model.Card{}, newError(CodeNotFound, "acceptance criterion not found", err)
 		}
-		return model.AcceptanceCriterion{}, newError(CodeValidation, err.Error(), err)
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.AcceptanceCriterion{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
-	s.logger.Info("card acceptance criterion updated", "project", projectSlug, "card_number", number, "criterion_id", criterionID, "completed", completed)
+	s.logger.Info("card acceptance criterion updated", "project", projectSlug, "card_number", number, "criterion_id", criterionID, "completed", criterion.Completed)
 	s.publish(model.Event{
 		Type:      model.EventTypeCardAcceptanceUpdated,
 		Project:   projectSlug,
@@ -394,19 +899,23 @@ func (s *Service) SetAcceptanceCriterionCompleted(projectSlug string, number int
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return criterion, nil
+	return criterion, card, nil
 }
 
-func (s *Service) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int) (model.AcceptanceCriterion, error) {
-	criterion, err := s.store.DeleteAcceptanceCriterion(projectSlug, number, criterionID)
+func (s *Service) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, card, err := s.store.DeleteAcceptanceCriterion(projectSlug, number, criterionID, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.AcceptanceCriterion{}, model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
-			return model.AcceptanceCriterion{}, newError(CodeNotFound, "acceptance criterion not found", err)
+			return model.AcceptanceCriterion{}, model.Card{}, newError(CodeNotFound, "acceptance criterion not found", err)
 		}
-		return model.AcceptanceCriterion{}, newError(CodeValidation, err.Error(), err)
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeValidation, err.Error(), err)
 	}
-	if err := s.syncCardProjection(projectSlug, number); err != nil {
-		return model.AcceptanceCriterion{}, err
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
 	}
 	s.logger.Info("card acceptance criterion deleted", "project", projectSlug, "card_number", number, "criterion_id", criterionID)
 	s.publish(model.Event{
@@ -416,12 +925,97 @@ func (s *Service) DeleteAcceptanceCriterion(projectSlug string, number int, crit
 		CardNum:   number,
 		Timestamp: time.Now().UTC(),
 	})
-	return criterion, nil
+	return criterion, card, nil
+}
+
+func (s *Service) AddAttachment(projectSlug string, number int, filename, contentType string, data []byte, expectedRevision int) (model.Attachment, model.Card, error) {
+	attachment, card, err := s.store.AddAttachment(projectSlug, number, filename, contentType, data, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Attachment{}, model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Attachment{}, model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Attachment{}, model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Attachment{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card attachment added", "project", projectSlug, "card_number", number, "filename", attachment.Filename, "size", attachment.Size)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardAttachmentAdded,
+		Project:   projectSlug,
+		CardID:    fmt.Sprintf("%s/card-%d", projectSlug, number),
+		CardNum:   number,
+		Timestamp: time.Now().UTC(),
+	})
+	return attachment, card, nil
+}
+
+func (s *Service) ListAttachments(projectSlug string, number int) ([]model.Attachment, error) {
+	card, err := s.store.GetCard(projectSlug, number)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, newError(CodeNotFound, "card not found", err)
+		}
+		return nil, newError(CodeInternal, "list attachments failed", err)
+	}
+	return normalizeCardDefaults(card).Attachments, nil
+}
+
+func (s *Service) ReadAttachment(projectSlug string, number int, filename string) (model.Attachment, []byte, error) {
+	attachment, data, err := s.store.ReadAttachment(projectSlug, number, filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Attachment{}, nil, newError(CodeNotFound, "attachment not found", err)
+		}
+		return model.Attachment{}, nil, newError(CodeInternal, "read attachment failed", err)
+	}
+	return attachment, data, nil
+}
+
+func (s *Service) DeleteAttachment(projectSlug string, number int, filename string, expectedRevision int) (model.Attachment, model.Card, error) {
+	attachment, card, err := s.store.DeleteAttachment(projectSlug, number, filename, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Attachment{}, model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Attachment{}, model.Card{}, newError(CodeNotFound, "attachment not found", err)
+		}
+		return model.Attachment{}, model.Card{}, newError(CodeInternal, "delete attachment failed", err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Attachment{}, model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card attachment deleted", "project", projectSlug, "card_number", number, "filename", attachment.Filename)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardAttachmentDeleted,
+		Project:   projectSlug,
+		CardID:    fmt.Sprintf("%s/card-%d", projectSlug, number),
+		CardNum:   number,
+		Timestamp: time.Now().UTC(),
+	})
+	return attachment, card, nil
 }
 
-func (s *Service) DeleteCard(projectSlug string, number int, hard bool) (model.Card, error) {
-	card, err := s.store.DeleteCard(projectSlug, number, hard)
+func (s *Service) DeleteCard(projectSlug string, number int, hard bool, expectedRevision int) (model.Card, error) {
+	var children []model.CardSummary
+	if hard {
+		var err error
+		children, err = s.projection.ListChildCards(fmt.Sprintf("%s/card-%d", projectSlug, number))
+		if err != nil {
+			return model.Card{}, newError(CodeInternal, "list child cards failed", err)
+		}
+	}
+	card, err := s.store.DeleteCard(projectSlug, number, hard, expectedRevision)
 	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
 		if errors.Is(err, os.ErrNotExist) {
 			return model.Card{}, newError(CodeNotFound, "card not found", err)
 		}
@@ -433,6 +1027,12 @@ func (s *Service) DeleteCard(projectSlug string, number int, hard bool) (model.C
 		if err := s.projection.HardDeleteCard(projectSlug, number); err != nil {
 			return model.Card{}, newError(CodeInternal, "projection sync failed", err)
 		}
+		if err := s.syncRelatedCards(card, model.EventTypeCardRelationRemoved); err != nil {
+			return model.Card{}, err
+		}
+		if err := s.syncChildCards(children, model.EventTypeCardParentUpdated); err != nil {
+			return model.Card{}, err
+		}
 		s.logger.Info("card hard deleted", "project", projectSlug, "card_id", card.ID, "card_number", card.Number)
 		s.publish(model.Event{
 			Type:      model.EventTypeCardDeletedHard,
@@ -458,6 +1058,101 @@ func (s *Service) DeleteCard(projectSlug string, number int, hard bool) (model.C
 	return card, nil
 }
 
+func (s *Service) RestoreCard(projectSlug string, number int, expectedRevision int) (model.Card, error) {
+	card, err := s.store.RestoreCard(projectSlug, number, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card restored", "project", projectSlug, "card_id", card.ID, "card_number", card.Number)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardRestored,
+		Project:   projectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return card, nil
+}
+
+// MoveCardToProject transfers a card to another project and returns it under
+// its new number. The source project keeps a tombstone pointing at it.
+func (s *Service) MoveCardToProject(projectSlug string, number int, targetSlug string, expectedRevision int) (model.Card, error) {
+	targetSlug = strings.TrimSpace(targetSlug)
+	if targetSlug != "" {
+		if _, err := s.store.GetProject(targetSlug); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return model.Card{}, newError(CodeNotFound, "target project not found", err)
+			}
+			return model.Card{}, newError(CodeInternal, "load project failed", err)
+		}
+	}
+	children, err := s.projection.ListChildCards(fmt.Sprintf("%s/card-%d", projectSlug, number))
+	if err != nil {
+		return model.Card{}, newError(CodeInternal, "list child cards failed", err)
+	}
+	moved, tombstone, err := s.store.MoveCardToProject(projectSlug, number, targetSlug, expectedRevision)
+	if err != nil {
+		if stale := preconditionErrorOf(err); stale != nil {
+			return model.Card{}, stale
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, newError(CodeNotFound, "card not found", err)
+		}
+		if errors.Is(err, os.ErrExist) {
+			return model.Card{}, newError(CodeConflict, err.Error(), err)
+		}
+		return model.Card{}, newError(CodeValidation, err.Error(), err)
+	}
+	moved = normalizeCardDefaults(moved)
+	tombstone = normalizeCardDefaults(tombstone)
+	target, err := s.store.GetProject(moved.ProjectSlug)
+	if err != nil {
+		return model.Card{}, newError(CodeInternal, "load project failed", err)
+	}
+	if err := s.projection.UpsertProject(target); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	if err := s.projection.UpsertCard(moved); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	if err := s.projection.UpsertCard(tombstone); err != nil {
+		return model.Card{}, newError(CodeInternal, "projection sync failed", err)
+	}
+	if err := s.syncRelatedCards(moved, model.EventTypeCardRelationUpdated); err != nil {
+		return model.Card{}, err
+	}
+	if err := s.syncChildCards(children, model.EventTypeCardParentUpdated); err != nil {
+		return model.Card{}, err
+	}
+	s.logger.Info("card transferred", "project", projectSlug, "card_id", tombstone.ID, "moved_to", moved.ID)
+	now := time.Now().UTC()
+	s.publish(model.Event{
+		Type:      model.EventTypeCardTransferred,
+		Project:   tombstone.ProjectSlug,
+		CardID:    tombstone.ID,
+		CardNum:   tombstone.Number,
+		Timestamp: now,
+	})
+	s.publish(model.Event{
+		Type:      model.EventTypeCardTransferred,
+		Project:   moved.ProjectSlug,
+		CardID:    moved.ID,
+		CardNum:   moved.Number,
+		Timestamp: now,
+	})
+	return moved, nil
+}
+
 func (s *Service) RebuildProjection() (RebuildResult, error) {
 	projects, cards, err := s.store.Snapshot()
 	if err != nil {
@@ -473,6 +1168,94 @@ func (s *Service) RebuildProjection() (RebuildResult, error) {
 	}, nil
 }
 
+// IngestProject syncs a project.md that was edited outside the server into the
+// projection and notifies clients.
+func (s *Service) IngestProject(slug string) error {
+	project, err := s.store.GetProject(slug)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return newError(CodeNotFound, "project not found", err)
+		}
+		return newError(CodeValidation, err.Error(), err)
+	}
+	known, err := s.projection.HasProject(project.Slug)
+	if err != nil {
+		return newError(CodeInternal, "projection lookup failed", err)
+	}
+	if err := s.projection.UpsertProject(project); err != nil {
+		return newError(CodeInternal, "projection sync failed", err)
+	}
+	eventType := model.EventTypeProjectUpdated
+	if !known {
+		eventType = model.EventTypeProjectCreated
+	}
+	s.logger.Info("project ingested from markdown", "project", project.Slug)
+	s.publish(model.Event{
+		Type:      eventType,
+		Project:   project.Slug,
+		Timestamp: time.Now().UTC(),
+	})
+	return nil
+}
+
+// IngestProjectRemoval drops a project whose directory was removed outside the
+// server from the projection.
+func (s *Service) IngestProjectRemoval(slug string) error {
+	if err := s.projection.DeleteProject(slug); err != nil {
+		return newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("project removal ingested from markdown", "project", slug)
+	s.publish(model.Event{
+		Type:      model.EventTypeProjectDeleted,
+		Project:   slug,
+		Timestamp: time.Now().UTC(),
+	})
+	return nil
+}
+
+// IngestCard syncs a card file that was edited outside the server into the
+// projection and notifies clients.
+func (s *Service) IngestCard(projectSlug string, number int) error {
+	card, err := s.store.GetCard(projectSlug, number)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return newError(CodeNotFound, "card not found", err)
+		}
+		return newError(CodeValidation, err.Error(), err)
+	}
+	card = normalizeCardDefaults(card)
+	if err := s.projection.UpsertCard(card); err != nil {
+		return newError(CodeInternal, "projection sync failed", err)
+	}
+	s.logger.Info("card ingested from markdown", "project", card.ProjectSlug, "card_id", card.ID, "card_number", card.Number)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardUpdated,
+		Project:   card.ProjectSlug,
+		CardID:    card.ID,
+		CardNum:   card.Number,
+		Timestamp: time.Now().UTC(),
+	})
+	return nil
+}
+
+// IngestCardRemoval drops a card whose file was removed outside the server from
+// the projection.
+func (s *Service) IngestCardRemoval(projectSlug string, number int) error {
+	if err := s.projection.HardDeleteCard(projectSlug, number); err != nil {
+		return newError(CodeInternal, "projection sync failed", err)
+	}
+	cardID := fmt.Sprintf("%s/card-%d", projectSlug, number)
+	s.logger.Info("card removal ingested from markdown", "project", projectSlug, "card_id", cardID, "card_number", number)
+	s.publish(model.Event{
+		Type:      model.EventTypeCardDeletedHard,
+		Project:   projectSlug,
+		CardID:    cardID,
+		CardNum:   number,
+		Timestamp: time.Now().UTC(),
+	})
+	return nil
+}
+
 func (s *Service) publish(event model.Event) {
 	if s.publisher == nil {
 		return
@@ -482,6 +1265,9 @@ func (s *Service) publish(event model.Event) {
 }
 
 func normalizeCardDefaults(card model.Card) model.Card {
+	if card.Labels == nil {
+		card.Labels = []string{}
+	}
 	if card.Description == nil {
 		card.Description = []model.TextEvent{}
 	}
@@ -497,6 +1283,12 @@ func normalizeCardDefaults(card model.Card) model.Card {
 	if card.AcceptanceCriteria == nil {
 		card.AcceptanceCriteria = []model.AcceptanceCriterion{}
 	}
+	if card.Relations == nil {
+		card.Relations = []model.CardRelation{}
+	}
+	if card.Attachments == nil {
+		card.Attachments = []model.Attachment{}
+	}
 	if card.NextTodoID <= 0 {
 		card.NextTodoID = nextTodoID(card.Todos)
 	}
@@ -525,18 +1317,3 @@ func nextAcceptanceCriterionID(criteria []model.AcceptanceCriterion) int {
 	}
 	return maxID + 1
 }
-
-func (s *Service) syncCardProjection(projectSlug string, number int) error {
-	card, err := s.store.GetCard(projectSlug, number)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return newError(CodeNotFound, "card not found", err)
-		}
-		return newError(CodeInternal, "get card failed", err)
-	}
-	card = normalizeCardDefaults(card)
-	if err := s.projection.UpsertCard(card); err != nil {
-		return newError(CodeInternal, "projection sync failed", err)
-	}
-	return nil
-}
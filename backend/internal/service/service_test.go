@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"os"
@@ -13,26 +14,45 @@ import (
 )
 
 type markdownStoreStub struct {
-	deleteProjectFn                   func(string) error
-	createProjectFn                   func(string, string, string) (model.Project, error)
-	listProjectsFn                    func() ([]model.Project, error)
-	createCardFn                      func(string, string, string, string, string) (model.Card, error)
-	getProjectFn                      func(string) (model.Project, error)
-	getCardFn                         func(string, int) (model.Card, error)
-	moveCardFn                        func(string, int, string) (model.Card, error)
-	setCardBranchFn                   func(string, int, string) (model.Card, error)
-	addCommentFn                      func(string, int, string) (model.Card, error)
-	appendDescriptionFn               func(string, int, string) (model.Card, error)
-	addTodoFn                         func(string, int, string) (model.Todo, error)
-	listTodosFn                       func(string, int) ([]model.Todo, error)
-	setTodoCompletedFn                func(string, int, int, bool) (model.Todo, error)
-	deleteTodoFn                      func(string, int, int) (model.Todo, error)
-	addAcceptanceCriterionFn          func(string, int, string) (model.AcceptanceCriterion, error)
-	listAcceptanceCriteriaFn          func(string, int) ([]model.AcceptanceCriterion, error)
-	setAcceptanceCriterionCompletedFn func(string, int, int, bool) (model.AcceptanceCriterion, error)
-	deleteAcceptanceCriterionFn       func(string, int, int) (model.AcceptanceCriterion, error)
-	deleteCardFn                      func(string, int, bool) (model.Card, error)
-	snapshotFn                        func() ([]model.Project, []model.Card, error)
+	deleteProjectFn             func(string) error
+	listTrashedProjectsFn       func() ([]model.TrashedProject, error)
+	restoreTrashedProjectFn     func(string) (model.Project, []model.Card, error)
+	purgeTrashedProjectFn       func(string) error
+	purgeTrashBeforeFn          func(time.Time) ([]model.TrashedProject, error)
+	createProjectFn             func(string, string, string) (model.Project, error)
+	listProjectsFn              func() ([]model.Project, error)
+	createCardFn                func(string, string, string, string, string, string, bool) (model.Card, error)
+	getProjectFn                func(string) (model.Project, error)
+	updateProjectFn             func(string, model.ProjectPatch) (model.Project, error)
+	setProjectStatusesFn        func(string, []string, map[string]string) (model.Project, []model.Card, error)
+	setTransitionRulesFn        func(string, []model.TransitionRule) (model.Project, error)
+	getCardFn                   func(string, int) (model.Card, error)
+	moveCardFn                  func(string, int, string, model.CardPosition, bool) (model.Card, error)
+	setCardBranchFn             func(string, int, string) (model.Card, error)
+	updateCardFn                func(string, int, model.CardPatch, bool) (model.Card, error)
+	addCommentFn                func(string, int, string) (model.Card, error)
+	appendDescriptionFn         func(string, int, string) (model.Card, error)
+	addTodoFn                   func(string, int, string) (model.Todo, error)
+	listTodosFn                 func(string, int) ([]model.Todo, error)
+	updateTodoFn                func(string, int, int, model.ChecklistItemPatch) (model.Todo, error)
+	deleteTodoFn                func(string, int, int) (model.Todo, error)
+	addAcceptanceCriterionFn    func(string, int, string) (model.AcceptanceCriterion, error)
+	listAcceptanceCriteriaFn    func(string, int) ([]model.AcceptanceCriterion, error)
+	updateAcceptanceCriterionFn func(string, int, int, model.ChecklistItemPatch) (model.AcceptanceCriterion, error)
+	deleteAcceptanceCriterionFn func(string, int, int) (model.AcceptanceCriterion, error)
+	deleteCardFn                func(string, int, bool) (model.Card, error)
+	restoreCardFn               func(string, int) (model.Card, error)
+	moveCardToProjectFn         func(string, int, string) (model.Card, model.Card, error)
+	addLabelFn                  func(string, int, string) (model.Card, error)
+	removeLabelFn               func(string, int, string) (model.Card, error)
+	setCardPriorityFn           func(string, int, string) (model.Card, error)
+	setCardDueFn                func(string, int, *time.Time) (model.Card, error)
+	addRelationFn               func(string, int, string, string) (model.Card, model.Card, error)
+	removeRelationFn            func(string, int, string, string) (model.Card, model.Card, error)
+	addAttachmentFn             func(string, int, string, string, []byte) (model.Attachment, error)
+	readAttachmentFn            func(string, int, string) (model.Attachment, []byte, error)
+	deleteAttachmentFn          func(string, int, string) (model.Attachment, error)
+	snapshotFn                  func() ([]model.Project, []model.Card, error)
 }
 
 func (m *markdownStoreStub) CreateProject(name, localPath, remoteURL string) (model.Project, error) {
@@ -44,86 +64,195 @@ func (m *markdownStoreStub) ListProjects() ([]model.Project, error) {
 }
 
 func (m *markdownStoreStub) GetProject(slug string) (model.Project, error) {
+	if m.getProjectFn == nil {
+		return model.Project{Slug: slug, Statuses: model.DefaultStatuses}, nil
+	}
 	return m.getProjectFn(slug)
 }
 
+func (m *markdownStoreStub) UpdateProject(slug string, patch model.ProjectPatch) (model.Project, error) {
+	return m.updateProjectFn(slug, patch)
+}
+
+func (m *markdownStoreStub) SetProjectStatuses(slug string, statuses []string, statusMap map[string]string) (model.Project, []model.Card, error) {
+	return m.setProjectStatusesFn(slug, statuses, statusMap)
+}
+
+func (m *markdownStoreStub) SetTransitionRules(slug string, rules []model.TransitionRule) (model.Project, error) {
+	return m.setTransitionRulesFn(slug, rules)
+}
+
 func (m *markdownStoreStub) DeleteProject(slug string) error {
 	return m.deleteProjectFn(slug)
 }
 
-func (m *markdownStoreStub) CreateCard(projectSlug, title, description, branch, status string) (model.Card, error) {
-	return m.createCardFn(projectSlug, title, description, branch, status)
+func (m *markdownStoreStub) ListTrashedProjects() ([]model.TrashedProject, error) {
+	return m.listTrashedProjectsFn()
+}
+
+func (m *markdownStoreStub) RestoreTrashedProject(id string) (model.Project, []model.Card, error) {
+	return m.restoreTrashedProjectFn(id)
+}
+
+func (m *markdownStoreStub) PurgeTrashedProject(id string) error {
+	return m.purgeTrashedProjectFn(id)
+}
+
+func (m *markdownStoreStub) PurgeTrashBefore(cutoff time.Time) ([]model.TrashedProject, error) {
+	return m.purgeTrashBeforeFn(cutoff)
+}
+
+func (m *markdownStoreStub) CreateCard(projectSlug, title, description, branch, status, parentID string, force bool) (model.Card, error) {
+	return m.createCardFn(projectSlug, title, description, branch, status, parentID, force)
 }
 
 func (m *markdownStoreStub) GetCard(projectSlug string, number int) (model.Card, error) {
+	if m.getCardFn == nil {
+		return model.Card{}, os.ErrNotExist
+	}
 	return m.getCardFn(projectSlug, number)
 }
 
-func (m *markdownStoreStub) MoveCard(projectSlug string, number int, status string) (model.Card, error) {
-	return m.moveCardFn(projectSlug, number, status)
+func (m *markdownStoreStub) MoveCard(projectSlug string, number int, status string, position model.CardPosition, force bool, _ int) (model.Card, error) {
+	return m.moveCardFn(projectSlug, number, status, position, force)
 }
 
-func (m *markdownStoreStub) SetCardBranch(projectSlug string, number int, branch string) (model.Card, error) {
+func (m *markdownStoreStub) SetCardBranch(projectSlug string, number int, branch string, _ int) (model.Card, error) {
 	return m.setCardBranchFn(projectSlug, number, branch)
 }
 
-func (m *markdownStoreStub) AddComment(projectSlug string, number int, body string) (model.Card, error) {
+func (m *markdownStoreStub) UpdateCard(projectSlug string, number int, patch model.CardPatch, force bool, _ int) (model.Card, error) {
+	return m.updateCardFn(projectSlug, number, patch, force)
+}
+
+func (m *markdownStoreStub) AddComment(projectSlug string, number int, body string, _ int) (model.Card, error) {
 	return m.addCommentFn(projectSlug, number, body)
 }
 
-func (m *markdownStoreStub) AppendDescription(projectSlug string, number int, body string) (model.Card, error) {
+func (m *markdownStoreStub) AppendDescription(projectSlug string, number int, body string, _ int) (model.Card, error) {
 	return m.appendDescriptionFn(projectSlug, number, body)
 }
 
-func (m *markdownStoreStub) AddTodo(projectSlug string, number int, text string) (model.Todo, error) {
-	return m.addTodoFn(projectSlug, number, text)
+// writtenCard stands in for the card an item write returns: the card
+// getCardFn gives, when the test sets one.
+func (m *markdownStoreStub) writtenCard(projectSlug string, number int, err error) model.Card {
+	if err != nil || m.getCardFn == nil {
+		return model.Card{}
+	}
+	card, _ := m.getCardFn(projectSlug, number)
+	return card
+}
+
+func (m *markdownStoreStub) AddTodo(projectSlug string, number int, text string, _ int) (model.Todo, model.Card, error) {
+	todo, err := m.addTodoFn(projectSlug, number, text)
+	return todo, m.writtenCard(projectSlug, number, err), err
 }
 
 func (m *markdownStoreStub) ListTodos(projectSlug string, number int) ([]model.Todo, error) {
 	return m.listTodosFn(projectSlug, number)
 }
 
-func (m *markdownStoreStub) SetTodoCompleted(projectSlug string, number int, todoID int, completed bool) (model.Todo, error) {
-	return m.setTodoCompletedFn(projectSlug, number, todoID, completed)
+func (m *markdownStoreStub) UpdateTodo(projectSlug string, number int, todoID int, patch model.ChecklistItemPatch, _ int) (model.Todo, model.Card, error) {
+	todo, err := m.updateTodoFn(projectSlug, number, todoID, patch)
+	return todo, m.writtenCard(projectSlug, number, err), err
 }
 
-func (m *markdownStoreStub) DeleteTodo(projectSlug string, number int, todoID int) (model.Todo, error) {
-	return m.deleteTodoFn(projectSlug, number, todoID)
+func (m *markdownStoreStub) DeleteTodo(projectSlug string, number int, todoID int, _ int) (model.Todo, model.Card, error) {
+	todo, err := m.deleteTodoFn(projectSlug, number, todoID)
+	return todo, m.writtenCard(projectSlug, number, err), err
 }
 
-func (m *markdownStoreStub) AddAcceptanceCriterion(projectSlug string, number int, text string) (model.AcceptanceCriterion, error) {
-	return m.addAcceptanceCriterionFn(projectSlug, number, text)
+func (m *markdownStoreStub) AddAcceptanceCriterion(projectSlug string, number int, text string, _ int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, err := m.addAcceptanceCriterionFn(projectSlug, number, text)
+	return criterion, m.writtenCard(projectSlug, number, err), err
 }
 
 func (m *markdownStoreStub) ListAcceptanceCriteria(projectSlug string, number int) ([]model.AcceptanceCriterion, error) {
 	return m.listAcceptanceCriteriaFn(projectSlug, number)
 }
 
-func (m *markdownStoreStub) SetAcceptanceCriterionCompleted(projectSlug string, number int, criterionID int, completed bool) (model.AcceptanceCriterion, error) {
-	return m.setAcceptanceCriterionCompletedFn(projectSlug, number, criterionID, completed)
+func (m *markdownStoreStub) UpdateAcceptanceCriterion(projectSlug string, number int, criterionID int, patch model.ChecklistItemPatch, _ int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, err := m.updateAcceptanceCriterionFn(projectSlug, number, criterionID, patch)
+	return criterion, m.writtenCard(projectSlug, number, err), err
 }
 
-func (m *markdownStoreStub) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int) (model.AcceptanceCriterion, error) {
-	return m.deleteAcceptanceCriterionFn(projectSlug, number, criterionID)
+func (m *markdownStoreStub) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int, _ int) (model.AcceptanceCriterion, model.Card, error) {
+	criterion, err := m.deleteAcceptanceCriterionFn(projectSlug, number, criterionID)
+	return criterion, m.writtenCard(projectSlug, number, err), err
 }
 
-func (m *markdownStoreStub) DeleteCard(projectSlug string, number int, hard bool) (model.Card, error) {
+func (m *markdownStoreStub) DeleteCard(projectSlug string, number int, hard bool, _ int) (model.Card, error) {
 	return m.deleteCardFn(projectSlug, number, hard)
 }
 
+func (m *markdownStoreStub) RestoreCard(projectSlug string, number int, _ int) (model.Card, error) {
+	return m.restoreCardFn(projectSlug, number)
+}
+
+func (m *markdownStoreStub) AddLabel(projectSlug string, number int, label string, _ int) (model.Card, error) {
+	return m.addLabelFn(projectSlug, number, label)
+}
+
+func (m *markdownStoreStub) RemoveLabel(projectSlug string, number int, label string, _ int) (model.Card, error) {
+	return m.removeLabelFn(projectSlug, number, label)
+}
+
+func (m *markdownStoreStub) SetCardPriority(projectSlug string, number int, priority string, _ int) (model.Card, error) {
+	return m.setCardPriorityFn(projectSlug, number, priority)
+}
+
+func (m *markdownStoreStub) SetCardDue(projectSlug string, number int, dueAt *time.Time, _ int) (model.Card, error) {
+	return m.setCardDueFn(projectSlug, number, dueAt)
+}
+
+func (m *markdownStoreStub) AddRelation(projectSlug string, number int, relationType, targetID string, _ int) (model.Card, model.Card, error) {
+	return m.addRelationFn(projectSlug, number, relationType, targetID)
+}
+
+func (m *markdownStoreStub) RemoveRelation(projectSlug string, number int, relationType, targetID string, _ int) (model.Card, model.Card, error) {
+	return m.removeRelationFn(projectSlug, number, relationType, targetID)
+}
+
+func (m *markdownStoreStub) MoveCardToProject(projectSlug string, number int, targetSlug string, _ int) (model.Card, model.Card, error) {
+	return m.moveCardToProjectFn(projectSlug, number, targetSlug)
+}
+
+func (m *markdownStoreStub) AddAttachment(projectSlug string, number int, filename, contentType string, data []byte, _ int) (model.Attachment, model.Card, error) {
+	attachment, err := m.addAttachmentFn(projectSlug, number, filename, contentType, data)
+	return attachment, m.writtenCard(projectSlug, number, err), err
+}
+
+func (m *markdownStoreStub) ReadAttachment(projectSlug string, number int, filename string) (model.Attachment, []byte, error) {
+	return m.readAttachmentFn(projectSlug, number, filename)
+}
+
+func (m *markdownStoreStub) DeleteAttachment(projectSlug string, number int, filename string, _ int) (model.Attachment, model.Card, error) {
+	attachment, err := m.deleteAttachmentFn(projectSlug, number, filename)
+	return attachment, m.writtenCard(projectSlug, number, err), err
+}
+
 func (m *markdownStoreStub) Snapshot() ([]model.Project, []model.Card, error) {
 	return m.snapshotFn()
 }
 
 type projectionStub struct {
+	hasProjectFn     func(string) (bool, error)
 	upsertProjectFn  func(model.Project) error
 	upsertCardFn     func(model.Card) error
 	deleteProjectFn  func(string) error
 	hardDeleteCardFn func(string, int) error
-	listCardsFn      func(string, bool) ([]model.CardSummary, error)
+	listCardsFn      func(string, model.CardListOptions) ([]model.CardSummary, error)
+	getCardSummaryFn func(string) (model.CardSummary, error)
+	listChildCardsFn func(string) ([]model.CardSummary, error)
 	rebuildFromMdFn  func([]model.Project, []model.Card) error
 }
 
+func (p *projectionStub) HasProject(slug string) (bool, error) {
+	if p.hasProjectFn == nil {
+		return false, nil
+	}
+	return p.hasProjectFn(slug)
+}
 func (p *projectionStub) UpsertProject(project model.Project) error {
 	return p.upsertProjectFn(project)
 }
@@ -134,8 +263,17 @@ func (p *projectionStub) DeleteProject(projectSlug string) error {
 func (p *projectionStub) HardDeleteCard(projectSlug string, number int) error {
 	return p.hardDeleteCardFn(projectSlug, number)
 }
-func (p *projectionStub) ListCards(projectSlug string, includeDeleted bool) ([]model.CardSummary, error) {
-	return p.listCardsFn(projectSlug, includeDeleted)
+func (p *projectionStub) ListCards(projectSlug string, opts model.CardListOptions) ([]model.CardSummary, error) {
+	return p.listCardsFn(projectSlug, opts)
+}
+func (p *projectionStub) GetCardSummary(cardID string) (model.CardSummary, error) {
+	return p.getCardSummaryFn(cardID)
+}
+func (p *projectionStub) ListChildCards(parentID string) ([]model.CardSummary, error) {
+	if p.listChildCardsFn == nil {
+		return nil, nil
+	}
+	return p.listChildCardsFn(parentID)
 }
 func (p *projectionStub) RebuildFromMarkdown(projects []model.Project, cards []model.Card) error {
 	return p.rebuildFromMdFn(projects, cards)
@@ -207,6 +345,68 @@ func TestDeleteProjectReturnsInternalWhenProjectionFails(t *testing.T) {
 	require.Len(t, publisher.events, 0)
 }
 
+func TestRestoreTrashedProjectSyncsProjectionAndPublishes(t *testing.T) {
+	t.Parallel()
+
+	var upsertedCards []string
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		restoreTrashedProjectFn: func(id string) (model.Project, []model.Card, error) {
+			require.Equal(t, "alpha-20260101T000000.000Z", id)
+			return model.Project{Slug: "alpha"}, []model.Card{{ID: "alpha/card-1"}, {ID: "alpha/card-2"}}, nil
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(_ model.Project) error { return nil },
+		upsertCardFn: func(card model.Card) error {
+			upsertedCards = append(upsertedCards, card.ID)
+			return nil
+		},
+	}, publisher)
+
+	project, err := svc.RestoreTrashedProject("alpha-20260101T000000.000Z")
+	require.NoError(t, err)
+	require.Equal(t, "alpha", project.Slug)
+	require.Equal(t, []string{"alpha/card-1", "alpha/card-2"}, upsertedCards)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeProjectRestored, publisher.events[0].Type)
+
+	svc = newNoopService(&markdownStoreStub{
+		restoreTrashedProjectFn: func(_ string) (model.Project, []model.Card, error) {
+			return model.Project{}, nil, fmt.Errorf("project %q already exists: %w", "alpha", os.ErrExist)
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.RestoreTrashedProject("alpha-20260101T000000.000Z")
+	require.Equal(t, CodeConflict, CodeOf(err))
+	require.Contains(t, err.Error(), `project "alpha" already exists`)
+}
+
+func TestPurgeExpiredTrashUsesRetentionCutoff(t *testing.T) {
+	t.Parallel()
+
+	var cutoff time.Time
+	svc := newNoopService(&markdownStoreStub{
+		purgeTrashBeforeFn: func(before time.Time) ([]model.TrashedProject, error) {
+			cutoff = before
+			return []model.TrashedProject{{ID: "alpha-20260101T000000.000Z"}}, nil
+		},
+	}, &projectionStub{}, &publisherStub{})
+
+	purged, err := svc.PurgeExpiredTrash(48 * time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	require.WithinDuration(t, time.Now().Add(-48*time.Hour), cutoff, time.Minute)
+
+	// Without a retention period nothing is purged and the store is not asked.
+	purged, err = svc.PurgeExpiredTrash(0)
+	require.NoError(t, err)
+	require.Equal(t, 0, purged)
+
+	svc = newNoopService(&markdownStoreStub{
+		purgeTrashedProjectFn: func(_ string) error { return os.ErrNotExist },
+	}, &projectionStub{}, &publisherStub{})
+	require.Equal(t, CodeNotFound, CodeOf(svc.PurgeTrashedProject("missing-20260101T000000.000Z")))
+}
+
 func TestCreateProjectConflictMapping(t *testing.T) {
 	t.Parallel()
 
@@ -221,6 +421,92 @@ func TestCreateProjectConflictMapping(t *testing.T) {
 	require.Equal(t, CodeConflict, CodeOf(err))
 }
 
+func TestUpdateProjectUpsertsProjectionAndPublishes(t *testing.T) {
+	t.Parallel()
+
+	remote := "git@example.com:org/alpha.git"
+	var upserted model.Project
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		updateProjectFn: func(slug string, patch model.ProjectPatch) (model.Project, error) {
+			require.Nil(t, patch.Name)
+			return model.Project{Slug: slug, Name: "Alpha", RemoteURL: *patch.RemoteURL}, nil
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(project model.Project) error {
+			upserted = project
+			return nil
+		},
+	}, publisher)
+
+	got, err := svc.UpdateProject("alpha", model.ProjectPatch{RemoteURL: &remote})
+	require.NoError(t, err)
+	require.Equal(t, remote, got.RemoteURL)
+	require.Equal(t, remote, upserted.RemoteURL)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeProjectUpdated, publisher.events[0].Type)
+	require.Equal(t, "alpha", publisher.events[0].Project)
+
+	svc = newNoopService(&markdownStoreStub{
+		updateProjectFn: func(_ string, _ model.ProjectPatch) (model.Project, error) {
+			return model.Project{}, os.ErrNotExist
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.UpdateProject("missing", model.ProjectPatch{RemoteURL: &remote})
+	require.Equal(t, CodeNotFound, CodeOf(err))
+
+	svc = newNoopService(&markdownStoreStub{
+		updateProjectFn: func(_ string, _ model.ProjectPatch) (model.Project, error) {
+			return model.Project{Slug: "alpha"}, nil
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(_ model.Project) error { return errors.New("projection down") },
+	}, &publisherStub{})
+	_, err = svc.UpdateProject("alpha", model.ProjectPatch{RemoteURL: &remote})
+	require.Equal(t, CodeInternal, CodeOf(err))
+}
+
+func TestSetProjectStatusesSyncsMigratedCards(t *testing.T) {
+	t.Parallel()
+
+	var upsertedCards []string
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		setProjectStatusesFn: func(slug string, statuses []string, statusMap map[string]string) (model.Project, []model.Card, error) {
+			require.Equal(t, "Shipped", statusMap["Done"])
+			return model.Project{Slug: slug, Statuses: statuses}, []model.Card{{ID: "alpha/card-1", ProjectSlug: slug, Number: 1, Status: "Shipped"}}, nil
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(_ model.Project) error { return nil },
+		upsertCardFn: func(card model.Card) error {
+			upsertedCards = append(upsertedCards, card.ID)
+			return nil
+		},
+	}, publisher)
+
+	project, err := svc.SetProjectStatuses("alpha", []string{"Todo", "Shipped"}, map[string]string{"Done": "Shipped"})
+	require.NoError(t, err)
+	require.Equal(t, "Shipped", project.DoneStatus())
+	require.Equal(t, []string{"alpha/card-1"}, upsertedCards)
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeProjectUpdated, publisher.events[0].Type)
+	require.Equal(t, model.EventTypeCardMoved, publisher.events[1].Type)
+
+	svc = newNoopService(&markdownStoreStub{
+		setProjectStatusesFn: func(_ string, _ []string, _ map[string]string) (model.Project, []model.Card, error) {
+			return model.Project{}, nil, errors.New("cards still use status Done")
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.SetProjectStatuses("alpha", []string{"Todo", "Shipped"}, nil)
+	require.Equal(t, CodeValidation, CodeOf(err))
+
+	svc = newNoopService(&markdownStoreStub{
+		getProjectFn: func(_ string) (model.Project, error) { return model.Project{}, os.ErrNotExist },
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.ProjectStatuses("missing")
+	require.Equal(t, CodeNotFound, CodeOf(err))
+}
+
 func TestCreateCardUpsertsProjectAndCard(t *testing.T) {
 	t.Parallel()
 
@@ -247,7 +533,7 @@ func TestCreateCardUpsertsProjectAndCard(t *testing.T) {
 	)
 
 	markdown := &markdownStoreStub{
-		createCardFn: func(projectSlug, _, _, _, _ string) (model.Card, error) {
+		createCardFn: func(projectSlug, _, _, _, _, _ string, _ bool) (model.Card, error) {
 			require.Equal(t, "alpha", projectSlug)
 			return createdCard, nil
 		},
@@ -271,7 +557,7 @@ func TestCreateCardUpsertsProjectAndCard(t *testing.T) {
 	publisher := &publisherStub{}
 
 	svc := newNoopService(markdown, projection, publisher)
-	card, err := svc.CreateCard("alpha", "title", "", "", "Todo")
+	card, err := svc.CreateCard("alpha", "title", "", "", "Todo", "", false)
 	require.NoError(t, err)
 	require.Equal(t, "alpha/card-1", card.ID)
 	require.True(t, projectUpserted)
@@ -298,12 +584,12 @@ func TestListCardsMapsProjectionErrorToInternal(t *testing.T) {
 	t.Parallel()
 
 	svc := newNoopService(&markdownStoreStub{}, &projectionStub{
-		listCardsFn: func(_ string, _ bool) ([]model.CardSummary, error) {
+		listCardsFn: func(_ string, _ model.CardListOptions) ([]model.CardSummary, error) {
 			return nil, errors.New("boom")
 		},
 	}, &publisherStub{})
 
-	_, err := svc.ListCards("alpha", false)
+	_, err := svc.ListCards("alpha", model.CardListOptions{})
 	require.Error(t, err)
 	require.Equal(t, CodeInternal, CodeOf(err))
 }
@@ -328,7 +614,7 @@ func TestMoveCardSuccessPublishesEvent(t *testing.T) {
 	card := model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Status: "Doing"}
 	publisher := &publisherStub{}
 	svc := newNoopService(&markdownStoreStub{
-		moveCardFn: func(_ string, _ int, _ string) (model.Card, error) {
+		moveCardFn: func(_ string, _ int, _ string, _ model.CardPosition, _ bool) (model.Card, error) {
 			return card, nil
 		},
 	}, &projectionStub{
@@ -338,18 +624,45 @@ func TestMoveCardSuccessPublishesEvent(t *testing.T) {
 		},
 	}, publisher)
 
-	got, err := svc.MoveCard("alpha", 1, "Doing")
+	got, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
 	require.NoError(t, err)
 	require.Equal(t, card.ID, got.ID)
 	require.Len(t, publisher.events, 1)
 	require.Equal(t, model.EventTypeCardMoved, publisher.events[0].Type)
 }
 
+func TestMoveCardWithinStatusPublishesReorder(t *testing.T) {
+	t.Parallel()
+
+	var positions []model.CardPosition
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(projectSlug string, number int) (model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: projectSlug, Number: number, Status: "Todo", Rank: "i"}, nil
+		},
+		moveCardFn: func(projectSlug string, number int, status string, position model.CardPosition, _ bool) (model.Card, error) {
+			positions = append(positions, position)
+			return model.Card{ID: "alpha/card-1", ProjectSlug: projectSlug, Number: number, Status: status, Rank: "9"}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, publisher)
+
+	_, err := svc.MoveCard("alpha", 1, "Todo", model.CardPosition{Before: 4}, false, 0)
+	require.NoError(t, err)
+	_, err = svc.MoveCard("alpha", 1, "Doing", model.CardPosition{After: 2}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardPosition{{Before: 4}, {After: 2}}, positions)
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeCardReordered, publisher.events[0].Type)
+	require.Equal(t, model.EventTypeCardMoved, publisher.events[1].Type)
+}
+
 func TestMoveCardProjectionFailureReturnsInternal(t *testing.T) {
 	t.Parallel()
 
 	svc := newNoopService(&markdownStoreStub{
-		moveCardFn: func(_ string, _ int, _ string) (model.Card, error) {
+		moveCardFn: func(_ string, _ int, _ string, _ model.CardPosition, _ bool) (model.Card, error) {
 			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Status: "Doing"}, nil
 		},
 	}, &projectionStub{
@@ -358,11 +671,151 @@ func TestMoveCardProjectionFailureReturnsInternal(t *testing.T) {
 		},
 	}, &publisherStub{})
 
-	_, err := svc.MoveCard("alpha", 1, "Doing")
+	_, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeInternal, CodeOf(err))
 }
 
+func TestMoveCardRejectsStaleRevision(t *testing.T) {
+	t.Parallel()
+
+	current := model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Status: "Review", Revision: 4}
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(_ string, _ int) (model.Card, error) { return current, nil },
+		moveCardFn: func(_ string, _ int, _ string, _ model.CardPosition, _ bool) (model.Card, error) {
+			t.Fatal("store must not be written when the revision is stale")
+			return model.Card{}, nil
+		},
+	}, &projectionStub{}, publisher)
+
+	_, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 3)
+	require.Error(t, err)
+	require.Equal(t, CodePreconditionFailed, CodeOf(err))
+	got, ok := CurrentCardOf(err)
+	require.True(t, ok)
+	require.Equal(t, 4, got.Revision)
+	require.Equal(t, "Review", got.Status)
+	require.Len(t, publisher.events, 0)
+}
+
+// The store checks the revision under its project lock; a card that changed
+// after any earlier read still fails with the card as it is now.
+func TestCommentCardMapsStoreStaleRevision(t *testing.T) {
+	t.Parallel()
+
+	current := model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Status: "Review", Revision: 5}
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		addCommentFn: func(_ string, _ int, _ string) (model.Card, error) {
+			return model.Card{}, &model.StaleRevisionError{Expected: 4, Current: current}
+		},
+	}, &projectionStub{}, publisher)
+
+	_, err := svc.CommentCard("alpha", 1, "hello", 4)
+	require.Error(t, err)
+	require.Equal(t, CodePreconditionFailed, CodeOf(err))
+	require.Equal(t, "card alpha/card-1 changed: expected revision 4, current revision 5", MessageOf(err))
+	got, ok := CurrentCardOf(err)
+	require.True(t, ok)
+	require.Equal(t, 5, got.Revision)
+	require.Len(t, publisher.events, 0)
+}
+
+func TestWriteToOneProjectDoesNotWaitForAnother(t *testing.T) {
+	t.Parallel()
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	svc := newNoopService(&markdownStoreStub{
+		addCommentFn: func(projectSlug string, number int, _ string) (model.Card, error) {
+			if projectSlug == "alpha" {
+				close(blocked)
+				<-release
+			}
+			return model.Card{ID: fmt.Sprintf("%s/card-%d", projectSlug, number), ProjectSlug: projectSlug, Number: number, Revision: 2}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, &publisherStub{})
+
+	alphaDone := make(chan error, 1)
+	go func() {
+		_, err := svc.CommentCard("alpha", 1, "slow", 0)
+		alphaDone <- err
+	}()
+	<-blocked
+
+	betaDone := make(chan error, 1)
+	go func() {
+		_, err := svc.CommentCard("beta", 1, "fast", 0)
+		betaDone <- err
+	}()
+	select {
+	case err := <-betaDone:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("comment on beta waited for the stuck write to alpha")
+	}
+
+	close(release)
+	require.NoError(t, <-alphaDone)
+}
+
+func TestMoveCardAcceptsMatchingRevision(t *testing.T) {
+	t.Parallel()
+
+	current := model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Status: "Todo", Revision: 4}
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(_ string, _ int) (model.Card, error) { return current, nil },
+		moveCardFn: func(_ string, _ int, status string, _ model.CardPosition, _ bool) (model.Card, error) {
+			moved := current
+			moved.Status = status
+			moved.Revision++
+			return moved, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, &publisherStub{})
+
+	got, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 4)
+	require.NoError(t, err)
+	require.Equal(t, 5, got.Revision)
+
+	_, ok := CurrentCardOf(errors.New("plain"))
+	require.False(t, ok)
+}
+
+func TestUpdateCardPublishesUpdate(t *testing.T) {
+	t.Parallel()
+
+	title := "Renamed"
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		updateCardFn: func(_ string, _ int, patch model.CardPatch, _ bool) (model.Card, error) {
+			require.Equal(t, "Renamed", *patch.Title)
+			require.Nil(t, patch.Status)
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Title: *patch.Title}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, publisher)
+
+	got, err := svc.UpdateCard("alpha", 1, model.CardPatch{Title: &title}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", got.Title)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeCardUpdated, publisher.events[0].Type)
+
+	svc = newNoopService(&markdownStoreStub{
+		updateCardFn: func(_ string, _ int, _ model.CardPatch, _ bool) (model.Card, error) {
+			return model.Card{}, errors.New("title is required")
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{}, false, 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+}
+
 func TestCommentCardSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -376,7 +829,7 @@ func TestCommentCardSuccess(t *testing.T) {
 		upsertCardFn: func(_ model.Card) error { return nil },
 	}, publisher)
 
-	_, err := svc.CommentCard("alpha", 1, "hello")
+	_, err := svc.CommentCard("alpha", 1, "hello", 0)
 	require.NoError(t, err)
 	require.Len(t, publisher.events, 1)
 	require.Equal(t, model.EventTypeCardCommented, publisher.events[0].Type)
@@ -395,7 +848,7 @@ func TestAppendDescriptionSuccess(t *testing.T) {
 		upsertCardFn: func(_ model.Card) error { return nil },
 	}, publisher)
 
-	_, err := svc.AppendDescription("alpha", 1, "desc")
+	_, err := svc.AppendDescription("alpha", 1, "desc", 0)
 	require.NoError(t, err)
 	require.Len(t, publisher.events, 1)
 	require.Equal(t, model.EventTypeCardUpdated, publisher.events[0].Type)
@@ -419,11 +872,12 @@ func TestTodoLifecycleMethods(t *testing.T) {
 			require.Equal(t, 1, number)
 			return []model.Todo{todo}, nil
 		},
-		setTodoCompletedFn: func(project string, number int, todoID int, completed bool) (model.Todo, error) {
+		updateTodoFn: func(project string, number int, todoID int, patch model.ChecklistItemPatch) (model.Todo, error) {
 			require.Equal(t, "alpha", project)
 			require.Equal(t, 1, number)
 			require.Equal(t, 1, todoID)
-			return model.Todo{ID: todoID, Text: "Write tests", Completed: completed}, nil
+			require.NotNil(t, patch.Completed)
+			return model.Todo{ID: todoID, Text: "Write tests", Completed: *patch.Completed}, nil
 		},
 		deleteTodoFn: func(project string, number int, todoID int) (model.Todo, error) {
 			require.Equal(t, "alpha", project)
@@ -445,23 +899,25 @@ func TestTodoLifecycleMethods(t *testing.T) {
 		upsertCardFn: func(_ model.Card) error { return nil },
 	}, publisher)
 
-	added, err := svc.AddTodo("alpha", 1, "Write tests")
+	added, card, err := svc.AddTodo("alpha", 1, "Write tests", 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, added.ID)
+	require.Equal(t, "alpha/card-1", card.ID)
 
 	listed, err := svc.ListTodos("alpha", 1)
 	require.NoError(t, err)
 	require.Len(t, listed, 1)
 
-	done, err := svc.SetTodoCompleted("alpha", 1, 1, true)
+	completed, reopened := true, false
+	done, _, err := svc.UpdateTodo("alpha", 1, 1, model.ChecklistItemPatch{Completed: &completed}, 0)
 	require.NoError(t, err)
 	require.True(t, done.Completed)
 
-	undo, err := svc.SetTodoCompleted("alpha", 1, 1, false)
+	undo, _, err := svc.UpdateTodo("alpha", 1, 1, model.ChecklistItemPatch{Completed: &reopened}, 0)
 	require.NoError(t, err)
 	require.False(t, undo.Completed)
 
-	removed, err := svc.DeleteTodo("alpha", 1, 1)
+	removed, _, err := svc.DeleteTodo("alpha", 1, 1, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, removed.ID)
 
@@ -480,13 +936,13 @@ func TestTodoMethodsMapNotFoundErrors(t *testing.T) {
 		listTodosFn: func(_ string, _ int) ([]model.Todo, error) {
 			return nil, os.ErrNotExist
 		},
-		setTodoCompletedFn: func(_ string, _ int, _ int, _ bool) (model.Todo, error) {
+		updateTodoFn: func(_ string, _ int, _ int, _ model.ChecklistItemPatch) (model.Todo, error) {
 			return model.Todo{}, os.ErrNotExist
 		},
 		deleteTodoFn: func(_ string, _ int, _ int) (model.Todo, error) { return model.Todo{}, os.ErrNotExist },
 	}, &projectionStub{}, &publisherStub{})
 
-	_, err := svc.AddTodo("alpha", 1, "Write tests")
+	_, _, err := svc.AddTodo("alpha", 1, "Write tests", 0)
 	require.Error(t, err)
 	require.Equal(t, CodeNotFound, CodeOf(err))
 
@@ -494,11 +950,11 @@ func TestTodoMethodsMapNotFoundErrors(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, CodeNotFound, CodeOf(err))
 
-	_, err = svc.SetTodoCompleted("alpha", 1, 1, true)
+	_, _, err = svc.UpdateTodo("alpha", 1, 1, model.ChecklistItemPatch{}, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeNotFound, CodeOf(err))
 
-	_, err = svc.DeleteTodo("alpha", 1, 1)
+	_, _, err = svc.DeleteTodo("alpha", 1, 1, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeNotFound, CodeOf(err))
 }
@@ -519,8 +975,8 @@ func TestAcceptanceCriteriaLifecycleMethods(t *testing.T) {
 		listAcceptanceCriteriaFn: func(_ string, _ int) ([]model.AcceptanceCriterion, error) {
 			return []model.AcceptanceCriterion{criterion}, nil
 		},
-		setAcceptanceCriterionCompletedFn: func(_ string, _ int, criterionID int, completed bool) (model.AcceptanceCriterion, error) {
-			return model.AcceptanceCriterion{ID: criterionID, Text: "Requirement A", Completed: completed}, nil
+		updateAcceptanceCriterionFn: func(_ string, _ int, criterionID int, patch model.ChecklistItemPatch) (model.AcceptanceCriterion, error) {
+			return model.AcceptanceCriterion{ID: criterionID, Text: "Requirement A", Completed: *patch.Completed}, nil
 		},
 		deleteAcceptanceCriterionFn: func(_ string, _ int, criterionID int) (model.AcceptanceCriterion, error) {
 			return model.AcceptanceCriterion{ID: criterionID, Text: "Requirement A", Completed: true}, nil
@@ -539,7 +995,7 @@ func TestAcceptanceCriteriaLifecycleMethods(t *testing.T) {
 		upsertCardFn: func(_ model.Card) error { return nil },
 	}, publisher)
 
-	added, err := svc.AddAcceptanceCriterion("alpha", 1, "Requirement A")
+	added, _, err := svc.AddAcceptanceCriterion("alpha", 1, "Requirement A", 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, added.ID)
 
@@ -547,15 +1003,16 @@ func TestAcceptanceCriteriaLifecycleMethods(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, listed, 1)
 
-	done, err := svc.SetAcceptanceCriterionCompleted("alpha", 1, 1, true)
+	completed, reopened := true, false
+	done, _, err := svc.UpdateAcceptanceCriterion("alpha", 1, 1, model.ChecklistItemPatch{Completed: &completed}, 0)
 	require.NoError(t, err)
 	require.True(t, done.Completed)
 
-	undo, err := svc.SetAcceptanceCriterionCompleted("alpha", 1, 1, false)
+	undo, _, err := svc.UpdateAcceptanceCriterion("alpha", 1, 1, model.ChecklistItemPatch{Completed: &reopened}, 0)
 	require.NoError(t, err)
 	require.False(t, undo.Completed)
 
-	removed, err := svc.DeleteAcceptanceCriterion("alpha", 1, 1)
+	removed, _, err := svc.DeleteAcceptanceCriterion("alpha", 1, 1, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, removed.ID)
 
@@ -582,15 +1039,456 @@ func TestDeleteCardSoftAndHardPaths(t *testing.T) {
 		},
 	}, publisher)
 
-	_, err := svc.DeleteCard("alpha", 1, false)
+	_, err := svc.DeleteCard("alpha", 1, false, 0)
 	require.NoError(t, err)
-	_, err = svc.DeleteCard("alpha", 1, true)
+	_, err = svc.DeleteCard("alpha", 1, true, 0)
 	require.NoError(t, err)
 	require.Len(t, publisher.events, 2)
 	require.Equal(t, model.EventTypeCardDeletedSoft, publisher.events[0].Type)
 	require.Equal(t, model.EventTypeCardDeletedHard, publisher.events[1].Type)
 }
 
+func TestRestoreCardPublishesRestored(t *testing.T) {
+	t.Parallel()
+
+	var upserted model.Card
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		restoreCardFn: func(_ string, _ int) (model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(card model.Card) error {
+			upserted = card
+			return nil
+		},
+	}, publisher)
+
+	got, err := svc.RestoreCard("alpha", 1, 0)
+	require.NoError(t, err)
+	require.False(t, got.Deleted)
+	require.Equal(t, "alpha/card-1", upserted.ID)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeCardRestored, publisher.events[0].Type)
+
+	svc = newNoopService(&markdownStoreStub{
+		restoreCardFn: func(_ string, _ int) (model.Card, error) {
+			return model.Card{}, errors.New("card 1 is not deleted")
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.RestoreCard("alpha", 1, 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+	require.EqualError(t, err, "card 1 is not deleted")
+
+	svc = newNoopService(&markdownStoreStub{
+		restoreCardFn: func(_ string, _ int) (model.Card, error) {
+			return model.Card{}, os.ErrNotExist
+		},
+	}, &projectionStub{}, &publisherStub{})
+	_, err = svc.RestoreCard("alpha", 9, 0)
+	require.Equal(t, CodeNotFound, CodeOf(err))
+}
+
+func TestMoveCardToProjectSyncsBothCardsAndPublishes(t *testing.T) {
+	t.Parallel()
+
+	upserted := make([]string, 0, 2)
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		getProjectFn: func(slug string) (model.Project, error) {
+			if slug != "beta" {
+				return model.Project{}, os.ErrNotExist
+			}
+			return model.Project{Slug: "beta", NextCardSeq: 8}, nil
+		},
+		moveCardToProjectFn: func(_ string, _ int, _ string) (model.Card, model.Card, error) {
+			return model.Card{ID: "beta/card-7", ProjectSlug: "beta", Number: 7},
+				model.Card{ID: "alpha/card-3", ProjectSlug: "alpha", Number: 3, Deleted: true, MovedTo: "beta/card-7"}, nil
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(project model.Project) error {
+			require.Equal(t, 8, project.NextCardSeq)
+			return nil
+		},
+		upsertCardFn: func(card model.Card) error {
+			upserted = append(upserted, card.ID)
+			return nil
+		},
+	}, publisher)
+
+	got, err := svc.MoveCardToProject("alpha", 3, "beta", 0)
+	require.NoError(t, err)
+	require.Equal(t, "beta/card-7", got.ID)
+	require.Equal(t, []string{"beta/card-7", "alpha/card-3"}, upserted)
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeCardTransferred, publisher.events[0].Type)
+	require.Equal(t, "alpha", publisher.events[0].Project)
+	require.Equal(t, "beta", publisher.events[1].Project)
+
+	_, err = svc.MoveCardToProject("alpha", 3, "gamma", 0)
+	require.Equal(t, CodeNotFound, CodeOf(err))
+	require.EqualError(t, err, "target project not found")
+}
+
+func TestGetCardReportsMovedTombstone(t *testing.T) {
+	t.Parallel()
+
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(_ string, _ int) (model.Card, error) {
+			return model.Card{ID: "alpha/card-3", Deleted: true, MovedTo: "beta/card-7"}, nil
+		},
+	}, &projectionStub{}, &publisherStub{})
+
+	_, err := svc.GetCard("alpha", 3)
+	require.Equal(t, CodeMoved, CodeOf(err))
+	tombstone, ok := CurrentCardOf(err)
+	require.True(t, ok)
+	require.Equal(t, "beta/card-7", tombstone.MovedTo)
+}
+
+func TestCardLabelChangesPublishEvents(t *testing.T) {
+	t.Parallel()
+
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		addLabelFn: func(_ string, _ int, label string) (model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Labels: []string{label}}, nil
+		},
+		removeLabelFn: func(_ string, _ int, label string) (model.Card, error) {
+			return model.Card{}, fmt.Errorf("card 1 has no label %q", label)
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, publisher)
+
+	card, err := svc.AddCardLabel("alpha", 1, "bug", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bug"}, card.Labels)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeCardLabelAdded, publisher.events[0].Type)
+
+	_, err = svc.RemoveCardLabel("alpha", 1, "infra", 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+	require.Len(t, publisher.events, 1)
+}
+
+func TestListCardsNormalizesLabelFilter(t *testing.T) {
+	t.Parallel()
+
+	var got model.CardListOptions
+	svc := newNoopService(&markdownStoreStub{}, &projectionStub{
+		listCardsFn: func(_ string, opts model.CardListOptions) ([]model.CardSummary, error) {
+			got = opts
+			return []model.CardSummary{}, nil
+		},
+	}, &publisherStub{})
+
+	_, err := svc.ListCards("alpha", model.CardListOptions{IncludeDeleted: true, Label: " Bug "})
+	require.NoError(t, err)
+	require.Equal(t, model.CardListOptions{IncludeDeleted: true, Label: "bug"}, got)
+}
+
+func TestListCardsValidatesSort(t *testing.T) {
+	t.Parallel()
+
+	var got model.CardListOptions
+	svc := newNoopService(&markdownStoreStub{}, &projectionStub{
+		listCardsFn: func(_ string, opts model.CardListOptions) ([]model.CardSummary, error) {
+			got = opts
+			return []model.CardSummary{}, nil
+		},
+	}, &publisherStub{})
+
+	_, err := svc.ListCards("alpha", model.CardListOptions{Sort: " Priority ", Overdue: true})
+	require.NoError(t, err)
+	require.Equal(t, model.CardListOptions{Sort: model.CardSortPriority, Overdue: true}, got)
+
+	_, err = svc.ListCards("alpha", model.CardListOptions{Sort: "title"})
+	require.Equal(t, CodeValidation, CodeOf(err))
+}
+
+func TestSetCardPriorityAndDuePublishEvents(t *testing.T) {
+	t.Parallel()
+
+	dueAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		setCardPriorityFn: func(_ string, _ int, priority string) (model.Card, error) {
+			if priority == "P9" {
+				return model.Card{}, fmt.Errorf("invalid priority %q", priority)
+			}
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Priority: priority}, nil
+		},
+		setCardDueFn: func(_ string, _ int, due *time.Time) (model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, DueAt: due}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, publisher)
+
+	card, err := svc.SetCardPriority("alpha", 1, "P1", 0)
+	require.NoError(t, err)
+	require.Equal(t, "P1", card.Priority)
+
+	card, err = svc.SetCardDue("alpha", 1, &dueAt, 0)
+	require.NoError(t, err)
+	require.Equal(t, dueAt, *card.DueAt)
+
+	_, err = svc.SetCardPriority("alpha", 1, "P9", 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeCardPriorityUpdated, publisher.events[0].Type)
+	require.Equal(t, model.EventTypeCardDueUpdated, publisher.events[1].Type)
+}
+
+func TestMoveCardRefusesBlockedCardUnlessForced(t *testing.T) {
+	t.Parallel()
+
+	// The store checks the blockers under the locks of their projects; the
+	// service reports its refusal as a conflict, for moves and edits alike.
+	var forced []bool
+	refuse := func(force bool) error {
+		forced = append(forced, force)
+		if force {
+			return nil
+		}
+		return &model.BlockedError{Number: 1, Blockers: []string{"alpha/card-2"}}
+	}
+	svc := newNoopService(&markdownStoreStub{
+		moveCardFn: func(projectSlug string, number int, status string, _ model.CardPosition, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: status}, refuse(force)
+		},
+		updateCardFn: func(projectSlug string, number int, patch model.CardPatch, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: *patch.Status}, refuse(force)
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, &publisherStub{})
+
+	_, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+	require.Equal(t, CodeConflict, CodeOf(err))
+	require.EqualError(t, err, "card 1 is blocked by alpha/card-2; finish those first or move with force")
+	doing := "Doing"
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{Status: &doing}, false, 0)
+	require.Equal(t, CodeConflict, CodeOf(err))
+
+	card, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", card.Status)
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{Status: &doing}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, true, true}, forced)
+}
+
+func TestMoveAndCreateCardRespectWIPLimits(t *testing.T) {
+	t.Parallel()
+
+	// The store counts the cards of the status under its project lock and
+	// refuses unforced writes over the limit; the service reports that as a
+	// conflict.
+	var forced []bool
+	refuse := func(force bool) error {
+		forced = append(forced, force)
+		if force {
+			return nil
+		}
+		return fmt.Errorf("write card: %w", &model.WIPLimitError{Status: "Doing", Limit: 2})
+	}
+	svc := newNoopService(&markdownStoreStub{
+		getProjectFn: func(slug string) (model.Project, error) {
+			return model.Project{Slug: slug, Statuses: model.DefaultStatuses, WIPLimits: map[string]int{"Doing": 2}}, nil
+		},
+		getCardFn: func(projectSlug string, number int) (model.Card, error) {
+			return model.Card{ID: fmt.Sprintf("%s/card-%d", projectSlug, number), ProjectSlug: projectSlug, Number: number, Status: "Todo"}, nil
+		},
+		moveCardFn: func(projectSlug string, number int, status string, _ model.CardPosition, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: status}, refuse(force)
+		},
+		createCardFn: func(projectSlug, _, _, _, status, _ string, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: 9, Status: status}, refuse(force)
+		},
+		updateCardFn: func(projectSlug string, number int, patch model.CardPatch, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: *patch.Status}, refuse(force)
+		},
+	}, &projectionStub{
+		upsertProjectFn: func(_ model.Project) error { return nil },
+		upsertCardFn:    func(_ model.Card) error { return nil },
+	}, &publisherStub{})
+
+	_, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+	require.Equal(t, CodeConflict, CodeOf(err))
+	require.EqualError(t, err, "status Doing is at its WIP limit of 2; force the move to exceed it")
+	_, err = svc.CreateCard("alpha", "t", "", "", "Doing", "", false)
+	require.Equal(t, CodeConflict, CodeOf(err))
+	doing := "Doing"
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{Status: &doing}, false, 0)
+	require.Equal(t, CodeConflict, CodeOf(err))
+
+	_, err = svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	_, err = svc.CreateCard("alpha", "t", "", "", "Doing", "", true)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, false, true, true}, forced)
+	require.Equal(t, map[string]int{"Doing": 2}, svc.WIPLimits("alpha"))
+}
+
+func TestMoveCardEnforcesTransitionRulesUnlessForced(t *testing.T) {
+	t.Parallel()
+
+	// The store evaluates the rules under the project lock; the service
+	// reports a refused move as a validation error.
+	var forced []bool
+	refuse := func(force bool) error {
+		forced = append(forced, force)
+		if force {
+			return nil
+		}
+		return &model.TransitionError{Number: 1, From: "Review", To: "Done", Unmet: []string{"1 of 2 acceptance criteria open", "no branch set"}}
+	}
+	svc := newNoopService(&markdownStoreStub{
+		moveCardFn: func(projectSlug string, number int, status string, _ model.CardPosition, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: status}, refuse(force)
+		},
+		updateCardFn: func(projectSlug string, number int, _ model.CardPatch, force bool) (model.Card, error) {
+			return model.Card{ProjectSlug: projectSlug, Number: number, Status: "Done"}, refuse(force)
+		},
+	}, &projectionStub{
+		upsertCardFn: func(_ model.Card) error { return nil },
+	}, &publisherStub{})
+
+	_, err := svc.MoveCard("alpha", 1, "Done", model.CardPosition{}, false, 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+	require.EqualError(t, err, "card 1 cannot move from Review to Done: 1 of 2 acceptance criteria open; no branch set; force the move to override")
+	done := "Done"
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{Status: &done}, false, 0)
+	require.Equal(t, CodeValidation, CodeOf(err))
+
+	_, err = svc.MoveCard("alpha", 1, "Done", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	_, err = svc.UpdateCard("alpha", 1, model.CardPatch{Status: &done}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, true, true}, forced)
+}
+
+func TestCardRelationChangesSyncBothCards(t *testing.T) {
+	t.Parallel()
+
+	publisher := &publisherStub{}
+	var upserted []string
+	svc := newNoopService(&markdownStoreStub{
+		addRelationFn: func(_ string, _ int, relationType, targetID string) (model.Card, model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Relations: []model.CardRelation{{Type: relationType, CardID: targetID}}},
+				model.Card{ID: targetID, ProjectSlug: "beta", Number: 4}, nil
+		},
+		removeRelationFn: func(_ string, _ int, _, _ string) (model.Card, model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1}, model.Card{}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(card model.Card) error {
+			upserted = append(upserted, card.ID)
+			return nil
+		},
+	}, publisher)
+
+	card, err := svc.AddCardRelation("alpha", 1, model.RelationBlocks, "beta/card-4", 0)
+	require.NoError(t, err)
+	require.Len(t, card.Relations, 1)
+	require.Equal(t, []string{"alpha/card-1", "beta/card-4"}, upserted)
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeCardRelationAdded, publisher.events[1].Type)
+	require.Equal(t, "beta", publisher.events[1].Project)
+
+	// The other card may be gone, e.g. with its project in the trash.
+	card, err = svc.RemoveCardRelation("alpha", 1, model.RelationBlocks, "beta/card-4", 0)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardRelation{}, card.Relations)
+	require.Len(t, upserted, 3)
+	require.Len(t, publisher.events, 3)
+	require.Equal(t, model.EventTypeCardRelationRemoved, publisher.events[2].Type)
+}
+
+func TestCardTreeExpandsChildrenAndSkipsCycles(t *testing.T) {
+	t.Parallel()
+
+	children := map[string][]model.CardSummary{
+		"alpha/card-1": {{ID: "alpha/card-2", ChildrenTotal: 1}, {ID: "beta/card-1"}},
+		"alpha/card-2": {{ID: "alpha/card-3", ChildrenTotal: 1}},
+		// A hand edit pointed card 3 back at the root.
+		"alpha/card-3": {{ID: "alpha/card-1"}},
+	}
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(projectSlug string, number int) (model.Card, error) {
+			if number != 1 {
+				return model.Card{}, os.ErrNotExist
+			}
+			return model.Card{ID: "alpha/card-1", ProjectSlug: projectSlug, Number: number}, nil
+		},
+	}, &projectionStub{
+		getCardSummaryFn: func(cardID string) (model.CardSummary, error) {
+			return model.CardSummary{ID: cardID, ChildrenDone: 1, ChildrenTotal: 2}, nil
+		},
+		listChildCardsFn: func(parentID string) ([]model.CardSummary, error) {
+			return children[parentID], nil
+		},
+	}, &publisherStub{})
+
+	tree, err := svc.CardTree("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, "alpha/card-1", tree.ID)
+	require.Equal(t, 2, tree.ChildrenTotal)
+	require.Len(t, tree.Children, 2)
+	require.Equal(t, "alpha/card-2", tree.Children[0].ID)
+	require.Len(t, tree.Children[0].Children, 1)
+	require.Empty(t, tree.Children[0].Children[0].Children)
+	require.Equal(t, []model.CardTreeNode{}, tree.Children[1].Children)
+
+	_, err = svc.CardTree("alpha", 9)
+	require.Equal(t, CodeNotFound, CodeOf(err))
+}
+
+func TestCardAttachmentsSyncProjectionAndPublish(t *testing.T) {
+	t.Parallel()
+
+	publisher := &publisherStub{}
+	upserts := 0
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(projectSlug string, number int) (model.Card, error) {
+			return model.Card{ID: "alpha/card-1", ProjectSlug: projectSlug, Number: number}, nil
+		},
+		addAttachmentFn: func(_ string, _ int, filename, contentType string, data []byte) (model.Attachment, error) {
+			return model.Attachment{Filename: filename, ContentType: contentType, Size: int64(len(data))}, nil
+		},
+		readAttachmentFn: func(_ string, _ int, _ string) (model.Attachment, []byte, error) {
+			return model.Attachment{}, nil, os.ErrNotExist
+		},
+		deleteAttachmentFn: func(_ string, _ int, filename string) (model.Attachment, error) {
+			return model.Attachment{Filename: filename}, nil
+		},
+	}, &projectionStub{
+		upsertCardFn: func(model.Card) error {
+			upserts++
+			return nil
+		},
+	}, publisher)
+
+	attachment, _, err := svc.AddAttachment("alpha", 1, "build.log", "text/plain", []byte("ok"), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), attachment.Size)
+	_, _, err = svc.DeleteAttachment("alpha", 1, "build.log", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, upserts)
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, model.EventTypeCardAttachmentAdded, publisher.events[0].Type)
+	require.Equal(t, model.EventTypeCardAttachmentDeleted, publisher.events[1].Type)
+
+	attachments, err := svc.ListAttachments("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, []model.Attachment{}, attachments)
+	_, _, err = svc.ReadAttachment("alpha", 1, "missing.log")
+	require.Equal(t, CodeNotFound, CodeOf(err))
+}
+
 func TestDeleteCardProjectionFailureReturnsInternal(t *testing.T) {
 	t.Parallel()
 
@@ -605,11 +1503,11 @@ func TestDeleteCardProjectionFailureReturnsInternal(t *testing.T) {
 		},
 	}, &publisherStub{})
 
-	_, err := svc.DeleteCard("alpha", 1, false)
+	_, err := svc.DeleteCard("alpha", 1, false, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeInternal, CodeOf(err))
 
-	_, err = svc.DeleteCard("alpha", 1, true)
+	_, err = svc.DeleteCard("alpha", 1, true, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeInternal, CodeOf(err))
 }
@@ -712,42 +1610,42 @@ func TestCreateCardAdditionalErrorPaths(t *testing.T) {
 
 	t.Run("project not found maps validation", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			createCardFn: func(_, _, _, _, _ string) (model.Card, error) { return model.Card{}, os.ErrNotExist },
+			createCardFn: func(_, _, _, _, _, _ string, _ bool) (model.Card, error) { return model.Card{}, os.ErrNotExist },
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.CreateCard("alpha", "t", "", "", "Todo")
+		_, err := svc.CreateCard("alpha", "t", "", "", "Todo", "", false)
 		require.Error(t, err)
 		require.Equal(t, CodeValidation, CodeOf(err))
 	})
 
 	t.Run("get project failure maps internal", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			createCardFn: func(_, _, _, _, _ string) (model.Card, error) {
+			createCardFn: func(_, _, _, _, _, _ string, _ bool) (model.Card, error) {
 				return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1}, nil
 			},
 			getProjectFn: func(_ string) (model.Project, error) { return model.Project{}, errors.New("boom") },
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.CreateCard("alpha", "t", "", "", "Todo")
+		_, err := svc.CreateCard("alpha", "t", "", "", "Todo", "", false)
 		require.Error(t, err)
 		require.Equal(t, CodeInternal, CodeOf(err))
 	})
 
 	t.Run("project projection failure maps internal", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			createCardFn: func(_, _, _, _, _ string) (model.Card, error) {
+			createCardFn: func(_, _, _, _, _, _ string, _ bool) (model.Card, error) {
 				return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1}, nil
 			},
 			getProjectFn: func(_ string) (model.Project, error) { return model.Project{Slug: "alpha"}, nil },
 		}, &projectionStub{
 			upsertProjectFn: func(_ model.Project) error { return errors.New("boom") },
 		}, &publisherStub{})
-		_, err := svc.CreateCard("alpha", "t", "", "", "Todo")
+		_, err := svc.CreateCard("alpha", "t", "", "", "Todo", "", false)
 		require.Error(t, err)
 		require.Equal(t, CodeInternal, CodeOf(err))
 	})
 
 	t.Run("card projection failure maps internal", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			createCardFn: func(_, _, _, _, _ string) (model.Card, error) {
+			createCardFn: func(_, _, _, _, _, _ string, _ bool) (model.Card, error) {
 				return model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1}, nil
 			},
 			getProjectFn: func(_ string) (model.Project, error) { return model.Project{Slug: "alpha"}, nil },
@@ -755,7 +1653,7 @@ func TestCreateCardAdditionalErrorPaths(t *testing.T) {
 			upsertProjectFn: func(_ model.Project) error { return nil },
 			upsertCardFn:    func(_ model.Card) error { return errors.New("boom") },
 		}, &publisherStub{})
-		_, err := svc.CreateCard("alpha", "t", "", "", "Todo")
+		_, err := svc.CreateCard("alpha", "t", "", "", "Todo", "", false)
 		require.Error(t, err)
 		require.Equal(t, CodeInternal, CodeOf(err))
 	})
@@ -766,11 +1664,11 @@ func TestListAndGetCardPaths(t *testing.T) {
 
 	t.Run("list cards success", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{}, &projectionStub{
-			listCardsFn: func(_ string, _ bool) ([]model.CardSummary, error) {
+			listCardsFn: func(_ string, _ model.CardListOptions) ([]model.CardSummary, error) {
 				return []model.CardSummary{{ID: "alpha/card-1"}}, nil
 			},
 		}, &publisherStub{})
-		cards, err := svc.ListCards("alpha", false)
+		cards, err := svc.ListCards("alpha", model.CardListOptions{})
 		require.NoError(t, err)
 		require.Len(t, cards, 1)
 	})
@@ -790,18 +1688,22 @@ func TestMoveCommentAppendValidationAndNotFound(t *testing.T) {
 
 	t.Run("move not found", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			moveCardFn: func(_ string, _ int, _ string) (model.Card, error) { return model.Card{}, os.ErrNotExist },
+			moveCardFn: func(_ string, _ int, _ string, _ model.CardPosition, _ bool) (model.Card, error) {
+				return model.Card{}, os.ErrNotExist
+			},
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.MoveCard("alpha", 1, "Doing")
+		_, err := svc.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
 		require.Error(t, err)
 		require.Equal(t, CodeNotFound, CodeOf(err))
 	})
 
 	t.Run("move validation", func(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
-			moveCardFn: func(_ string, _ int, _ string) (model.Card, error) { return model.Card{}, errors.New("bad status") },
+			moveCardFn: func(_ string, _ int, _ string, _ model.CardPosition, _ bool) (model.Card, error) {
+				return model.Card{}, errors.New("bad status")
+			},
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.MoveCard("alpha", 1, "Nope")
+		_, err := svc.MoveCard("alpha", 1, "Nope", model.CardPosition{}, false, 0)
 		require.Error(t, err)
 		require.Equal(t, CodeValidation, CodeOf(err))
 	})
@@ -810,7 +1712,7 @@ func TestMoveCommentAppendValidationAndNotFound(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
 			addCommentFn: func(_ string, _ int, _ string) (model.Card, error) { return model.Card{}, os.ErrNotExist },
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.CommentCard("alpha", 1, "x")
+		_, err := svc.CommentCard("alpha", 1, "x", 0)
 		require.Error(t, err)
 		require.Equal(t, CodeNotFound, CodeOf(err))
 	})
@@ -823,7 +1725,7 @@ func TestMoveCommentAppendValidationAndNotFound(t *testing.T) {
 		}, &projectionStub{
 			upsertCardFn: func(_ model.Card) error { return errors.New("boom") },
 		}, &publisherStub{})
-		_, err := svc.CommentCard("alpha", 1, "x")
+		_, err := svc.CommentCard("alpha", 1, "x", 0)
 		require.Error(t, err)
 		require.Equal(t, CodeInternal, CodeOf(err))
 	})
@@ -832,7 +1734,7 @@ func TestMoveCommentAppendValidationAndNotFound(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
 			appendDescriptionFn: func(_ string, _ int, _ string) (model.Card, error) { return model.Card{}, os.ErrNotExist },
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.AppendDescription("alpha", 1, "x")
+		_, err := svc.AppendDescription("alpha", 1, "x", 0)
 		require.Error(t, err)
 		require.Equal(t, CodeNotFound, CodeOf(err))
 	})
@@ -841,7 +1743,7 @@ func TestMoveCommentAppendValidationAndNotFound(t *testing.T) {
 		svc := newNoopService(&markdownStoreStub{
 			appendDescriptionFn: func(_ string, _ int, _ string) (model.Card, error) { return model.Card{}, errors.New("bad body") },
 		}, &projectionStub{}, &publisherStub{})
-		_, err := svc.AppendDescription("alpha", 1, "x")
+		_, err := svc.AppendDescription("alpha", 1, "x", 0)
 		require.Error(t, err)
 		require.Equal(t, CodeValidation, CodeOf(err))
 	})
@@ -853,14 +1755,14 @@ func TestDeleteCardValidationAndNotFound(t *testing.T) {
 	svc := newNoopService(&markdownStoreStub{
 		deleteCardFn: func(_ string, _ int, _ bool) (model.Card, error) { return model.Card{}, os.ErrNotExist },
 	}, &projectionStub{}, &publisherStub{})
-	_, err := svc.DeleteCard("alpha", 1, false)
+	_, err := svc.DeleteCard("alpha", 1, false, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeNotFound, CodeOf(err))
 
 	svc = newNoopService(&markdownStoreStub{
 		deleteCardFn: func(_ string, _ int, _ bool) (model.Card, error) { return model.Card{}, errors.New("bad delete") },
 	}, &projectionStub{}, &publisherStub{})
-	_, err = svc.DeleteCard("alpha", 1, false)
+	_, err = svc.DeleteCard("alpha", 1, false, 0)
 	require.Error(t, err)
 	require.Equal(t, CodeValidation, CodeOf(err))
 }
@@ -877,3 +1779,57 @@ func TestPublishNoPublisherAndTrimmedProject(t *testing.T) {
 	require.Len(t, publisher.events, 1)
 	require.Equal(t, "alpha", publisher.events[0].Project)
 }
+
+func TestIngestCardUpsertsProjectionAndPublishesUpdate(t *testing.T) {
+	t.Parallel()
+
+	card := model.Card{ID: "alpha/card-3", ProjectSlug: "alpha", Number: 3, Title: "Edited"}
+	publisher := &publisherStub{}
+	var upserted model.Card
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(_ string, _ int) (model.Card, error) { return card, nil },
+	}, &projectionStub{
+		upsertCardFn: func(input model.Card) error {
+			upserted = input
+			return nil
+		},
+	}, publisher)
+
+	require.NoError(t, svc.IngestCard("alpha", 3))
+	require.Equal(t, "Edited", upserted.Title)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeCardUpdated, publisher.events[0].Type)
+	require.Equal(t, "alpha/card-3", publisher.events[0].CardID)
+}
+
+func TestIngestCardParseFailureIsValidationAndSilent(t *testing.T) {
+	t.Parallel()
+
+	publisher := &publisherStub{}
+	svc := newNoopService(&markdownStoreStub{
+		getCardFn: func(_ string, _ int) (model.Card, error) { return model.Card{}, errors.New("invalid frontmatter") },
+	}, &projectionStub{}, publisher)
+
+	err := svc.IngestCard("alpha", 3)
+	require.Error(t, err)
+	require.Equal(t, CodeValidation, CodeOf(err))
+	require.Len(t, publisher.events, 0)
+}
+
+func TestIngestCardRemovalDeletesFromProjection(t *testing.T) {
+	t.Parallel()
+
+	publisher := &publisherStub{}
+	var deleted string
+	svc := newNoopService(&markdownStoreStub{}, &projectionStub{
+		hardDeleteCardFn: func(slug string, number int) error {
+			deleted = fmt.Sprintf("%s/%d", slug, number)
+			return nil
+		},
+	}, publisher)
+
+	require.NoError(t, svc.IngestCardRemoval("alpha", 2))
+	require.Equal(t, "alpha/2", deleted)
+	require.Len(t, publisher.events, 1)
+	require.Equal(t, model.EventTypeCardDeletedHard, publisher.events[0].Type)
+}
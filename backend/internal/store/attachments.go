@@ -0,0 +1,235 @@
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+type attachmentFrontmatter struct {
+	Filename    string    `yaml:"filename"`
+	Size        int64     `yaml:"size"`
+	ContentType string    `yaml:"content_type"`
+	SHA256      string    `yaml:"sha256"`
+	UploadedAt  time.Time `yaml:"uploaded_at"`
+}
+
+// AddAttachment stores a blob under the card's attachment directory and lists
+// it in the card frontmatter. Uploading a filename that already exists
+// replaces that attachment.
+func (s *MarkdownStore) AddAttachment(projectSlug string, number int, filename, contentType string, data []byte, expectedRevision int) (_ model.Attachment, _ model.Card, err error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	defer unlock()
+
+	filename, err = validateAttachmentFilename(filename)
+	if err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	contentType = strings.TrimSpace(contentType)
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = http.DetectContentType(data)
+	}
+	sum := sha256.Sum256(data)
+	now := time.Now().UTC()
+	attachment := model.Attachment{
+		Filename:    filename,
+		Size:        int64(len(data)),
+		ContentType: contentType,
+		SHA256:      hex.EncodeToString(sum[:]),
+		UploadedAt:  now,
+	}
+
+	tx := s.beginJournal("card.attachment.add")
+	defer tx.end(&err)
+	dir := s.attachmentsDir(projectSlug, number)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	if err := tx.writeBlob(filepath.Join(dir, filename), data); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+
+	historyType := "card.attachment.added"
+	if idx := indexOfAttachment(card.Attachments, filename); idx >= 0 {
+		card.Attachments[idx] = attachment
+		historyType = "card.attachment.replaced"
+	} else {
+		card.Attachments = append(card.Attachments, attachment)
+	}
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      historyType,
+		Details:   fmt.Sprintf("%s (%d bytes)", filename, attachment.Size),
+	})
+	if err := tx.writeCard(&card); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	return attachment, card, nil
+}
+
+// ReadAttachment returns an attachment's metadata and content. A filename the
+// card does not list yields os.ErrNotExist.
+func (s *MarkdownStore) ReadAttachment(projectSlug string, number int, filename string) (model.Attachment, []byte, error) {
+	defer s.rlockProject(projectSlug)()
+
+	card, err := s.getCardUnlocked(projectSlug, number)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	idx := indexOfAttachment(card.Attachments, strings.TrimSpace(filename))
+	if idx < 0 {
+		return model.Attachment{}, nil, os.ErrNotExist
+	}
+	attachment := card.Attachments[idx]
+	data, err := os.ReadFile(filepath.Join(s.attachmentsDir(projectSlug, number), attachment.Filename))
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	return attachment, data, nil
+}
+
+// DeleteAttachment removes an attachment's blob and its frontmatter entry.
+func (s *MarkdownStore) DeleteAttachment(projectSlug string, number int, filename string, expectedRevision int) (_ model.Attachment, _ model.Card, err error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	defer unlock()
+
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	idx := indexOfAttachment(card.Attachments, strings.TrimSpace(filename))
+	if idx < 0 {
+		return model.Attachment{}, model.Card{}, os.ErrNotExist
+	}
+	attachment := card.Attachments[idx]
+	tx := s.beginJournal("card.attachment.delete")
+	defer tx.end(&err)
+	dir := s.attachmentsDir(projectSlug, number)
+	if err := tx.remove(filepath.Join(dir, attachment.Filename)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return model.Attachment{}, model.Card{}, err
+	}
+
+	now := time.Now().UTC()
+	card.Attachments = slices.Delete(card.Attachments, idx, idx+1)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.attachment.deleted",
+		Details:   attachment.Filename,
+	})
+	if err := tx.writeCard(&card); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Attachment{}, model.Card{}, err
+	}
+	// Drop the card's directory once it is empty; a leftover file keeps it.
+	_ = os.Remove(dir)
+	return attachment, card, nil
+}
+
+// moveAttachmentsUnlocked re-files a transferred card's blobs under its new
+// number.
+func (s *MarkdownStore) moveAttachmentsUnlocked(tx *journalTx, from, to model.Card) error {
+	src := s.attachmentsDir(from.ProjectSlug, from.Number)
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return tx.rename(src, s.attachmentsDir(to.ProjectSlug, to.Number))
+}
+
+func (s *MarkdownStore) attachmentsDir(projectSlug string, number int) string {
+	return filepath.Join(s.projectDir(projectSlug), "attachments", fmt.Sprintf("card-%d", number))
+}
+
+// validateAttachmentFilename keeps uploads inside the card's attachment
+// directory: no path separators, no dot files.
+func validateAttachmentFilename(filename string) (string, error) {
+	filename = strings.TrimSpace(filename)
+	if filename == "" {
+		return "", errors.New("attachment filename is required")
+	}
+	if len(filename) > 255 || strings.ContainsAny(filename, `/\`) || strings.HasPrefix(filename, ".") || strings.ContainsRune(filename, 0) {
+		return "", fmt.Errorf("invalid attachment filename %q", filename)
+	}
+	return filename, nil
+}
+
+func indexOfAttachment(attachments []model.Attachment, filename string) int {
+	return slices.IndexFunc(attachments, func(a model.Attachment) bool { return a.Filename == filename })
+}
+
+func attachmentsToFrontmatter(attachments []model.Attachment) []attachmentFrontmatter {
+	if len(attachments) == 0 {
+		return nil
+	}
+	out := make([]attachmentFrontmatter, 0, len(attachments))
+	for _, a := range attachments {
+		out = append(out, attachmentFrontmatter{Filename: a.Filename, Size: a.Size, ContentType: a.ContentType, SHA256: a.SHA256, UploadedAt: a.UploadedAt})
+	}
+	return out
+}
+
+// attachmentsFromFrontmatter drops entries whose filename the store would not
+// accept on upload, so a hand-edited name like ../../etc/passwd never reaches
+// a blob path. Doctor reports the dropped entries.
+func attachmentsFromFrontmatter(attachments []attachmentFrontmatter) []model.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	out := make([]model.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		filename, err := validateAttachmentFilename(a.Filename)
+		if err != nil || indexOfAttachment(out, filename) >= 0 {
+			continue
+		}
+		out = append(out, model.Attachment{Filename: filename, Size: a.Size, ContentType: a.ContentType, SHA256: a.SHA256, UploadedAt: a.UploadedAt})
+	}
+	return out
+}
+
+// invalidAttachmentFilenames returns the attachment filenames in a card file's
+// frontmatter that attachmentsFromFrontmatter drops.
+func invalidAttachmentFilenames(data []byte) ([]string, error) {
+	yml, _, err := splitFrontmatter(data)
+	if err != nil {
+		return nil, err
+	}
+	var fm struct {
+		Attachments []attachmentFrontmatter `yaml:"attachments"`
+	}
+	if err := yaml.Unmarshal(yml, &fm); err != nil {
+		return nil, err
+	}
+	var invalid []string
+	for _, a := range fm.Attachments {
+		if _, err := validateAttachmentFilename(a.Filename); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%q", a.Filename))
+		}
+	}
+	return invalid, nil
+}
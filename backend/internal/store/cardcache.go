@@ -0,0 +1,154 @@
+package store
+
+import (
+	"container/list"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// cardCacheSize bounds the number of parsed cards kept in memory.
+const cardCacheSize = 4096
+
+// cardCache keeps recently read cards parsed, so reading a card that has not
+// changed skips the file read and the parse. An entry is valid while the file
+// still has the size and modification time it had when it was read; edits
+// made outside the store change those. The store's own card writes replace
+// the entry with the card they wrote, so the read that usually follows a
+// write skips the parse too. The least recently used card is evicted once the
+// cache is full.
+type cardCache struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]*list.Element
+	order   *list.List // of *cachedCard, most recently used first
+}
+
+type cachedCard struct {
+	path    string
+	size    int64
+	modTime time.Time
+	card    model.Card
+}
+
+func newCardCache(max int) *cardCache {
+	return &cardCache{max: max, entries: map[string]*list.Element{}, order: list.New()}
+}
+
+// get returns the card cached for path if info still describes the file.
+func (c *cardCache) get(path string, info os.FileInfo) (model.Card, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[path]
+	if !ok {
+		return model.Card{}, false
+	}
+	entry := elem.Value.(*cachedCard)
+	if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
+		c.order.Remove(elem)
+		delete(c.entries, path)
+		return model.Card{}, false
+	}
+	c.order.MoveToFront(elem)
+	return cloneCard(entry.card), true
+}
+
+// put caches card as the content of path described by info, which must have
+// been taken before the file was read.
+func (c *cardCache) put(path string, info os.FileInfo, card model.Card) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cachedCard{path: path, size: info.Size(), modTime: info.ModTime(), card: cloneCard(card)}
+	if elem, ok := c.entries[path]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[path] = c.order.PushFront(entry)
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedCard).path)
+	}
+}
+
+func (c *cardCache) forget(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[path]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, path)
+	}
+}
+
+// cloneCard copies the slices of a card so callers can change the card they
+// get without changing the cached one.
+func cloneCard(card model.Card) model.Card {
+	card.Labels = slices.Clone(card.Labels)
+	if card.DueAt != nil {
+		dueAt := *card.DueAt
+		card.DueAt = &dueAt
+	}
+	card.Description = slices.Clone(card.Description)
+	card.Comments = slices.Clone(card.Comments)
+	card.History = slices.Clone(card.History)
+	card.Todos = slices.Clone(card.Todos)
+	card.AcceptanceCriteria = slices.Clone(card.AcceptanceCriteria)
+	card.Relations = slices.Clone(card.Relations)
+	card.Attachments = slices.Clone(card.Attachments)
+	card.ExtraSections = slices.Clone(card.ExtraSections)
+	return card
+}
+
+// storedCard returns card as parsing the file encodeCard writes for it gives
+// it back: entry text trimmed, empty entries dropped, entry timestamps to the
+// second and empty lists nil.
+func storedCard(card model.Card) model.Card {
+	card = cloneCard(card)
+	card.Description = storedTextEvents(card.Description)
+	card.Comments = storedTextEvents(card.Comments)
+	card.Todos = storedEntries(card.Todos, func(t *model.Todo) *string { return &t.Text })
+	card.AcceptanceCriteria = storedEntries(card.AcceptanceCriteria, func(a *model.AcceptanceCriterion) *string { return &a.Text })
+	for i := range card.History {
+		card.History[i].Timestamp = card.History[i].Timestamp.UTC().Truncate(time.Second)
+		card.History[i].Details = strings.TrimSpace(card.History[i].Details)
+	}
+	card.History = nilIfEmpty(card.History)
+	card.Labels = nilIfEmpty(card.Labels)
+	card.Relations = nilIfEmpty(card.Relations)
+	card.Attachments = nilIfEmpty(card.Attachments)
+	card.ExtraSections = nilIfEmpty(card.ExtraSections)
+	return card
+}
+
+func storedTextEvents(events []model.TextEvent) []model.TextEvent {
+	for i := range events {
+		events[i].Timestamp = events[i].Timestamp.UTC().Truncate(time.Second)
+	}
+	return storedEntries(events, func(e *model.TextEvent) *string { return &e.Body })
+}
+
+// storedEntries trims the text of each entry and drops the ones left empty,
+// which a card file cannot tell apart from no entry.
+func storedEntries[T any](entries []T, text func(*T) *string) []T {
+	for i := range entries {
+		t := text(&entries[i])
+		*t = strings.TrimSpace(*t)
+	}
+	entries = slices.DeleteFunc(entries, func(entry T) bool { return *text(&entry) == "" })
+	return nilIfEmpty(entries)
+}
+
+func nilIfEmpty[S ~[]E, E any](s S) S {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
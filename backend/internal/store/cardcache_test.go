@@ -0,0 +1,236 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardCacheReturnsCopies(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddTodo("alpha", 1, "first", 0)
+	require.NoError(t, err)
+
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	card.Todos[0].Text = "changed"
+	card.History = append(card.History, model.HistoryEvent{Type: "changed"})
+
+	again, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, "first", again.Todos[0].Text)
+	require.Len(t, again.History, len(card.History)-1)
+}
+
+func TestCardCacheSeesWritesAndOutsideEdits(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, err = s.GetCard("alpha", 1)
+	require.NoError(t, err)
+
+	_, err = s.AddComment("alpha", 1, "from the store", 0)
+	require.NoError(t, err)
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Len(t, card.Comments, 1)
+
+	// An outside edit of the same size, given a later modification time the
+	// way an editor would.
+	path := s.cardPath("alpha", 1)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	edited := strings.Replace(string(data), "title: Task", "title: Edit", 1)
+	require.Len(t, edited, len(data))
+	require.NoError(t, os.WriteFile(path, []byte(edited), 0o644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+	card, err = s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, "Edit", card.Title)
+
+	require.NoError(t, os.Remove(path))
+	_, err = s.GetCard("alpha", 1)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCardCacheSeededByWritesMatchesFile(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("beta", "Other", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	// Each write seeds the cache; the cached card must be what a fresh read
+	// of the file gives.
+	requireCachedMatchesFile := func(step string) {
+		t.Helper()
+		path := s.cardPath("alpha", 1)
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		cached, ok := s.cards.get(path, info)
+		require.True(t, ok, step)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		parsed, err := parseCard(data)
+		require.NoError(t, err)
+		require.Equal(t, parsed, cached, step)
+	}
+	due := time.Now().Add(48 * time.Hour)
+	title := "  Renamed  "
+	steps := []struct {
+		name  string
+		write func() error
+	}{
+		{"create", func() error {
+			_, err := s.CreateCard("alpha", "Task", "  first line\n", "", "Todo", "", false)
+			return err
+		}},
+		{"describe", func() error { _, err := s.AppendDescription("alpha", 1, "\n# not a heading\n", 0); return err }},
+		{"comment", func() error { _, err := s.AddComment("alpha", 1, " (none) ", 0); return err }},
+		{"todo", func() error { _, _, err := s.AddTodo("alpha", 1, "todo  ", 0); return err }},
+		{"criterion", func() error { _, _, err := s.AddAcceptanceCriterion("alpha", 1, "works", 0); return err }},
+		{"label", func() error { _, err := s.AddLabel("alpha", 1, "Bug", 0); return err }},
+		{"unlabel", func() error { _, err := s.RemoveLabel("alpha", 1, "bug", 0); return err }},
+		{"priority", func() error { _, err := s.SetCardPriority("alpha", 1, "p1", 0); return err }},
+		{"due", func() error { _, err := s.SetCardDue("alpha", 1, &due, 0); return err }},
+		{"relate", func() error { _, _, err := s.AddRelation("alpha", 1, "blocks", "beta/card-1", 0); return err }},
+		{"attach", func() error { _, _, err := s.AddAttachment("alpha", 1, "a.txt", "", []byte("a"), 0); return err }},
+		{"update", func() error { _, err := s.UpdateCard("alpha", 1, model.CardPatch{Title: &title}, false, 0); return err }},
+		{"move", func() error { _, err := s.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0); return err }},
+		{"delete", func() error { _, err := s.DeleteCard("alpha", 1, false, 0); return err }},
+		{"restore", func() error { _, err := s.RestoreCard("alpha", 1, 0); return err }},
+	}
+	for _, step := range steps {
+		require.NoError(t, step.write(), step.name)
+		requireCachedMatchesFile(step.name)
+	}
+}
+
+func TestCardCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	cache := newCardCache(2)
+	info := func(name string) os.FileInfo {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		return fi
+	}
+	a, b, c := info("a"), info("b"), info("c")
+	cache.put("a", a, model.Card{Title: "a"})
+	cache.put("b", b, model.Card{Title: "b"})
+	_, ok := cache.get("a", a)
+	require.True(t, ok)
+	cache.put("c", c, model.Card{Title: "c"})
+
+	_, ok = cache.get("b", b)
+	require.False(t, ok)
+	card, ok := cache.get("a", a)
+	require.True(t, ok)
+	require.Equal(t, "a", card.Title)
+	_, ok = cache.get("c", c)
+	require.True(t, ok)
+}
+
+// benchmarkStore returns a store with one project of long-lived cards, each
+// with a history and a few comments and todos.
+func benchmarkStore(b *testing.B, cards int) *MarkdownStore {
+	b.Helper()
+	s, err := NewMarkdownStore(b.TempDir())
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = s.Close() })
+	_, err = s.CreateProject("Bench", "", "")
+	require.NoError(b, err)
+	for i := range cards {
+		card, err := s.CreateCard("bench", fmt.Sprintf("Card %d", i), strings.Repeat("Some description. ", 20), "", "Todo", "", false)
+		require.NoError(b, err)
+		for j := range 5 {
+			_, err = s.AddComment("bench", card.Number, fmt.Sprintf("Comment %d with a few words in it.", j), 0)
+			require.NoError(b, err)
+			_, _, err = s.AddTodo("bench", card.Number, fmt.Sprintf("Todo %d", j), 0)
+			require.NoError(b, err)
+		}
+	}
+	return s
+}
+
+// cacheVariants runs a benchmark with the card cache and without it, which
+// is how every read worked before the cache.
+var cacheVariants = []struct {
+	name string
+	size int
+}{
+	{"Cached", cardCacheSize},
+	{"Uncached", 0},
+}
+
+func BenchmarkGetCard(b *testing.B) {
+	for _, variant := range cacheVariants {
+		b.Run(variant.name, func(b *testing.B) {
+			s := benchmarkStore(b, 1)
+			s.cards = newCardCache(variant.size)
+			for b.Loop() {
+				if _, err := s.GetCard("bench", 1); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSnapshot(b *testing.B) {
+	for _, variant := range cacheVariants {
+		b.Run(variant.name, func(b *testing.B) {
+			s := benchmarkStore(b, 200)
+			s.cards = newCardCache(variant.size)
+			for b.Loop() {
+				if _, _, err := s.Snapshot(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkWriteThenGetCard(b *testing.B) {
+	for _, variant := range cacheVariants {
+		b.Run(variant.name, func(b *testing.B) {
+			s := benchmarkStore(b, 1)
+			s.cards = newCardCache(variant.size)
+			for b.Loop() {
+				// Clients read the card back when they see the change
+				// event a write publishes.
+				if _, err := s.AddLabel("bench", 1, "bench", 0); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := s.GetCard("bench", 1); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := s.RemoveLabel("bench", 1, "bench", 0); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := s.GetCard("bench", 1); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// Kinds of problem Doctor reports.
+const (
+	DoctorParseError           = "parse_error"
+	DoctorMissingProject       = "missing_project"
+	DoctorSlugMismatch         = "slug_mismatch"
+	DoctorCardMismatch         = "card_mismatch"
+	DoctorNextCardSeq          = "next_card_seq"
+	DoctorDuplicateTodoID      = "duplicate_todo_id"
+	DoctorDuplicateCriterionID = "duplicate_acceptance_criterion_id"
+	DoctorInvalidAttachment    = "invalid_attachment"
+	DoctorTempFile             = "temp_file"
+)
+
+// tempFileGrace is how old a temp file must be before Doctor treats it as left
+// behind, so a write in progress in another process is not reported.
+const tempFileGrace = time.Minute
+
+// DoctorIssue is one problem found in the data directory. Path is relative to
+// the data directory; Repair says what --fix does (or did) about it.
+type DoctorIssue struct {
+	Path   string `json:"path"`
+	Kind   string `json:"kind"`
+	Detail string `json:"detail"`
+	Repair string `json:"repair"`
+	Fixed  bool   `json:"fixed"`
+}
+
+// DoctorReport is the outcome of a Doctor run.
+type DoctorReport struct {
+	ProjectsChecked int           `json:"projects_checked"`
+	CardsChecked    int           `json:"cards_checked"`
+	Issues          []DoctorIssue `json:"issues"`
+}
+
+// Unfixed counts the issues left in place.
+func (r DoctorReport) Unfixed() int {
+	n := 0
+	for _, issue := range r.Issues {
+		if !issue.Fixed {
+			n++
+		}
+	}
+	return n
+}
+
+type doctorFinding struct {
+	issue  DoctorIssue
+	repair func() error
+}
+
+// Doctor checks every project.md and card-N.md under the data directory and,
+// with fix, repairs what it safely can. Files that cannot be parsed are moved
+// to the quarantine directory rather than deleted.
+func (s *MarkdownStore) Doctor(fix bool) (DoctorReport, error) {
+	if fix && s.readOnly {
+		return DoctorReport{}, ErrReadOnly
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report, findings, err := s.doctorScan(time.Now().UTC())
+	if err != nil {
+		return DoctorReport{}, err
+	}
+	if fix {
+		for i, finding := range findings {
+			if err := finding.repair(); err != nil {
+				return report, fmt.Errorf("repair %s: %w", finding.issue.Path, err)
+			}
+			report.Issues[i].Fixed = true
+		}
+	}
+	return report, nil
+}
+
+// QuarantineUnreadable moves project directories and card files the store
+// cannot load to the quarantine directory, so one broken file does not keep
+// the rest of the data from loading. The moved files are returned.
+func (s *MarkdownStore) QuarantineUnreadable() ([]DoctorIssue, error) {
+	unlock, err := s.lockStore()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	_, findings, err := s.doctorScan(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	var quarantined []DoctorIssue
+	for _, finding := range findings {
+		if finding.issue.Kind != DoctorParseError && finding.issue.Kind != DoctorMissingProject {
+			continue
+		}
+		if err := finding.repair(); err != nil {
+			return quarantined, fmt.Errorf("quarantine %s: %w", finding.issue.Path, err)
+		}
+		finding.issue.Fixed = true
+		quarantined = append(quarantined, finding.issue)
+	}
+	return quarantined, nil
+}
+
+// doctorScan collects the problems in the data directory without changing
+// anything. Callers hold s.mu.
+func (s *MarkdownStore) doctorScan(now time.Time) (DoctorReport, []doctorFinding, error) {
+	report := DoctorReport{Issues: []DoctorIssue{}}
+	var findings []doctorFinding
+	add := func(path, kind, detail, repair string, fn func() error) {
+		issue := DoctorIssue{Path: s.relativePath(path), Kind: kind, Detail: detail, Repair: repair}
+		report.Issues = append(report.Issues, issue)
+		findings = append(findings, doctorFinding{issue: issue, repair: fn})
+	}
+	quarantine := func(path string) (string, func() error) {
+		dst := filepath.Join(s.dataDir, ".quarantine", now.Format(trashTimeLayout), filepath.FromSlash(s.relativePath(path)))
+		return "move to " + s.relativePath(dst), func() error {
+			if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+				return err
+			}
+			return os.Rename(path, dst)
+		}
+	}
+
+	entries, err := os.ReadDir(s.projectsDir)
+	if err != nil {
+		return DoctorReport{}, nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		slug := entry.Name()
+		dir := s.projectDir(slug)
+		if err := s.scanTempFiles(dir, now, add); err != nil {
+			return DoctorReport{}, nil, err
+		}
+
+		data, err := os.ReadFile(s.projectPath(slug))
+		if errors.Is(err, os.ErrNotExist) {
+			repair, fn := quarantine(dir)
+			add(dir, DoctorMissingProject, "project directory has no project.md", repair, fn)
+			continue
+		}
+		if err != nil {
+			return DoctorReport{}, nil, err
+		}
+		project, err := parseProject(data, slug)
+		if err != nil {
+			repair, fn := quarantine(dir)
+			add(s.projectPath(slug), DoctorParseError, err.Error(), repair, fn)
+			continue
+		}
+		report.ProjectsChecked++
+		if project.Slug != slug {
+			add(s.projectPath(slug), DoctorSlugMismatch, fmt.Sprintf("slug is %q but the directory is %q", project.Slug, slug), "set slug to "+slug, func() error {
+				return s.repairProjectUnlocked(slug, func(p *model.Project) { p.Slug = slug })
+			})
+		}
+
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			return DoctorReport{}, nil, err
+		}
+		highest := 0
+		for _, file := range files {
+			number, ok := cardNumberFromFilename(file.Name())
+			if file.IsDir() || !ok {
+				continue
+			}
+			highest = max(highest, number)
+			path := s.cardPath(slug, number)
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return DoctorReport{}, nil, err
+			}
+			card, err := parseCard(data)
+			if err != nil {
+				repair, fn := quarantine(path)
+				add(path, DoctorParseError, err.Error(), repair, fn)
+				continue
+			}
+			report.CardsChecked++
+			s.scanCard(slug, number, card, now, add)
+			invalid, err := invalidAttachmentFilenames(data)
+			if err != nil {
+				return DoctorReport{}, nil, err
+			}
+			if len(invalid) > 0 {
+				detail := "attachments with invalid filenames are ignored: " + strings.Join(invalid, ", ")
+				add(path, DoctorInvalidAttachment, detail, "remove them from the frontmatter", func() error {
+					return s.repairCardUnlocked(slug, number, now, detail, func(*model.Card) {})
+				})
+			}
+		}
+		if highest >= project.NextCardSeq {
+			add(s.projectPath(slug), DoctorNextCardSeq, fmt.Sprintf("next_card_seq is %d but card-%d.md exists", project.NextCardSeq, highest), fmt.Sprintf("set next_card_seq to %d", highest+1), func() error {
+				return s.repairProjectUnlocked(slug, func(p *model.Project) { p.NextCardSeq = max(p.NextCardSeq, highest+1) })
+			})
+		}
+	}
+	return report, findings, nil
+}
+
+// scanCard checks a parsed card against the file it was read from.
+func (s *MarkdownStore) scanCard(slug string, number int, card model.Card, now time.Time, add func(path, kind, detail, repair string, fn func() error)) {
+	path := s.cardPath(slug, number)
+	id := fmt.Sprintf("%s/card-%d", slug, number)
+	if card.ID != id || card.ProjectSlug != slug || card.Number != number {
+		detail := fmt.Sprintf("frontmatter has id=%s project=%s number=%d", card.ID, card.ProjectSlug, card.Number)
+		add(path, DoctorCardMismatch, detail, "set id, project and number from the filename", func() error {
+			return s.repairCardUnlocked(slug, number, now, detail, func(c *model.Card) {
+				c.ID, c.ProjectSlug, c.Number = id, slug, number
+			})
+		})
+	}
+
+	todoIDs := make([]int, 0, len(card.Todos))
+	for _, todo := range card.Todos {
+		todoIDs = append(todoIDs, todo.ID)
+	}
+	if dups := duplicateIDs(todoIDs); len(dups) > 0 {
+		detail := "todo ids used more than once: " + joinInts(dups)
+		add(path, DoctorDuplicateTodoID, detail, "give the later duplicates new ids", func() error {
+			return s.repairCardUnlocked(slug, number, now, detail, func(c *model.Card) {
+				c.NextTodoID = renumberDuplicates(c.Todos, c.NextTodoID, func(t *model.Todo) *int { return &t.ID })
+			})
+		})
+	}
+	criterionIDs := make([]int, 0, len(card.AcceptanceCriteria))
+	for _, criterion := range card.AcceptanceCriteria {
+		criterionIDs = append(criterionIDs, criterion.ID)
+	}
+	if dups := duplicateIDs(criterionIDs); len(dups) > 0 {
+		detail := "acceptance criterion ids used more than once: " + joinInts(dups)
+		add(path, DoctorDuplicateCriterionID, detail, "give the later duplicates new ids", func() error {
+			return s.repairCardUnlocked(slug, number, now, detail, func(c *model.Card) {
+				c.NextAcceptanceCriterionID = renumberDuplicates(c.AcceptanceCriteria, c.NextAcceptanceCriterionID, func(a *model.AcceptanceCriterion) *int { return &a.ID })
+			})
+		})
+	}
+}
+
+// scanTempFiles reports temp files writeFileAtomic left behind in a project
+// directory or one of its attachment directories.
+func (s *MarkdownStore) scanTempFiles(dir string, now time.Time, add func(path, kind, detail, repair string, fn func() error)) error {
+	dirs := []string{dir}
+	attachmentDirs, err := os.ReadDir(filepath.Join(dir, "attachments"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for _, entry := range attachmentDirs {
+		if entry.IsDir() {
+			dirs = append(dirs, filepath.Join(dir, "attachments", entry.Name()))
+		}
+	}
+	for _, d := range dirs {
+		entries, err := os.ReadDir(d)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), ".tmp-") {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			if now.Sub(info.ModTime()) < tempFileGrace {
+				continue
+			}
+			path := filepath.Join(d, entry.Name())
+			add(path, DoctorTempFile, "left behind by an interrupted write", "delete it", func() error {
+				if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return err
+				}
+				return nil
+			})
+		}
+	}
+	return nil
+}
+
+func (s *MarkdownStore) repairProjectUnlocked(slug string, fn func(*model.Project)) error {
+	project, err := s.loadProject(slug)
+	if err != nil {
+		return err
+	}
+	fn(&project)
+	return s.writeProject(project)
+}
+
+func (s *MarkdownStore) repairCardUnlocked(slug string, number int, now time.Time, detail string, fn func(*model.Card)) error {
+	card, err := s.getCardUnlocked(slug, number)
+	if err != nil {
+		return err
+	}
+	fn(&card)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.repaired",
+		Details:   detail,
+	})
+	return s.writeCard(&card)
+}
+
+// renumberDuplicates keeps the first item with each ID and gives the others
+// fresh IDs from next onwards. It returns the next free ID.
+func renumberDuplicates[T any](items []T, next int, id func(*T) *int) int {
+	for i := range items {
+		next = max(next, *id(&items[i])+1)
+	}
+	seen := map[int]bool{}
+	for i := range items {
+		itemID := id(&items[i])
+		if seen[*itemID] {
+			*itemID = next
+			next++
+		}
+		seen[*itemID] = true
+	}
+	return next
+}
+
+func duplicateIDs(ids []int) []int {
+	seen := map[int]int{}
+	var dups []int
+	for _, id := range ids {
+		seen[id]++
+		if seen[id] == 2 {
+			dups = append(dups, id)
+		}
+	}
+	sort.Ints(dups)
+	return dups
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (s *MarkdownStore) relativePath(path string) string {
+	rel, err := filepath.Rel(s.dataDir, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Sections the store writes itself. Any other "# " section of a file is kept
+// as an extra section.
+var (
+	cardSections    = []string{"Description", "Todos", "Acceptance Criteria", "Comments", "History"}
+	projectSections = []string{"Project"}
+)
+
+// notesSection is the extra card section exposed read-only as Card.Notes.
+const notesSection = "Notes"
+
+var (
+	cardFrontmatterKeys    = frontmatterKeys(reflect.TypeOf(cardFrontmatter{}))
+	projectFrontmatterKeys = frontmatterKeys(reflect.TypeOf(projectFrontmatter{}))
+)
+
+func frontmatterKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		keys[name] = true
+	}
+	return keys
+}
+
+// extraFrontmatter returns the frontmatter keys outside known, with their
+// values, as YAML to append when the file is written again.
+func extraFrontmatter(yml []byte, known map[string]bool) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(yml, &doc); err != nil {
+		return "", err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return "", nil
+	}
+	pairs := doc.Content[0].Content
+	extra := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if !known[pairs[i].Value] {
+			extra.Content = append(extra.Content, pairs[i], pairs[i+1])
+		}
+	}
+	if len(extra.Content) == 0 {
+		return "", nil
+	}
+	out, err := yaml.Marshal(extra)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// extraSections collects the sections of body not named in known, each with
+// the known section it followed. Text before the first section is kept as a
+// section without a heading.
+func extraSections(body string, known []string) []model.ExtraSection {
+	var (
+		sections []model.ExtraSection
+		current  *model.ExtraSection
+		lines    []string
+		after    string
+	)
+	flush := func() {
+		if current != nil {
+			current.Body = strings.TrimRight(strings.Join(lines, "\n"), "\n")
+			if current.Heading != "" || current.Body != "" {
+				sections = append(sections, *current)
+			}
+		}
+		current, lines = nil, nil
+	}
+	current = &model.ExtraSection{}
+	for _, line := range strings.Split(body, "\n") {
+		if heading, ok := strings.CutPrefix(line, "# "); ok {
+			flush()
+			heading = strings.TrimSpace(heading)
+			if slices.Contains(known, heading) {
+				after = heading
+				continue
+			}
+			current = &model.ExtraSection{Heading: heading, After: after}
+			continue
+		}
+		if current != nil {
+			lines = append(lines, line)
+		}
+	}
+	flush()
+	return sections
+}
+
+// writeExtraSections writes the extra sections that followed the managed
+// section after; sections from the top of the file are written with after "".
+func writeExtraSections(body *strings.Builder, sections []model.ExtraSection, after string) {
+	for _, section := range sections {
+		if section.After != after {
+			continue
+		}
+		if after != "" && !strings.HasSuffix(body.String(), "\n\n") {
+			body.WriteByte('\n')
+		}
+		if section.Heading != "" {
+			body.WriteString("# ")
+			body.WriteString(section.Heading)
+			body.WriteByte('\n')
+		}
+		if section.Body != "" {
+			body.WriteString(section.Body)
+			body.WriteByte('\n')
+		}
+		if after == "" {
+			body.WriteByte('\n')
+		}
+	}
+}
+
+func notesOf(sections []model.ExtraSection) string {
+	for _, section := range sections {
+		if section.Heading == notesSection {
+			return strings.TrimSpace(section.Body)
+		}
+	}
+	return ""
+}
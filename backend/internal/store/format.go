@@ -0,0 +1,292 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// formatVersion is written to the format_version frontmatter field of every
+// project and card file. Files without one predate versioning and count as
+// version 0. Both kinds of file share the version, so a data directory is in
+// one format; a version may leave one kind unchanged.
+//
+// The text of descriptions, comments, todos, acceptance criteria and history
+// entries is written trimmed of leading and trailing whitespace, so that is
+// the one change a write and a read make to a card.
+//
+//   - 1: versioned; the legacy column field is gone.
+//   - 2: entry bodies escape lines that would read as markdown structure.
+const formatVersion = 2
+
+// A migration upgrades a file's frontmatter and body by one format version.
+// It works on the raw frontmatter so it does not depend on the current
+// frontmatter structs.
+type migration func(fm map[string]any, body string) (string, error)
+
+// cardMigrations[v] upgrades a card file from version v to v+1.
+var cardMigrations = []migration{
+	0: migrateCardV0,
+	1: migrateCardV1,
+}
+
+// projectMigrations[v] upgrades a project file from version v to v+1.
+var projectMigrations = []migration{
+	0: stampVersion,
+	1: stampVersion,
+}
+
+// migrateCardV0 upgrades an unversioned card. The oldest cards kept their
+// status in a column field; the revision, rank and next checklist ids that
+// parseCard derives when missing are left for it to fill in.
+func migrateCardV0(fm map[string]any, body string) (string, error) {
+	if column, ok := fm["column"].(string); ok {
+		if status, _ := fm["status"].(string); strings.TrimSpace(status) == "" {
+			fm["status"] = column
+		}
+	}
+	delete(fm, "column")
+	return body, nil
+}
+
+// migrateCardV1 escapes the entry bodies of a version 1 card. Version 1 read
+// a body line starting with "# " or "## " as a heading, so such lines cannot
+// occur; lines starting with a backslash and literal "(none)" lines can, and
+// would otherwise change meaning.
+func migrateCardV1(_ map[string]any, body string) (string, error) {
+	lines := strings.Split(body, "\n")
+	managed, entry := false, -1
+	escapeEntry := func(end int) {
+		if entry < 0 || strings.TrimSpace(strings.Join(lines[entry:end], "\n")) == "(none)" {
+			return
+		}
+		for i := entry; i < end; i++ {
+			lines[i] = escapeLine(lines[i])
+		}
+	}
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "# "):
+			escapeEntry(i)
+			entry = -1
+			managed = slices.Contains(cardSections, strings.TrimSpace(strings.TrimPrefix(line, "# ")))
+		case strings.HasPrefix(line, "## "):
+			escapeEntry(i)
+			entry = -1
+			if managed {
+				entry = i + 1
+			}
+		}
+	}
+	escapeEntry(len(lines))
+	return strings.Join(lines, "\n"), nil
+}
+
+// stampVersion is the migration of a version that left a kind of file as it
+// was.
+func stampVersion(_ map[string]any, body string) (string, error) {
+	return body, nil
+}
+
+// escapeBody prepares the text of a description, comment, todo, acceptance
+// criterion or history entry for the card file. A line that would read as a
+// section or entry heading, a literal "(none)" and a line that already starts
+// with a backslash get a backslash in front, the way markdown escapes a
+// leading "#"; every other line is written as is. The text is trimmed first,
+// as the store trims it on input.
+func escapeBody(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = escapeLine(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func escapeLine(line string) string {
+	if strings.HasPrefix(line, "# ") || strings.HasPrefix(line, "## ") || strings.HasPrefix(line, `\`) || line == "(none)" {
+		return `\` + line
+	}
+	return line
+}
+
+func unescapeBody(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, `\`)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// migrateFile brings data to the current format version. Data already at the
+// current version is returned as is; a newer version is an error, since this
+// build cannot know what it would lose by reading it.
+func migrateFile(data []byte, version, current int, migrations []migration) ([]byte, error) {
+	switch {
+	case version == current:
+		return data, nil
+	case version > current:
+		return nil, fmt.Errorf("format_version %d is newer than the supported %d", version, current)
+	case version < 0:
+		return nil, fmt.Errorf("invalid format_version %d", version)
+	}
+	yml, body, err := splitFrontmatter(data)
+	if err != nil {
+		return nil, err
+	}
+	fm := map[string]any{}
+	if err := yaml.Unmarshal(yml, &fm); err != nil {
+		return nil, err
+	}
+	for v := version; v < current; v++ {
+		if body, err = migrations[v](fm, body); err != nil {
+			return nil, fmt.Errorf("migrate format_version %d: %w", v, err)
+		}
+	}
+	fm["format_version"] = current
+	out, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, err
+	}
+	return []byte("---\n" + string(out) + "---\n" + body), nil
+}
+
+// MigratedFile is a file Migrate upgraded, or would upgrade on a dry run.
+// Path is relative to the data directory.
+type MigratedFile struct {
+	Path        string `json:"path"`
+	FromVersion int    `json:"from_version"`
+	ToVersion   int    `json:"to_version"`
+	Before      string `json:"-"`
+	After       string `json:"-"`
+}
+
+// Migrate rewrites every project and card file older than the current format
+// version in the current format. With dryRun nothing is written. Reading a
+// file migrates it in memory anyway, so this only makes the upgrade explicit
+// on disk; it does not change revisions or history.
+func (s *MarkdownStore) Migrate(dryRun bool) ([]MigratedFile, error) {
+	if !dryRun && s.readOnly {
+		return nil, ErrReadOnly
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.projectsDir)
+	if err != nil {
+		return nil, err
+	}
+	migrated := []MigratedFile{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		slug := entry.Name()
+		file, err := s.migrateProjectFile(slug, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		if file != nil {
+			migrated = append(migrated, *file)
+		}
+
+		files, err := os.ReadDir(s.projectDir(slug))
+		if err != nil {
+			return nil, err
+		}
+		numbers := make([]int, 0, len(files))
+		for _, f := range files {
+			if number, ok := cardNumberFromFilename(f.Name()); ok && !f.IsDir() {
+				numbers = append(numbers, number)
+			}
+		}
+		sort.Ints(numbers)
+		for _, number := range numbers {
+			file, err := s.migrateCardFile(slug, number, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			if file != nil {
+				migrated = append(migrated, *file)
+			}
+		}
+	}
+	return migrated, nil
+}
+
+func (s *MarkdownStore) migrateProjectFile(slug string, dryRun bool) (*MigratedFile, error) {
+	path := s.projectPath(slug)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.relativePath(path), err)
+	}
+	version, err := readFormatVersion(data)
+	if err != nil || version >= formatVersion {
+		return nil, s.migrateError(path, err)
+	}
+	project, err := parseProject(data, slug)
+	if err != nil {
+		return nil, s.migrateError(path, err)
+	}
+	out, err := encodeProject(project)
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		if err := s.writeFile(path, out); err != nil {
+			return nil, err
+		}
+	}
+	return &MigratedFile{Path: s.relativePath(path), FromVersion: version, ToVersion: formatVersion, Before: string(data), After: string(out)}, nil
+}
+
+func (s *MarkdownStore) migrateCardFile(slug string, number int, dryRun bool) (*MigratedFile, error) {
+	path := s.cardPath(slug, number)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.relativePath(path), err)
+	}
+	version, err := readFormatVersion(data)
+	if err != nil || version >= formatVersion {
+		return nil, s.migrateError(path, err)
+	}
+	card, err := parseCard(data)
+	if err != nil {
+		return nil, s.migrateError(path, err)
+	}
+	out, err := encodeCard(card)
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		if err := s.writeFile(path, out); err != nil {
+			return nil, err
+		}
+	}
+	return &MigratedFile{Path: s.relativePath(path), FromVersion: version, ToVersion: formatVersion, Before: string(data), After: string(out)}, nil
+}
+
+func (s *MarkdownStore) migrateError(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w (run kanban doctor)", s.relativePath(path), err)
+}
+
+// readFormatVersion reads only the format_version of a file.
+func readFormatVersion(data []byte) (int, error) {
+	yml, _, err := splitFrontmatter(data)
+	if err != nil {
+		return 0, err
+	}
+	var fm struct {
+		FormatVersion int `yaml:"format_version"`
+	}
+	if err := yaml.Unmarshal(yml, &fm); err != nil {
+		return 0, err
+	}
+	return fm.FormatVersion, nil
+}
@@ -0,0 +1,204 @@
+package store
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the current-format golden files")
+
+// The golden files under testdata/formats pin each historical file format:
+// vN holds files as written at format version N, and the directory of the
+// current version holds what every older file migrates to. A version may add
+// files for what it introduced, so each version is compared on its own files.
+func TestMigrateHistoricalFormats(t *testing.T) {
+	current := filepath.Join("testdata", "formats", fmt.Sprintf("v%d", formatVersion))
+
+	for version := 0; version < formatVersion; version++ {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			root := t.TempDir()
+			copyTree(t, filepath.Join("testdata", "formats", fmt.Sprintf("v%d", version)), root)
+			s, err := NewMarkdownStore(root)
+			require.NoError(t, err)
+
+			dryRun, err := s.Migrate(true)
+			require.NoError(t, err)
+			require.NotEmpty(t, dryRun)
+			for _, file := range dryRun {
+				require.Equal(t, version, file.FromVersion)
+				require.Equal(t, formatVersion, file.ToVersion)
+				before, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(file.Path)))
+				require.NoError(t, err)
+				require.Equal(t, file.Before, string(before), "a dry run writes nothing")
+			}
+
+			migrated, err := s.Migrate(false)
+			require.NoError(t, err)
+			require.Len(t, migrated, len(dryRun))
+			if *updateGolden && version == formatVersion-1 {
+				require.NoError(t, os.RemoveAll(current))
+				copyTree(t, root, current)
+			}
+			want := readTree(t, current)
+			got := readTree(t, root)
+			for path, content := range got {
+				require.Equal(t, want[path], content, path)
+			}
+
+			again, err := s.Migrate(false)
+			require.NoError(t, err)
+			require.Empty(t, again)
+		})
+	}
+
+	t.Run("current", func(t *testing.T) {
+		root := t.TempDir()
+		copyTree(t, current, root)
+		s, err := NewMarkdownStore(root)
+		require.NoError(t, err)
+
+		migrated, err := s.Migrate(false)
+		require.NoError(t, err)
+		require.Empty(t, migrated)
+
+		// Rewriting every file as loaded must reproduce it byte for byte.
+		projects, cards, err := s.Snapshot()
+		require.NoError(t, err)
+		for _, project := range projects {
+			data, err := encodeProject(project)
+			require.NoError(t, err)
+			require.Equal(t, string(readFile(t, s.projectPath(project.Slug))), string(data))
+		}
+		for _, card := range cards {
+			data, err := encodeCard(card)
+			require.NoError(t, err)
+			require.Equal(t, string(readFile(t, s.cardPath(card.ProjectSlug, card.Number))), string(data))
+		}
+	})
+}
+
+func TestParseCardMigratesLegacyColumn(t *testing.T) {
+	data := readFile(t, filepath.Join("testdata", "formats", "v0", "projects", "legacy", "card-1.md"))
+	card, err := parseCard(data)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", card.Status)
+	require.Equal(t, 3, card.NextTodoID)
+	require.Equal(t, 2, card.NextAcceptanceCriterionID)
+	require.Equal(t, 1, card.Revision)
+	require.Equal(t, defaultRank(1), card.Rank)
+
+	_, err = parseCard([]byte("---\nformat_version: 99\nid: a/card-1\n---\n"))
+	require.ErrorContains(t, err, fmt.Sprintf("format_version 99 is newer than the supported %d", formatVersion))
+}
+
+func copyTree(t *testing.T, src, dst string) {
+	t.Helper()
+	require.NoError(t, filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	}))
+}
+
+// readTree maps the markdown files under root to their content.
+func readTree(t *testing.T, root string) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".md") {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		files[filepath.ToSlash(rel)] = string(data)
+		return err
+	}))
+	return files
+}
+
+func readFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
+
+// FuzzCardRoundTrip checks that any text a card can hold survives a write and
+// a read with no change beyond what storedCard, which the card cache relies
+// on, says the format loses.
+func FuzzCardRoundTrip(f *testing.F) {
+	for _, seed := range []string{
+		"plain text",
+		"# Todos\n## 1 | open\ninjected",
+		"## 2025-01-01T00:00:00Z\nnot a comment",
+		"(none)",
+		"before\n(none)\nafter",
+		`\`,
+		"\\# escaped already\n\\\\two",
+		"---\nid: other\n---",
+		"windows\r\nline endings",
+		"blank\n\n\nlines",
+		strings.Repeat("x", 2<<20),
+	} {
+		f.Add(seed, "")
+	}
+	f.Add("comment", "# details\n## heading")
+
+	f.Add("  indented\n\tand trailing  \n", "\n\n")
+
+	f.Fuzz(func(t *testing.T, text, details string) {
+		if strings.TrimSpace(text) == "" {
+			t.Skip()
+		}
+		at := time.Date(2025, 1, 2, 3, 4, 5, 678, time.UTC)
+		card := model.Card{
+			ID:                        "fuzz/card-1",
+			ProjectSlug:               "fuzz",
+			Number:                    1,
+			Title:                     "Fuzz",
+			Status:                    "Todo",
+			Rank:                      defaultRank(1),
+			Revision:                  1,
+			CreatedAt:                 at,
+			UpdatedAt:                 at,
+			NextTodoID:                2,
+			NextAcceptanceCriterionID: 2,
+			Description:               []model.TextEvent{{Timestamp: at, Body: text}},
+			Todos:                     []model.Todo{{ID: 1, Text: text}},
+			AcceptanceCriteria:        []model.AcceptanceCriterion{{ID: 1, Text: text, Completed: true}},
+			Comments:                  []model.TextEvent{{Timestamp: at, Body: text}, {Timestamp: at, Body: text}},
+			History:                   []model.HistoryEvent{{Timestamp: at, Type: "card.created", Details: details}},
+		}
+
+		data, err := encodeCard(card)
+		require.NoError(t, err)
+		parsed, err := parseCard(data)
+		require.NoError(t, err)
+		require.Equal(t, storedCard(card), parsed)
+	})
+}
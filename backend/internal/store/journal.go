@@ -0,0 +1,321 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// Operations that change more than one file go through a journal so a crash
+// part way cannot leave the files disagreeing, such as a card-N.md written
+// while project.md still hands out N. Each operation gets a directory under
+// .journal/ in the data directory. Before a file is first changed, its
+// previous content is kept there: card and project files are copied, while
+// attachment blobs and removed files are moved aside, so replacing a large
+// blob costs no copy. A small manifest lists the files and is rewritten as
+// the operation goes, and the directory is removed once the operation is
+// done. A manifest found when the store is opened belongs to an operation
+// that never finished; its files are put back the way they were.
+const (
+	journalDirName      = ".journal"
+	journalManifestName = "journal.json"
+)
+
+var journalSeq atomic.Int64
+
+type journalEntry struct {
+	Op    string        `json:"op"`
+	Files []journalFile `json:"files"`
+}
+
+// journalFile is the state of a path, relative to the data directory, before
+// the operation changed it. Saved names the file in the operation's journal
+// directory holding the previous content of a path that existed. A directory
+// the operation renamed records where it went instead.
+type journalFile struct {
+	Path      string `json:"path"`
+	Existed   bool   `json:"existed,omitempty"`
+	Saved     string `json:"saved,omitempty"`
+	RenamedTo string `json:"renamed_to,omitempty"`
+}
+
+// A journalTx is one journaled operation, used like a database transaction:
+// defer end right after beginJournal, change files only through the
+// transaction, and commit at the end. Ending a committed operation does
+// nothing.
+type journalTx struct {
+	s         *MarkdownStore
+	dir       string
+	entry     journalEntry
+	recorded  map[string]bool
+	committed bool
+}
+
+func (s *MarkdownStore) beginJournal(op string) *journalTx {
+	return &journalTx{s: s, entry: journalEntry{Op: op}, recorded: map[string]bool{}}
+}
+
+func (tx *journalTx) writeCard(c *model.Card) error {
+	if err := tx.record(tx.s.cardPath(c.ProjectSlug, c.Number)); err != nil {
+		return err
+	}
+	return tx.s.writeCard(c)
+}
+
+func (tx *journalTx) writeProject(p model.Project) error {
+	if err := tx.record(tx.s.projectPath(p.Slug)); err != nil {
+		return err
+	}
+	return tx.s.writeProject(p)
+}
+
+// writeBlob writes an attachment blob. Blobs are not card or project files,
+// so the store does not track them as its own writes.
+func (tx *journalTx) writeBlob(path string, data []byte) error {
+	if err := tx.moveAside(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return writeFileAtomic(path, data, 0o644)
+}
+
+func (tx *journalTx) remove(path string) error {
+	tx.s.rememberRemoval(path)
+	return tx.moveAside(path)
+}
+
+// rename moves a directory, creating the parent of dst as needed.
+func (tx *journalTx) rename(src, dst string) error {
+	if err := tx.add("", journalFile{Path: tx.s.relativePath(src), RenamedTo: tx.s.relativePath(dst)}); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(src, dst)
+}
+
+// record copies the current content of path to the journal unless it is
+// already there, so the journal always holds the state before the operation.
+func (tx *journalTx) record(path string) error {
+	if tx.recorded[path] {
+		return nil
+	}
+	file := journalFile{Path: tx.s.relativePath(path)}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := tx.open(); err != nil {
+			return err
+		}
+		file.Existed, file.Saved = true, tx.nextSaved()
+		if err := writeFileAtomic(filepath.Join(tx.dir, file.Saved), data, 0o644); err != nil {
+			return err
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+	return tx.add(path, file)
+}
+
+// moveAside removes path, moving it into the journal unless the journal
+// already holds its state before the operation. The manifest names the saved
+// file before the move, so a crash in between leaves nothing to put back. A
+// missing path is recorded as such and reported as os.ErrNotExist.
+func (tx *journalTx) moveAside(path string) error {
+	if tx.recorded[path] {
+		return os.Remove(path)
+	}
+	file := journalFile{Path: tx.s.relativePath(path)}
+	_, statErr := os.Lstat(path)
+	switch {
+	case statErr == nil:
+		file.Existed, file.Saved = true, tx.nextSaved()
+	case !errors.Is(statErr, os.ErrNotExist):
+		return statErr
+	}
+	if err := tx.add(path, file); err != nil {
+		return err
+	}
+	if !file.Existed {
+		return statErr
+	}
+	return renameFile(path, filepath.Join(tx.dir, file.Saved))
+}
+
+// add lists file in the manifest. path marks the file recorded, unless empty.
+func (tx *journalTx) add(path string, file journalFile) error {
+	tx.entry.Files = append(tx.entry.Files, file)
+	if err := tx.save(); err != nil {
+		tx.entry.Files = tx.entry.Files[:len(tx.entry.Files)-1]
+		return err
+	}
+	if path != "" {
+		tx.recorded[path] = true
+	}
+	return nil
+}
+
+// nextSaved names the file that keeps the previous content of the next
+// recorded path.
+func (tx *journalTx) nextSaved() string {
+	return strconv.Itoa(len(tx.entry.Files))
+}
+
+// open creates the operation's journal directory on first use.
+func (tx *journalTx) open() error {
+	if tx.dir != "" {
+		return nil
+	}
+	dir := filepath.Join(tx.s.dataDir, journalDirName, fmt.Sprintf("%020d-%020d", time.Now().UnixNano(), journalSeq.Add(1)))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tx.dir = dir
+	return nil
+}
+
+func (tx *journalTx) manifestPath() string {
+	return filepath.Join(tx.dir, journalManifestName)
+}
+
+func (tx *journalTx) save() error {
+	if err := tx.open(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(tx.entry)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(tx.manifestPath(), data, 0o644)
+}
+
+// commit ends the operation. Removing the manifest is what commits it: if
+// that fails the operation is not committed, so the deferred rollback undoes
+// it rather than the next start. Saved files left behind after that are
+// removed on the next start.
+func (tx *journalTx) commit() error {
+	if tx.dir != "" {
+		if err := os.Remove(tx.manifestPath()); err != nil {
+			return err
+		}
+		_ = os.RemoveAll(tx.dir)
+	}
+	tx.committed = true
+	return nil
+}
+
+// end rolls back an operation that did not commit. A failed rollback is joined
+// into *errp, the deferring function's named error result, so the caller
+// learns the files are left for the next start to put back.
+func (tx *journalTx) end(errp *error) {
+	if err := tx.rollback(); err != nil {
+		*errp = errors.Join(*errp, err)
+	}
+}
+
+// rollback puts every file the operation changed back, last change first, and
+// removes the journal directory. A failed rollback keeps the manifest for the
+// next start to finish; putting a file back twice is harmless.
+func (tx *journalTx) rollback() error {
+	if tx.committed || tx.dir == "" {
+		return nil
+	}
+	for _, file := range slices.Backward(tx.entry.Files) {
+		if err := tx.restore(file); err != nil {
+			return fmt.Errorf("roll back %s %s: %w", tx.entry.Op, file.Path, err)
+		}
+	}
+	if err := os.Remove(tx.manifestPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	tx.committed = true
+	return os.RemoveAll(tx.dir)
+}
+
+func (tx *journalTx) restore(file journalFile) error {
+	path := filepath.Join(tx.s.dataDir, filepath.FromSlash(file.Path))
+	switch {
+	case file.RenamedTo != "":
+		dst := filepath.Join(tx.s.dataDir, filepath.FromSlash(file.RenamedTo))
+		if _, err := os.Stat(dst); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return os.Rename(dst, path)
+	case file.Existed:
+		// A saved file that is gone was never moved aside, or an earlier
+		// attempt at this rollback already put it back.
+		saved := filepath.Join(tx.dir, file.Saved)
+		if _, err := os.Stat(saved); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if isWatchedFile(filepath.Base(path)) {
+			data, err := os.ReadFile(saved)
+			if err != nil {
+				return err
+			}
+			tx.s.rememberWrite(path, data)
+		}
+		tx.s.cards.forget(path)
+		return renameFile(saved, path)
+	default:
+		tx.s.rememberRemoval(path)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+}
+
+// recoverJournal rolls back the operations a crash interrupted, newest first.
+// An operation without a manifest stopped before it changed anything, and
+// temp files are journal writes that never completed; both are only removed.
+func (s *MarkdownStore) recoverJournal() error {
+	dir := filepath.Join(s.dataDir, journalDirName)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	slices.Reverse(entries)
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if strings.HasPrefix(entry.Name(), ".tmp-") {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if !entry.IsDir() {
+			continue
+		}
+		tx := &journalTx{s: s, dir: path}
+		data, err := os.ReadFile(tx.manifestPath())
+		if errors.Is(err, os.ErrNotExist) {
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &tx.entry); err != nil {
+			return fmt.Errorf("%s: %w", s.relativePath(tx.manifestPath()), err)
+		}
+		if err := tx.rollback(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package store
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// failProjectRename makes the next rename onto a project.md fail, after
+// calling crash with the data directory as it is at that moment.
+func failProjectRename(t *testing.T, crash func()) {
+	t.Helper()
+	previousRename := renameFile
+	failed := false
+	renameFile = func(src, dst string) error {
+		if filepath.Base(dst) == "project.md" && !failed {
+			failed = true
+			if crash != nil {
+				crash()
+			}
+			return errors.New("rename failed")
+		}
+		return previousRename(src, dst)
+	}
+	t.Cleanup(func() { renameFile = previousRename })
+}
+
+func journalEntries(t *testing.T, dataDir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(dataDir, journalDirName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	require.NoError(t, err)
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestCreateCardRollsBackWhenProjectWriteFails(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	before, err := os.ReadFile(s.projectPath("alpha"))
+	require.NoError(t, err)
+
+	failProjectRename(t, nil)
+	_, err = s.CreateCard("alpha", "Lost", "", "", "Todo", "", false)
+	require.ErrorContains(t, err, "rename failed")
+
+	_, err = os.Stat(s.cardPath("alpha", 1))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	after, err := os.ReadFile(s.projectPath("alpha"))
+	require.NoError(t, err)
+	require.Equal(t, string(before), string(after))
+	require.Empty(t, journalEntries(t, dataDir))
+}
+
+func TestNewMarkdownStoreRollsBackInterruptedCreate(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	before, err := os.ReadFile(s.projectPath("alpha"))
+	require.NoError(t, err)
+
+	// The copy is the data directory of a process that died between writing
+	// the card and writing project.md.
+	crashed := filepath.Join(t.TempDir(), "crashed")
+	failProjectRename(t, func() { copyTree(t, dataDir, crashed) })
+	_, err = s.CreateCard("alpha", "Lost", "", "", "Todo", "", false)
+	require.Error(t, err)
+	require.FileExists(t, filepath.Join(crashed, "projects", "alpha", "card-1.md"))
+	require.Len(t, journalEntries(t, crashed), 1)
+
+	recovered, err := NewMarkdownStore(crashed)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = recovered.Close() })
+	require.Empty(t, journalEntries(t, crashed))
+	require.NoFileExists(t, filepath.Join(crashed, "projects", "alpha", "card-1.md"))
+	after, err := os.ReadFile(filepath.Join(crashed, "projects", "alpha", "project.md"))
+	require.NoError(t, err)
+	require.Equal(t, string(before), string(after))
+
+	card, err := recovered.CreateCard("alpha", "Kept", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	require.Equal(t, 1, card.Number)
+	report, err := recovered.Doctor(false)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+}
+
+func TestNewMarkdownStoreRemovesUnfinishedJournalWrites(t *testing.T) {
+	dataDir := t.TempDir()
+	dir := filepath.Join(dataDir, journalDirName)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".tmp-123"), []byte(`{"op":`), 0o644))
+	// An operation that saved a file but had not listed it in its manifest
+	// yet had not changed anything either.
+	unlisted := filepath.Join(dir, "00000000000000000001-00000000000000000001")
+	require.NoError(t, os.MkdirAll(unlisted, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(unlisted, "0"), []byte("saved"), 0o644))
+
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	require.Empty(t, journalEntries(t, dataDir))
+}
+
+func TestCreateCardRefusesToOverwriteCard(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	card, err := s.CreateCard("alpha", "First", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	// A card written by hand, or left by an older build that crashed, at the
+	// number project.md hands out next.
+	data, err := os.ReadFile(s.cardPath("alpha", card.Number))
+	require.NoError(t, err)
+	handWritten := strings.ReplaceAll(string(data), "alpha/card-1", "alpha/card-2")
+	handWritten = strings.Replace(handWritten, "number: 1", "number: 2", 1)
+	require.NoError(t, os.WriteFile(s.cardPath("alpha", 2), []byte(handWritten), 0o644))
+
+	_, err = s.CreateCard("alpha", "Second", "", "", "Todo", "", false)
+	require.ErrorIs(t, err, os.ErrExist)
+	require.ErrorContains(t, err, "doctor --fix")
+	data, err = os.ReadFile(s.cardPath("alpha", 2))
+	require.NoError(t, err)
+	require.Equal(t, handWritten, string(data))
+}
+
+func TestAddAttachmentRollsBackBlobWhenCardWriteFails(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", []byte("first\n"), 0)
+	require.NoError(t, err)
+	before, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+
+	previousRename := renameFile
+	failed := false
+	renameFile = func(src, dst string) error {
+		if filepath.Base(dst) == "card-1.md" && !failed {
+			failed = true
+			return errors.New("rename failed")
+		}
+		return previousRename(src, dst)
+	}
+	t.Cleanup(func() { renameFile = previousRename })
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", []byte("second\n"), 0)
+	require.ErrorContains(t, err, "rename failed")
+
+	blob, err := os.ReadFile(filepath.Join(s.attachmentsDir("alpha", 1), "build.log"))
+	require.NoError(t, err)
+	require.Equal(t, "first\n", string(blob))
+	after, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+	require.Equal(t, string(before), string(after))
+	require.Empty(t, journalEntries(t, dataDir))
+}
+
+// Replacing a blob moves the old one aside instead of copying it into the
+// manifest, and an interrupted replace puts it back on the next start.
+func TestNewMarkdownStoreRestoresBlobMovedAsideByInterruptedReplace(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	first := []byte(strings.Repeat("first\n", 100_000))
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", first, 0)
+	require.NoError(t, err)
+
+	crashed := filepath.Join(t.TempDir(), "crashed")
+	previousRename := renameFile
+	renameFile = func(src, dst string) error {
+		if filepath.Base(dst) == "card-1.md" {
+			copyTree(t, dataDir, crashed)
+			return errors.New("rename failed")
+		}
+		return previousRename(src, dst)
+	}
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", []byte("second\n"), 0)
+	renameFile = previousRename
+	require.ErrorContains(t, err, "rename failed")
+
+	operations := journalEntries(t, crashed)
+	require.Len(t, operations, 1)
+	manifest, err := os.ReadFile(filepath.Join(crashed, journalDirName, operations[0], journalManifestName))
+	require.NoError(t, err)
+	require.Less(t, len(manifest), 1024)
+	blobPath := filepath.Join(crashed, "projects", "alpha", "attachments", "card-1", "build.log")
+	blob, err := os.ReadFile(blobPath)
+	require.NoError(t, err)
+	require.Equal(t, "second\n", string(blob))
+
+	recovered, err := NewMarkdownStore(crashed)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = recovered.Close() })
+	require.Empty(t, journalEntries(t, crashed))
+	blob, err = os.ReadFile(blobPath)
+	require.NoError(t, err)
+	require.Equal(t, first, blob)
+	report, err := recovered.Doctor(false)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+}
+
+func TestDeleteAttachmentReportsFailedRollback(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", []byte("kept\n"), 0)
+	require.NoError(t, err)
+	blobPath := filepath.Join(s.attachmentsDir("alpha", 1), "build.log")
+
+	// Every write of the card fails, so the rollback cannot put it back
+	// either.
+	previousRename := renameFile
+	renameFile = func(src, dst string) error {
+		if filepath.Base(dst) == "card-1.md" {
+			return errors.New("rename failed")
+		}
+		return previousRename(src, dst)
+	}
+	_, _, err = s.DeleteAttachment("alpha", 1, "build.log", 0)
+	renameFile = previousRename
+	require.ErrorContains(t, err, "rename failed")
+	require.ErrorContains(t, err, "roll back card.attachment.delete")
+	require.NoFileExists(t, blobPath)
+	require.Len(t, journalEntries(t, dataDir), 1)
+
+	// The next start finishes the rollback from the journal.
+	require.NoError(t, s.Close())
+	reopened, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = reopened.Close() })
+	require.Empty(t, journalEntries(t, dataDir))
+	_, data, err := reopened.ReadAttachment("alpha", 1, "build.log")
+	require.NoError(t, err)
+	require.Equal(t, "kept\n", string(data))
+}
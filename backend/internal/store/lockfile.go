@@ -0,0 +1,56 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// lockFileName is the file in the data directory a writable store holds an
+// exclusive advisory lock on for as long as it is open, so two servers never
+// allocate card numbers in the same directory. It holds the owner's PID.
+const lockFileName = ".lock"
+
+var (
+	// ErrDataDirLocked is returned when another process holds the data
+	// directory lock.
+	ErrDataDirLocked = errors.New("data directory is in use")
+	// ErrReadOnly is returned by writes to a store opened read-only.
+	ErrReadOnly = errors.New("store is open read-only")
+
+	errLockHeld = errors.New("lock held")
+)
+
+// lockDataDir takes the data directory lock and records the current PID in it.
+func lockDataDir(dataDir string) (*os.File, error) {
+	path := filepath.Join(dataDir, lockFileName)
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := flock(f); err != nil {
+		_ = f.Close()
+		if !errors.Is(err, errLockHeld) {
+			return nil, fmt.Errorf("lock %s: %w", path, err)
+		}
+		holder := "unknown"
+		if data, readErr := os.ReadFile(path); readErr == nil {
+			if pid, convErr := strconv.Atoi(strings.TrimSpace(string(data))); convErr == nil {
+				holder = strconv.Itoa(pid)
+			}
+		}
+		return nil, fmt.Errorf("%w: %s is locked by process %s; stop that process or open the directory read-only", ErrDataDirLocked, dataDir, holder)
+	}
+	if err := f.Truncate(0); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package store
+
+import "os"
+
+// flock is a no-op where flock(2) is not available; the data directory is
+// not protected against a second process there.
+func flock(*os.File) error {
+	return nil
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownStoreLocksDataDir(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(root, lockFileName))
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%d\n", os.Getpid()), string(data))
+
+	_, err = NewMarkdownStore(root)
+	require.ErrorIs(t, err, ErrDataDirLocked)
+	require.ErrorContains(t, err, fmt.Sprintf("locked by process %d", os.Getpid()))
+
+	require.NoError(t, s.Close())
+	require.NoError(t, s.Close(), "closing twice is harmless")
+	reopened, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	require.NoError(t, reopened.Close())
+}
+
+func TestReadOnlyMarkdownStore(t *testing.T) {
+	root := t.TempDir()
+	_, err := NewReadOnlyMarkdownStore(root)
+	require.ErrorIs(t, err, os.ErrNotExist, "a read-only store does not create the directory")
+
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	readOnly, err := NewReadOnlyMarkdownStore(root)
+	require.NoError(t, err, "a read-only store needs no lock")
+	projects, cards, err := readOnly.Snapshot()
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Len(t, cards, 1)
+	_, err = readOnly.Doctor(false)
+	require.NoError(t, err)
+	_, err = readOnly.Migrate(true)
+	require.NoError(t, err)
+
+	_, err = readOnly.CreateProject("Beta", "", "")
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = readOnly.AddComment("alpha", 1, "nope", 0)
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = readOnly.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = readOnly.DeleteCard("alpha", 1, true, 0)
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = readOnly.Doctor(true)
+	require.ErrorIs(t, err, ErrReadOnly)
+	_, err = readOnly.Migrate(false)
+	require.ErrorIs(t, err, ErrReadOnly)
+
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, "Todo", card.Status)
+	require.Empty(t, card.Comments)
+	require.NoError(t, readOnly.Close())
+	require.NoError(t, s.Close())
+}
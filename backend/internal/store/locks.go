@@ -0,0 +1,121 @@
+package store
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// Locking has two levels. s.mu guards the set of projects: creating, deleting
+// or restoring a project, and any write that can reach cards in projects it
+// cannot name up front, take it exclusively. Everything else holds it shared
+// together with the lock of each project it touches, so work in one project
+// does not wait for another; a move that checks a card's blockers also locks
+// their projects, see lockProjectsWithBlockers. Project locks are taken in
+// slug order, and no method takes a lock it already holds; exported methods
+// therefore never call each other, only the *Unlocked helpers.
+
+// projectLock returns the lock of a project, creating it on first use.
+func (s *MarkdownStore) projectLock(slug string) *sync.RWMutex {
+	s.projectLocksMu.Lock()
+	defer s.projectLocksMu.Unlock()
+
+	lock, ok := s.projectLocks[slug]
+	if !ok {
+		lock = &sync.RWMutex{}
+		s.projectLocks[slug] = lock
+	}
+	return lock
+}
+
+// lockStore locks the whole store for writing and returns the function that
+// releases it.
+func (s *MarkdownStore) lockStore() (func(), error) {
+	if s.readOnly {
+		return nil, ErrReadOnly
+	}
+	s.mu.Lock()
+	return s.mu.Unlock, nil
+}
+
+// lockProjects locks the given projects for writing and returns the function
+// that releases them. Empty slugs are skipped, so an optional second project
+// can be passed as is.
+func (s *MarkdownStore) lockProjects(slugs ...string) (func(), error) {
+	if s.readOnly {
+		return nil, ErrReadOnly
+	}
+	slugs = slices.Compact(slices.Sorted(slices.Values(slugs)))
+	slugs = slices.DeleteFunc(slugs, func(slug string) bool { return slug == "" })
+	s.mu.RLock()
+	locks := make([]*sync.RWMutex, len(slugs))
+	for i, slug := range slugs {
+		locks[i] = s.projectLock(slug)
+		locks[i].Lock()
+	}
+	return func() {
+		for i := len(locks) - 1; i >= 0; i-- {
+			locks[i].Unlock()
+		}
+		s.mu.RUnlock()
+	}, nil
+}
+
+// lockProjectsWithBlockers locks a card's project for writing together with
+// the projects of the cards it is blocked by, so their statuses hold still
+// while the write checks them. Relations only change under the locks of both
+// projects, so the blockers read before locking are read again under the
+// locks, and locking starts over if they changed in between.
+func (s *MarkdownStore) lockProjectsWithBlockers(projectSlug string, number int) (func(), error) {
+	blockerSlugs := func() []string {
+		card, err := s.getCardUnlocked(projectSlug, number)
+		if err != nil {
+			return nil
+		}
+		var slugs []string
+		for _, relation := range card.Relations {
+			if relation.Type != model.RelationBlockedBy {
+				continue
+			}
+			if slug, _, err := model.ParseCardID(relation.CardID); err == nil && slug != projectSlug {
+				slugs = append(slugs, slug)
+			}
+		}
+		return slices.Compact(slices.Sorted(slices.Values(slugs)))
+	}
+	for {
+		runlock := s.rlockProject(projectSlug)
+		slugs := blockerSlugs()
+		runlock()
+		unlock, err := s.lockProjects(append(slugs, projectSlug)...)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Equal(blockerSlugs(), slugs) {
+			return unlock, nil
+		}
+		unlock()
+	}
+}
+
+// rlockProject locks a project for reading and returns the function that
+// releases it.
+func (s *MarkdownStore) rlockProject(slug string) func() {
+	s.mu.RLock()
+	lock := s.projectLock(slug)
+	lock.RLock()
+	return func() {
+		lock.RUnlock()
+		s.mu.RUnlock()
+	}
+}
+
+// forgetProjectLock drops the lock of a project that no longer exists. The
+// caller holds s.mu exclusively, so no one else can hold the lock.
+func (s *MarkdownStore) forgetProjectLock(slug string) {
+	s.projectLocksMu.Lock()
+	defer s.projectLocksMu.Unlock()
+
+	delete(s.projectLocks, slug)
+}
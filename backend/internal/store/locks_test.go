@@ -0,0 +1,286 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWritesToOtherProjectsProceedWhileProjectLocked(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err := s.CreateProject(name, "", "")
+		require.NoError(t, err)
+		_, err = s.CreateCard(Slugify(name), "Task", "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+
+	unlock, err := s.lockProjects("alpha")
+	require.NoError(t, err)
+	_, err = s.AddComment("beta", 1, "not blocked by alpha", 0)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = s.AddComment("alpha", 1, "waits for alpha", 0)
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected AddComment to block while the project lock is held")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("AddComment did not finish after releasing the project lock")
+	}
+}
+
+// A snapshot used to take the read lock again for every project while a
+// writer could be queued on the store lock, which deadlocks with sync.RWMutex.
+func TestSnapshotDoesNotDeadlockWithQueuedWriter(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateProject("Beta", "", "")
+	require.NoError(t, err)
+
+	// Holding beta makes the snapshot wait part way through, with the store
+	// lock held shared, while a project is created.
+	unlock, err := s.lockProjects("beta")
+	require.NoError(t, err)
+	snapshot := make(chan error, 1)
+	go func() {
+		_, _, err := s.Snapshot()
+		snapshot <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	created := make(chan error, 1)
+	go func() {
+		_, err := s.CreateProject("Gamma", "", "")
+		created <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	unlock()
+
+	for _, ch := range []chan error{snapshot, created} {
+		select {
+		case err := <-ch:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("snapshot and a queued project create deadlocked")
+		}
+	}
+}
+
+// TestMarkdownStoreConcurrentStress runs writes within and across projects
+// next to snapshots. Run it with -race; it also checks that no write is lost.
+func TestMarkdownStoreConcurrentStress(t *testing.T) {
+	if testing.Short() {
+		t.Skip("stress test")
+	}
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	const (
+		projects = 4
+		workers  = 3
+		rounds   = 9
+	)
+	slugs := make([]string, projects)
+	for i := range slugs {
+		project, err := s.CreateProject(fmt.Sprintf("Stress %d", i), "", "")
+		require.NoError(t, err)
+		slugs[i] = project.Slug
+	}
+
+	var (
+		wg     sync.WaitGroup
+		errs   = make(chan error, 16)
+		stop   = make(chan struct{})
+		keptMu sync.Mutex
+		kept   []string
+	)
+	check := func(err error) {
+		if err != nil {
+			select {
+			case errs <- err:
+			default:
+			}
+		}
+	}
+	for p := range projects {
+		for w := range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				slug, other := slugs[p], slugs[(p+1)%projects]
+				for r := range rounds {
+					card, err := s.CreateCard(slug, fmt.Sprintf("w%d r%d", w, r), "", "", "Todo", "", false)
+					if err != nil {
+						check(err)
+						return
+					}
+					_, err = s.MoveCard(slug, card.Number, "Doing", model.CardPosition{}, false, 0)
+					check(err)
+					_, err = s.AddComment(slug, card.Number, fmt.Sprintf("comment %d", r), 0)
+					check(err)
+					_, _, err = s.AddTodo(slug, card.Number, "todo", 0)
+					check(err)
+
+					switch r % 3 {
+					case 0:
+						child, err := s.CreateCard(other, "child", "", "", "Todo", card.ID, false)
+						check(err)
+						if err == nil {
+							_, _, err = s.AddRelation(slug, card.Number, model.RelationBlocks, child.ID, 0)
+							check(err)
+						}
+					case 1:
+						_, _, err = s.MoveCardToProject(slug, card.Number, other, 0)
+						check(err)
+						continue
+					case 2:
+						_, err = s.DeleteCard(slug, card.Number, false, 0)
+						check(err)
+					}
+					keptMu.Lock()
+					kept = append(kept, card.ID)
+					keptMu.Unlock()
+				}
+			}()
+		}
+	}
+
+	var readers sync.WaitGroup
+	readers.Add(1)
+	go func() {
+		defer readers.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			_, _, err := s.Snapshot()
+			check(err)
+			_, err = s.ListProjects()
+			check(err)
+		}
+	}()
+
+	wg.Wait()
+	close(stop)
+	readers.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	_, cards, err := s.Snapshot()
+	require.NoError(t, err)
+	moved := 0
+	byID := map[string]model.Card{}
+	for _, card := range cards {
+		byID[card.ID] = card
+		if card.MovedTo != "" {
+			moved++
+		}
+	}
+	// Every three rounds leave a card with a child, a moved card with its
+	// tombstone and a soft-deleted card.
+	require.Len(t, cards, projects*workers*(rounds/3)*5)
+	require.Equal(t, projects*workers*(rounds/3), moved)
+	for _, id := range kept {
+		require.Len(t, byID[id].Comments, 1, id)
+		require.Len(t, byID[id].Todos, 1, id)
+	}
+
+	report, err := s.Doctor(false)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+}
+
+// The WIP limit is counted under the project lock, so concurrent moves into a
+// status cannot all see room left in it.
+func TestConcurrentMovesRespectWIPLimit(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.UpdateProject("alpha", model.ProjectPatch{WIPLimits: map[string]int{"Doing": 2}})
+	require.NoError(t, err)
+	const cards = 8
+	for i := range cards {
+		_, err := s.CreateCard("alpha", fmt.Sprintf("Task %d", i), "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, cards)
+	for i := range cards {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = s.MoveCard("alpha", i+1, "Doing", model.CardPosition{}, false, 0)
+		}()
+	}
+	wg.Wait()
+
+	moved := 0
+	for _, err := range errs {
+		if err == nil {
+			moved++
+			continue
+		}
+		var wipErr *model.WIPLimitError
+		require.ErrorAs(t, err, &wipErr)
+	}
+	require.Equal(t, 2, moved)
+}
+
+// An unforced move holds the locks of its blockers' projects, so a blocker
+// cannot be finished or reopened between the check and the write.
+func TestBlockedMoveWaitsForTheBlockersProject(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err := s.CreateProject(name, "", "")
+		require.NoError(t, err)
+		_, err = s.CreateCard(Slugify(name), "Task", "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+	_, _, err = s.AddRelation("alpha", 1, model.RelationBlockedBy, "beta/card-1", 0)
+	require.NoError(t, err)
+
+	unlock, err := s.lockProjects("beta")
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() {
+		_, err := s.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+		done <- err
+	}()
+	select {
+	case <-done:
+		t.Fatal("expected the move to wait for the blocker's project")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case err := <-done:
+		var blockedErr *model.BlockedError
+		require.ErrorAs(t, err, &blockedErr)
+	case <-time.After(2 * time.Second):
+		t.Fatal("move did not finish after releasing the blocker's project")
+	}
+}
@@ -1,13 +1,15 @@
 package store
 
 import (
-	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,47 +23,134 @@ import (
 type MarkdownStore struct {
 	dataDir     string
 	projectsDir string
-	mu          sync.RWMutex
+	trashDir    string
+
+	// mu and the per-project locks are described in locks.go.
+	mu             sync.RWMutex
+	projectLocksMu sync.Mutex
+	projectLocks   map[string]*sync.RWMutex
+
+	// lockFile holds the data directory lock; it is nil when readOnly.
+	lockFile *os.File
+	readOnly bool
+
+	knownMu sync.Mutex
+	known   map[string]knownFile
+
+	cards *cardCache
 }
 
+// knownFile tracks what the store itself last wrote to (or removed from) a
+// path, so filesystem events caused by the store can be told apart from edits
+// made outside the server.
+type knownFile struct {
+	sums    [][sha256.Size]byte
+	removed bool
+}
+
+const knownWritesPerFile = 4
+
 var renameFile = os.Rename
 
+// NewMarkdownStore opens dataDir for reading and writing, creating it if
+// needed, and rolls back any operation a crash left half done. It fails with
+// ErrDataDirLocked while another store, in this or another process, has the
+// directory open; Close releases it.
 func NewMarkdownStore(dataDir string) (*MarkdownStore, error) {
-	projectsDir := filepath.Join(dataDir, "projects")
-	if err := os.MkdirAll(projectsDir, 0o755); err != nil {
+	s := newMarkdownStore(dataDir)
+	if err := os.MkdirAll(s.projectsDir, 0o755); err != nil {
+		return nil, err
+	}
+	lockFile, err := lockDataDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	s.lockFile = lockFile
+	if err := s.recoverJournal(); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewReadOnlyMarkdownStore opens an existing dataDir without taking the data
+// directory lock, so it works next to a running server. Every write fails
+// with ErrReadOnly.
+func NewReadOnlyMarkdownStore(dataDir string) (*MarkdownStore, error) {
+	s := newMarkdownStore(dataDir)
+	if _, err := os.Stat(s.projectsDir); err != nil {
 		return nil, err
 	}
-	return &MarkdownStore{dataDir: dataDir, projectsDir: projectsDir}, nil
+	s.readOnly = true
+	return s, nil
+}
+
+func newMarkdownStore(dataDir string) *MarkdownStore {
+	return &MarkdownStore{
+		dataDir:      dataDir,
+		projectsDir:  filepath.Join(dataDir, "projects"),
+		trashDir:     filepath.Join(dataDir, ".trash"),
+		projectLocks: map[string]*sync.RWMutex{},
+		known:        map[string]knownFile{},
+		cards:        newCardCache(cardCacheSize),
+	}
+}
+
+// Close releases the data directory lock. The store must not be used after.
+func (s *MarkdownStore) Close() error {
+	if s.lockFile == nil {
+		return nil
+	}
+	err := s.lockFile.Close()
+	s.lockFile = nil
+	return err
 }
 
 type projectFrontmatter struct {
-	Name        string    `yaml:"name"`
-	Slug        string    `yaml:"slug"`
-	LocalPath   string    `yaml:"local_path,omitempty"`
-	RemoteURL   string    `yaml:"remote_url,omitempty"`
-	CreatedAt   time.Time `yaml:"created_at"`
-	UpdatedAt   time.Time `yaml:"updated_at"`
-	NextCardSeq int       `yaml:"next_card_seq"`
+	FormatVersion   int                         `yaml:"format_version"`
+	Name            string                      `yaml:"name"`
+	Slug            string                      `yaml:"slug"`
+	LocalPath       string                      `yaml:"local_path,omitempty"`
+	RemoteURL       string                      `yaml:"remote_url,omitempty"`
+	CreatedAt       time.Time                   `yaml:"created_at"`
+	UpdatedAt       time.Time                   `yaml:"updated_at"`
+	NextCardSeq     int                         `yaml:"next_card_seq"`
+	Statuses        []string                    `yaml:"statuses,omitempty"`
+	WIPLimits       map[string]int              `yaml:"wip_limits,omitempty"`
+	TransitionRules []transitionRuleFrontmatter `yaml:"transition_rules,omitempty"`
 }
 
 type cardFrontmatter struct {
-	ID                        string    `yaml:"id"`
-	ProjectSlug               string    `yaml:"project"`
-	Number                    int       `yaml:"number"`
-	Title                     string    `yaml:"title"`
-	Branch                    string    `yaml:"branch,omitempty"`
-	Status                    string    `yaml:"status"`
-	Column                    string    `yaml:"column,omitempty"`
-	Deleted                   bool      `yaml:"deleted"`
-	CreatedAt                 time.Time `yaml:"created_at"`
-	UpdatedAt                 time.Time `yaml:"updated_at"`
-	NextTodoID                int       `yaml:"next_todo_id,omitempty"`
-	NextAcceptanceCriterionID int       `yaml:"next_acceptance_criterion_id,omitempty"`
+	FormatVersion             int                       `yaml:"format_version"`
+	ID                        string                    `yaml:"id"`
+	ProjectSlug               string                    `yaml:"project"`
+	Number                    int                       `yaml:"number"`
+	Title                     string                    `yaml:"title"`
+	Branch                    string                    `yaml:"branch,omitempty"`
+	Status                    string                    `yaml:"status"`
+	Rank                      string                    `yaml:"rank,omitempty"`
+	Labels                    []string                  `yaml:"labels,omitempty"`
+	Priority                  string                    `yaml:"priority,omitempty"`
+	DueAt                     *time.Time                `yaml:"due_at,omitempty"`
+	Relations                 []cardRelationFrontmatter `yaml:"relations,omitempty"`
+	Parent                    string                    `yaml:"parent,omitempty"`
+	Attachments               []attachmentFrontmatter   `yaml:"attachments,omitempty"`
+	Column                    string                    `yaml:"column,omitempty"`
+	Deleted                   bool                      `yaml:"deleted"`
+	Revision                  int                       `yaml:"revision,omitempty"`
+	CreatedAt                 time.Time                 `yaml:"created_at"`
+	UpdatedAt                 time.Time                 `yaml:"updated_at"`
+	NextTodoID                int                       `yaml:"next_todo_id,omitempty"`
+	NextAcceptanceCriterionID int                       `yaml:"next_acceptance_criterion_id,omitempty"`
+	MovedTo                   string                    `yaml:"moved_to,omitempty"`
 }
 
 func (s *MarkdownStore) CreateProject(name, localPath, remoteURL string) (model.Project, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock, err := s.lockStore()
+	if err != nil {
+		return model.Project{}, err
+	}
+	defer unlock()
 
 	name = strings.TrimSpace(name)
 	if name == "" {
@@ -86,6 +175,7 @@ func (s *MarkdownStore) CreateProject(name, localPath, remoteURL string) (model.
 		CreatedAt:   now,
 		UpdatedAt:   now,
 		NextCardSeq: 1,
+		Statuses:    slices.Clone(model.DefaultStatuses),
 	}
 	if err := s.writeProject(project); err != nil {
 		return model.Project{}, err
@@ -97,67 +187,181 @@ func (s *MarkdownStore) ListProjects() ([]model.Project, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	dirs, err := os.ReadDir(s.projectsDir)
+	slugs, err := s.projectSlugsUnlocked()
 	if err != nil {
 		return nil, err
 	}
-	projects := make([]model.Project, 0, len(dirs))
-	for _, entry := range dirs {
-		if !entry.IsDir() {
-			continue
+	projects := make([]model.Project, 0, len(slugs))
+	for _, slug := range slugs {
+		lock := s.projectLock(slug)
+		lock.RLock()
+		project, err := s.loadProject(slug)
+		lock.RUnlock()
+		if err != nil {
+			return nil, err
 		}
-		project, err := s.loadProject(entry.Name())
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// listProjectsUnlocked reads every project. The caller holds s.mu exclusively.
+func (s *MarkdownStore) listProjectsUnlocked() ([]model.Project, error) {
+	slugs, err := s.projectSlugsUnlocked()
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]model.Project, 0, len(slugs))
+	for _, slug := range slugs {
+		project, err := s.loadProject(slug)
 		if err != nil {
 			return nil, err
 		}
 		projects = append(projects, project)
 	}
-	sort.Slice(projects, func(i, j int) bool { return projects[i].Slug < projects[j].Slug })
 	return projects, nil
 }
 
+// projectSlugsUnlocked lists the project directories in slug order. The
+// caller holds s.mu.
+func (s *MarkdownStore) projectSlugsUnlocked() ([]string, error) {
+	dirs, err := os.ReadDir(s.projectsDir)
+	if err != nil {
+		return nil, err
+	}
+	slugs := make([]string, 0, len(dirs))
+	for _, entry := range dirs {
+		if entry.IsDir() {
+			slugs = append(slugs, entry.Name())
+		}
+	}
+	sort.Strings(slugs)
+	return slugs, nil
+}
+
 func (s *MarkdownStore) GetProject(slug string) (model.Project, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockProject(slug)()
 
 	return s.loadProject(slug)
 }
 
+func (s *MarkdownStore) UpdateProject(slug string, patch model.ProjectPatch) (model.Project, error) {
+	unlock, err := s.lockProjects(slug)
+	if err != nil {
+		return model.Project{}, err
+	}
+	defer unlock()
+
+	if patch.Name == nil && patch.LocalPath == nil && patch.RemoteURL == nil && patch.WIPLimits == nil {
+		return model.Project{}, errors.New("at least one field is required")
+	}
+	project, err := s.loadProject(slug)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	changed := false
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		if name == "" {
+			return model.Project{}, errors.New("name is required")
+		}
+		changed = changed || name != project.Name
+		project.Name = name
+	}
+	if patch.LocalPath != nil {
+		localPath := strings.TrimSpace(*patch.LocalPath)
+		changed = changed || localPath != project.LocalPath
+		project.LocalPath = localPath
+	}
+	if patch.RemoteURL != nil {
+		remoteURL := strings.TrimSpace(*patch.RemoteURL)
+		changed = changed || remoteURL != project.RemoteURL
+		project.RemoteURL = remoteURL
+	}
+	if patch.WIPLimits != nil {
+		limits, err := applyWIPLimits(project, patch.WIPLimits)
+		if err != nil {
+			return model.Project{}, err
+		}
+		changed = changed || !maps.Equal(limits, project.WIPLimits)
+		project.WIPLimits = limits
+	}
+	if !changed {
+		return project, nil
+	}
+
+	project.UpdatedAt = time.Now().UTC()
+	if err := s.writeProject(project); err != nil {
+		return model.Project{}, err
+	}
+	return project, nil
+}
+
+// DeleteProject moves the project directory into the trash. Trashed projects
+// can be restored until they are purged.
 func (s *MarkdownStore) DeleteProject(slug string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	unlock, err := s.lockStore()
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	projectDir := s.projectDir(slug)
-	if _, err := os.Stat(projectDir); err != nil {
+	entries, err := os.ReadDir(projectDir)
+	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return os.ErrNotExist
 		}
 		return err
 	}
-	return os.RemoveAll(projectDir)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			s.rememberRemoval(filepath.Join(projectDir, entry.Name()))
+		}
+	}
+	if err := s.moveProjectToTrash(slug, time.Now()); err != nil {
+		return err
+	}
+	s.forgetProjectLock(slug)
+	return nil
 }
 
-func (s *MarkdownStore) CreateCard(projectSlug, title, description, branch, status string) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// CreateCard adds a card to a project. A non-empty parentID makes the card a
+// child of that card, which may live in another project. Creating it in a
+// status at its WIP limit needs force.
+func (s *MarkdownStore) CreateCard(projectSlug, title, description, branch, status, parentID string, force bool) (_ model.Card, err error) {
+	parentSlug, _, _ := model.ParseCardID(parentID)
+	unlock, err := s.lockProjects(projectSlug, parentSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
 
 	title = strings.TrimSpace(title)
 	if title == "" {
 		return model.Card{}, errors.New("title is required")
 	}
-	if err := validateStatus(status); err != nil {
+	project, err := s.loadProject(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if err := validateStatus(project, status); err != nil {
 		return model.Card{}, err
 	}
 	branch = strings.TrimSpace(branch)
 	if err := validateBranchName(branch); err != nil {
 		return model.Card{}, err
 	}
-	project, err := s.loadProject(projectSlug)
+	parentID, err = s.validateParentUnlocked(parentID)
 	if err != nil {
 		return model.Card{}, err
 	}
 	now := time.Now().UTC()
 	number := project.NextCardSeq
+	if err := s.checkNextCardFree(project); err != nil {
+		return model.Card{}, err
+	}
 	project.NextCardSeq++
 	project.UpdatedAt = now
 
@@ -168,39 +372,91 @@ func (s *MarkdownStore) CreateCard(projectSlug, title, description, branch, stat
 		Title:                     title,
 		Branch:                    branch,
 		Status:                    status,
+		ParentID:                  parentID,
 		Deleted:                   false,
 		CreatedAt:                 now,
 		UpdatedAt:                 now,
 		NextTodoID:                1,
 		NextAcceptanceCriterionID: 1,
 	}
+	if card.Rank, err = s.rankCardUnlocked(card, model.CardPosition{}); err != nil {
+		return model.Card{}, err
+	}
 	if strings.TrimSpace(description) != "" {
 		card.Description = append(card.Description, model.TextEvent{Timestamp: now, Body: strings.TrimSpace(description)})
 	}
+	details := fmt.Sprintf("status=%s", status)
+	if parentID != "" {
+		details += fmt.Sprintf(" parent=%s", parentID)
+	}
 	card.History = append(card.History, model.HistoryEvent{
 		Timestamp: now,
 		Type:      "card.created",
-		Details:   fmt.Sprintf("status=%s", status),
+		Details:   details,
 	})
+	if err := s.checkWIPLimitUnlocked(project, &card, force, now); err != nil {
+		return model.Card{}, err
+	}
 
-	if err := s.writeCard(card); err != nil {
+	tx := s.beginJournal("card.create")
+	defer tx.end(&err)
+	if err := tx.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
-	if err := s.writeProject(project); err != nil {
+	if err := tx.writeProject(project); err != nil {
+		return model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
+// checkNextCardFree fails with os.ErrExist when a card file already sits at
+// the project's next card number. That means project.md fell behind, for
+// example through a hand edit; writing the next card would lose that card.
+func (s *MarkdownStore) checkNextCardFree(project model.Project) error {
+	number := project.NextCardSeq
+	if _, err := os.Stat(s.cardPath(project.Slug, number)); err == nil {
+		return fmt.Errorf("card-%d.md already exists in project %s, whose next_card_seq is %d (run kanban doctor --fix): %w", number, project.Slug, number, os.ErrExist)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
 func (s *MarkdownStore) GetCard(projectSlug string, number int) (model.Card, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockProject(projectSlug)()
 
 	return s.getCardUnlocked(projectSlug, number)
 }
 
+// getCardAtRevisionUnlocked reads a card about to be changed. When
+// expectedRevision is positive and the card is at another revision it fails
+// with a *model.StaleRevisionError; the caller's project lock keeps the card
+// from changing between this check and the write.
+func (s *MarkdownStore) getCardAtRevisionUnlocked(projectSlug string, number, expectedRevision int) (model.Card, error) {
+	card, err := s.getCardUnlocked(projectSlug, number)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if expectedRevision > 0 && card.Revision != expectedRevision {
+		return model.Card{}, &model.StaleRevisionError{Expected: expectedRevision, Current: card}
+	}
+	return card, nil
+}
+
 func (s *MarkdownStore) getCardUnlocked(projectSlug string, number int) (model.Card, error) {
-	data, err := os.ReadFile(s.cardPath(projectSlug, number))
+	path := s.cardPath(projectSlug, number)
+	info, err := os.Stat(path)
+	if err != nil {
+		s.cards.forget(path)
+		return model.Card{}, err
+	}
+	if card, ok := s.cards.get(path, info); ok {
+		return card, nil
+	}
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return model.Card{}, err
 	}
@@ -208,18 +464,22 @@ func (s *MarkdownStore) getCardUnlocked(projectSlug string, number int) (model.C
 	if err != nil {
 		return model.Card{}, err
 	}
+	s.cards.put(path, info, card)
 	return card, nil
 }
 
-func (s *MarkdownStore) AppendDescription(projectSlug string, number int, body string) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) AppendDescription(projectSlug string, number int, body string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
 
 	body = strings.TrimSpace(body)
 	if body == "" {
 		return model.Card{}, errors.New("description body is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
 		return model.Card{}, err
 	}
@@ -227,21 +487,24 @@ func (s *MarkdownStore) AppendDescription(projectSlug string, number int, body s
 	card.Description = append(card.Description, model.TextEvent{Timestamp: now, Body: body})
 	card.UpdatedAt = now
 	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.updated", Details: "description appended"})
-	if err := s.writeCard(card); err != nil {
+	if err := s.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
-func (s *MarkdownStore) AddComment(projectSlug string, number int, body string) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) AddComment(projectSlug string, number int, body string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
 
 	body = strings.TrimSpace(body)
 	if body == "" {
 		return model.Card{}, errors.New("comment body is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
 		return model.Card{}, err
 	}
@@ -249,23 +512,26 @@ func (s *MarkdownStore) AddComment(projectSlug string, number int, body string)
 	card.Comments = append(card.Comments, model.TextEvent{Timestamp: now, Body: body})
 	card.UpdatedAt = now
 	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.commented", Details: "comment appended"})
-	if err := s.writeCard(card); err != nil {
+	if err := s.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
-func (s *MarkdownStore) AddTodo(projectSlug string, number int, text string) (model.Todo, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) AddTodo(projectSlug string, number int, text string, expectedRevision int) (model.Todo, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Todo{}, model.Card{}, err
+	}
+	defer unlock()
 
 	text = strings.TrimSpace(text)
 	if text == "" {
-		return model.Todo{}, errors.New("todo text is required")
+		return model.Todo{}, model.Card{}, errors.New("todo text is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.Todo{}, err
+		return model.Todo{}, model.Card{}, err
 	}
 
 	now := time.Now().UTC()
@@ -282,15 +548,14 @@ func (s *MarkdownStore) AddTodo(projectSlug string, number int, text string) (mo
 		Type:      "card.todo.added",
 		Details:   fmt.Sprintf("todo_id=%d", todo.ID),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.Todo{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.Todo{}, model.Card{}, err
 	}
-	return todo, nil
+	return todo, card, nil
 }
 
 func (s *MarkdownStore) ListTodos(projectSlug string, number int) ([]model.Todo, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockProject(projectSlug)()
 
 	card, err := s.getCardUnlocked(projectSlug, number)
 	if err != nil {
@@ -304,51 +569,92 @@ func (s *MarkdownStore) ListTodos(projectSlug string, number int) ([]model.Todo,
 	return out, nil
 }
 
-func (s *MarkdownStore) SetTodoCompleted(projectSlug string, number int, todoID int, completed bool) (model.Todo, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// UpdateTodo edits a todo's text, completion and position. Moving a todo keeps
+// its ID; the new order is what the Todos section is written in.
+func (s *MarkdownStore) UpdateTodo(projectSlug string, number int, todoID int, patch model.ChecklistItemPatch, expectedRevision int) (model.Todo, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Todo{}, model.Card{}, err
+	}
+	defer unlock()
 
 	if todoID <= 0 {
-		return model.Todo{}, errors.New("todo id is required")
+		return model.Todo{}, model.Card{}, errors.New("todo id is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	if patch.Text == nil && patch.Completed == nil && patch.Position == nil {
+		return model.Todo{}, model.Card{}, errors.New("at least one field is required")
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.Todo{}, err
+		return model.Todo{}, model.Card{}, err
 	}
 
 	idx := indexOfTodo(card.Todos, todoID)
 	if idx < 0 {
-		return model.Todo{}, os.ErrNotExist
+		return model.Todo{}, model.Card{}, os.ErrNotExist
+	}
+	todo := card.Todos[idx]
+	var changes []string
+	if patch.Text != nil {
+		text := strings.TrimSpace(*patch.Text)
+		if text == "" {
+			return model.Todo{}, model.Card{}, errors.New("todo text is required")
+		}
+		if text != todo.Text {
+			changes = append(changes, fieldChange("text", todo.Text, text))
+			todo.Text = text
+		}
+	}
+	if patch.Completed != nil && *patch.Completed != todo.Completed {
+		todo.Completed = *patch.Completed
+		changes = append(changes, fmt.Sprintf("completed=%t", todo.Completed))
 	}
+	card.Todos[idx] = todo
+	if patch.Position != nil {
+		moved, change, err := moveChecklistItem(card.Todos, idx, *patch.Position)
+		if err != nil {
+			return model.Todo{}, model.Card{}, err
+		}
+		card.Todos = moved
+		if change != "" {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return todo, card, nil
+	}
+
 	now := time.Now().UTC()
-	card.Todos[idx].Completed = completed
 	card.UpdatedAt = now
 	card.History = append(card.History, model.HistoryEvent{
 		Timestamp: now,
 		Type:      "card.todo.updated",
-		Details:   fmt.Sprintf("todo_id=%d completed=%t", todoID, completed),
+		Details:   fmt.Sprintf("todo_id=%d %s", todoID, strings.Join(changes, "; ")),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.Todo{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.Todo{}, model.Card{}, err
 	}
-	return card.Todos[idx], nil
+	return todo, card, nil
 }
 
-func (s *MarkdownStore) DeleteTodo(projectSlug string, number int, todoID int) (model.Todo, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) DeleteTodo(projectSlug string, number int, todoID int, expectedRevision int) (model.Todo, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Todo{}, model.Card{}, err
+	}
+	defer unlock()
 
 	if todoID <= 0 {
-		return model.Todo{}, errors.New("todo id is required")
+		return model.Todo{}, model.Card{}, errors.New("todo id is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.Todo{}, err
+		return model.Todo{}, model.Card{}, err
 	}
 
 	idx := indexOfTodo(card.Todos, todoID)
 	if idx < 0 {
-		return model.Todo{}, os.ErrNotExist
+		return model.Todo{}, model.Card{}, os.ErrNotExist
 	}
 	removed := card.Todos[idx]
 	card.Todos = append(card.Todos[:idx], card.Todos[idx+1:]...)
@@ -359,23 +665,26 @@ func (s *MarkdownStore) DeleteTodo(projectSlug string, number int, todoID int) (
 		Type:      "card.todo.deleted",
 		Details:   fmt.Sprintf("todo_id=%d", todoID),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.Todo{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.Todo{}, model.Card{}, err
 	}
-	return removed, nil
+	return removed, card, nil
 }
 
-func (s *MarkdownStore) AddAcceptanceCriterion(projectSlug string, number int, text string) (model.AcceptanceCriterion, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) AddAcceptanceCriterion(projectSlug string, number int, text string, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
+	}
+	defer unlock()
 
 	text = strings.TrimSpace(text)
 	if text == "" {
-		return model.AcceptanceCriterion{}, errors.New("acceptance criterion text is required")
+		return model.AcceptanceCriterion{}, model.Card{}, errors.New("acceptance criterion text is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.AcceptanceCriterion{}, err
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
 
 	now := time.Now().UTC()
@@ -392,15 +701,14 @@ func (s *MarkdownStore) AddAcceptanceCriterion(projectSlug string, number int, t
 		Type:      "card.acceptance.added",
 		Details:   fmt.Sprintf("criterion_id=%d", criterion.ID),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.AcceptanceCriterion{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
-	return criterion, nil
+	return criterion, card, nil
 }
 
 func (s *MarkdownStore) ListAcceptanceCriteria(projectSlug string, number int) ([]model.AcceptanceCriterion, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockProject(projectSlug)()
 
 	card, err := s.getCardUnlocked(projectSlug, number)
 	if err != nil {
@@ -414,51 +722,92 @@ func (s *MarkdownStore) ListAcceptanceCriteria(projectSlug string, number int) (
 	return out, nil
 }
 
-func (s *MarkdownStore) SetAcceptanceCriterionCompleted(projectSlug string, number int, criterionID int, completed bool) (model.AcceptanceCriterion, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// UpdateAcceptanceCriterion edits a criterion's text, completion and position
+// the same way UpdateTodo does for todos.
+func (s *MarkdownStore) UpdateAcceptanceCriterion(projectSlug string, number int, criterionID int, patch model.ChecklistItemPatch, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
+	}
+	defer unlock()
 
 	if criterionID <= 0 {
-		return model.AcceptanceCriterion{}, errors.New("criterion id is required")
+		return model.AcceptanceCriterion{}, model.Card{}, errors.New("criterion id is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	if patch.Text == nil && patch.Completed == nil && patch.Position == nil {
+		return model.AcceptanceCriterion{}, model.Card{}, errors.New("at least one field is required")
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.AcceptanceCriterion{}, err
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
 
 	idx := indexOfAcceptanceCriterion(card.AcceptanceCriteria, criterionID)
 	if idx < 0 {
-		return model.AcceptanceCriterion{}, os.ErrNotExist
+		return model.AcceptanceCriterion{}, model.Card{}, os.ErrNotExist
+	}
+	criterion := card.AcceptanceCriteria[idx]
+	var changes []string
+	if patch.Text != nil {
+		text := strings.TrimSpace(*patch.Text)
+		if text == "" {
+			return model.AcceptanceCriterion{}, model.Card{}, errors.New("acceptance criterion text is required")
+		}
+		if text != criterion.Text {
+			changes = append(changes, fieldChange("text", criterion.Text, text))
+			criterion.Text = text
+		}
+	}
+	if patch.Completed != nil && *patch.Completed != criterion.Completed {
+		criterion.Completed = *patch.Completed
+		changes = append(changes, fmt.Sprintf("completed=%t", criterion.Completed))
+	}
+	card.AcceptanceCriteria[idx] = criterion
+	if patch.Position != nil {
+		moved, change, err := moveChecklistItem(card.AcceptanceCriteria, idx, *patch.Position)
+		if err != nil {
+			return model.AcceptanceCriterion{}, model.Card{}, err
+		}
+		card.AcceptanceCriteria = moved
+		if change != "" {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return criterion, card, nil
 	}
+
 	now := time.Now().UTC()
-	card.AcceptanceCriteria[idx].Completed = completed
 	card.UpdatedAt = now
 	card.History = append(card.History, model.HistoryEvent{
 		Timestamp: now,
 		Type:      "card.acceptance.updated",
-		Details:   fmt.Sprintf("criterion_id=%d completed=%t", criterionID, completed),
+		Details:   fmt.Sprintf("criterion_id=%d %s", criterionID, strings.Join(changes, "; ")),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.AcceptanceCriterion{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
-	return card.AcceptanceCriteria[idx], nil
+	return criterion, card, nil
 }
 
-func (s *MarkdownStore) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int) (model.AcceptanceCriterion, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) DeleteAcceptanceCriterion(projectSlug string, number int, criterionID int, expectedRevision int) (model.AcceptanceCriterion, model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
+	}
+	defer unlock()
 
 	if criterionID <= 0 {
-		return model.AcceptanceCriterion{}, errors.New("criterion id is required")
+		return model.AcceptanceCriterion{}, model.Card{}, errors.New("criterion id is required")
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
-		return model.AcceptanceCriterion{}, err
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
 
 	idx := indexOfAcceptanceCriterion(card.AcceptanceCriteria, criterionID)
 	if idx < 0 {
-		return model.AcceptanceCriterion{}, os.ErrNotExist
+		return model.AcceptanceCriterion{}, model.Card{}, os.ErrNotExist
 	}
 	removed := card.AcceptanceCriteria[idx]
 	card.AcceptanceCriteria = append(card.AcceptanceCriteria[:idx], card.AcceptanceCriteria[idx+1:]...)
@@ -469,43 +818,90 @@ func (s *MarkdownStore) DeleteAcceptanceCriterion(projectSlug string, number int
 		Type:      "card.acceptance.deleted",
 		Details:   fmt.Sprintf("criterion_id=%d", criterionID),
 	})
-	if err := s.writeCard(card); err != nil {
-		return model.AcceptanceCriterion{}, err
+	if err := s.writeCard(&card); err != nil {
+		return model.AcceptanceCriterion{}, model.Card{}, err
 	}
-	return removed, nil
+	return removed, card, nil
 }
 
-func (s *MarkdownStore) MoveCard(projectSlug string, number int, status string) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// MoveCard changes a card's status and places it in that status column; see
+// model.CardPosition. Moving it against the project's transition rules, out
+// of the first status while open cards block it (see
+// model.Project.GuardsBlockedMove), or into a status at its WIP limit needs
+// force.
+func (s *MarkdownStore) MoveCard(projectSlug string, number int, status string, position model.CardPosition, force bool, expectedRevision int) (model.Card, error) {
+	lock := func() (func(), error) { return s.lockProjectsWithBlockers(projectSlug, number) }
+	if force {
+		lock = func() (func(), error) { return s.lockProjects(projectSlug) }
+	}
+	unlock, err := lock()
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
 
-	if err := validateStatus(status); err != nil {
+	project, err := s.loadProject(projectSlug)
+	if err != nil {
 		return model.Card{}, err
 	}
-	card, err := s.getCardUnlocked(projectSlug, number)
+	if err := validateStatus(project, status); err != nil {
+		return model.Card{}, err
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
 		return model.Card{}, err
 	}
 	now := time.Now().UTC()
+	entered := status != card.Status
+	overridden, err := checkTransitionRules(project, card, status, force, now)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if err := s.checkBlockersUnlocked(project, card, status, force); err != nil {
+		return model.Card{}, err
+	}
 	card.Status = status
+	details := fmt.Sprintf("status=%s", status)
+	if entered || position != (model.CardPosition{}) {
+		if card.Rank, err = s.rankCardUnlocked(card, position); err != nil {
+			return model.Card{}, err
+		}
+		switch {
+		case position.Before != 0:
+			details += fmt.Sprintf(" before=%d", position.Before)
+		case position.After != 0:
+			details += fmt.Sprintf(" after=%d", position.After)
+		}
+	}
 	card.UpdatedAt = now
-	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.moved", Details: fmt.Sprintf("status=%s", status)})
-	if err := s.writeCard(card); err != nil {
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.moved", Details: details})
+	if overridden != nil {
+		card.History = append(card.History, *overridden)
+	}
+	if entered {
+		if err := s.checkWIPLimitUnlocked(project, &card, force, now); err != nil {
+			return model.Card{}, err
+		}
+	}
+	if err := s.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
-func (s *MarkdownStore) SetCardBranch(projectSlug string, number int, branch string) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *MarkdownStore) SetCardBranch(projectSlug string, number int, branch string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
 
 	branch = strings.TrimSpace(branch)
 	if err := validateBranchName(branch); err != nil {
 		return model.Card{}, err
 	}
 
-	card, err := s.getCardUnlocked(projectSlug, number)
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
 		return model.Card{}, err
 	}
@@ -518,25 +914,288 @@ func (s *MarkdownStore) SetCardBranch(projectSlug string, number int, branch str
 		Type:      "card.branch.updated",
 		Details:   fmt.Sprintf("branch=%s", branch),
 	})
-	if err := s.writeCard(card); err != nil {
+	if err := s.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
-func (s *MarkdownStore) DeleteCard(projectSlug string, number int, hard bool) (model.Card, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// UpdateCard changes the fields patch names. A status change is checked like
+// MoveCard and needs force in the same cases.
+func (s *MarkdownStore) UpdateCard(projectSlug string, number int, patch model.CardPatch, force bool, expectedRevision int) (model.Card, error) {
+	lock := func() (func(), error) { return s.lockProjects(projectSlug) }
+	if patch.Status != nil && !force {
+		lock = func() (func(), error) { return s.lockProjectsWithBlockers(projectSlug, number) }
+	}
+	unlock, err := lock()
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	if patch.Title == nil && patch.Branch == nil && patch.Status == nil {
+		return model.Card{}, errors.New("at least one field is required")
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, err
+	}
 
-	card, err := s.getCardUnlocked(projectSlug, number)
+	var (
+		changes    []string
+		entered    *model.Project // set when the card enters a new status
+		overridden *model.HistoryEvent
+	)
+	if patch.Title != nil {
+		title := strings.TrimSpace(*patch.Title)
+		if title == "" {
+			return model.Card{}, errors.New("title is required")
+		}
+		if title != card.Title {
+			changes = append(changes, fieldChange("title", card.Title, title))
+			card.Title = title
+		}
+	}
+	if patch.Branch != nil {
+		branch := strings.TrimSpace(*patch.Branch)
+		if err := validateBranchName(branch); err != nil {
+			return model.Card{}, err
+		}
+		if branch != card.Branch {
+			changes = append(changes, fieldChange("branch", card.Branch, branch))
+			card.Branch = branch
+		}
+	}
+	if patch.Status != nil {
+		status := strings.TrimSpace(*patch.Status)
+		project, err := s.loadProject(projectSlug)
+		if err != nil {
+			return model.Card{}, err
+		}
+		if err := validateStatus(project, status); err != nil {
+			return model.Card{}, err
+		}
+		if status != card.Status {
+			if overridden, err = checkTransitionRules(project, card, status, force, time.Now().UTC()); err != nil {
+				return model.Card{}, err
+			}
+			if err := s.checkBlockersUnlocked(project, card, status, force); err != nil {
+				return model.Card{}, err
+			}
+			changes = append(changes, fieldChange("status", card.Status, status))
+			card.Status = status
+			if card.Rank, err = s.rankCardUnlocked(card, model.CardPosition{}); err != nil {
+				return model.Card{}, err
+			}
+			entered = &project
+		}
+	}
+	if len(changes) == 0 {
+		return card, nil
+	}
+
+	now := time.Now().UTC()
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.updated",
+		Details:   strings.Join(changes, "; "),
+	})
+	if overridden != nil {
+		overridden.Timestamp = now
+		card.History = append(card.History, *overridden)
+	}
+	if entered != nil {
+		if err := s.checkWIPLimitUnlocked(*entered, &card, force, now); err != nil {
+			return model.Card{}, err
+		}
+	}
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+// AddLabel tags a card. Labels are kept lowercase and sorted.
+func (s *MarkdownStore) AddLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	label, err = validateLabel(label)
+	if err != nil {
+		return model.Card{}, err
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
 	if err != nil {
 		return model.Card{}, err
 	}
+	if slices.Contains(card.Labels, label) {
+		return model.Card{}, fmt.Errorf("card %d already has label %q", number, label)
+	}
+	now := time.Now().UTC()
+	card.Labels = normalizeLabels(append(card.Labels, label))
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.label.added", Details: fmt.Sprintf("label=%s", label)})
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+func (s *MarkdownStore) RemoveLabel(projectSlug string, number int, label string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	label, err = validateLabel(label)
+	if err != nil {
+		return model.Card{}, err
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, err
+	}
+	idx := slices.Index(card.Labels, label)
+	if idx < 0 {
+		return model.Card{}, fmt.Errorf("card %d has no label %q", number, label)
+	}
+	now := time.Now().UTC()
+	card.Labels = slices.Delete(card.Labels, idx, idx+1)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.label.removed", Details: fmt.Sprintf("label=%s", label)})
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+// SetCardPriority sets or, given an empty priority, clears a card's priority.
+func (s *MarkdownStore) SetCardPriority(projectSlug string, number int, priority string, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	priority, err = validatePriority(priority)
+	if err != nil {
+		return model.Card{}, err
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if card.Priority == priority {
+		return card, nil
+	}
+	now := time.Now().UTC()
+	details := fieldChange("priority", card.Priority, priority)
+	card.Priority = priority
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.priority.updated", Details: details})
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+// SetCardDue sets or, given nil, clears a card's due date. Due dates are kept
+// in UTC at second precision.
+func (s *MarkdownStore) SetCardDue(projectSlug string, number int, dueAt *time.Time, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if dueAt != nil {
+		due := dueAt.UTC().Truncate(time.Second)
+		dueAt = &due
+	}
+	if formatDue(card.DueAt) == formatDue(dueAt) {
+		return card, nil
+	}
+	now := time.Now().UTC()
+	details := fieldChange("due_at", formatDue(card.DueAt), formatDue(dueAt))
+	card.DueAt = dueAt
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.due.updated", Details: details})
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+func formatDue(dueAt *time.Time) string {
+	if dueAt == nil {
+		return ""
+	}
+	return dueAt.UTC().Format(time.RFC3339)
+}
+
+// moveChecklistItem moves items[idx] to the 1-based position and describes the
+// move for history; an unchanged position yields an empty description.
+func moveChecklistItem[T any](items []T, idx, position int) ([]T, string, error) {
+	if position < 1 || position > len(items) {
+		return nil, "", fmt.Errorf("position must be between 1 and %d", len(items))
+	}
+	if position-1 == idx {
+		return items, "", nil
+	}
+	item := items[idx]
+	items = slices.Insert(slices.Delete(items, idx, idx+1), position-1, item)
+	return items, fmt.Sprintf("position: %d -> %d", idx+1, position), nil
+}
+
+func fieldChange(field, from, to string) string {
+	return fmt.Sprintf("%s: %q -> %q", field, from, to)
+}
+
+// DeleteCard marks a card deleted, or with hard removes its file. A hard
+// delete also updates related and child cards, which may live in any project.
+func (s *MarkdownStore) DeleteCard(projectSlug string, number int, hard bool, expectedRevision int) (_ model.Card, err error) {
+	lock := func() (func(), error) { return s.lockProjects(projectSlug) }
 	if hard {
-		if err := os.Remove(s.cardPath(projectSlug, number)); err != nil {
+		lock = s.lockStore
+	}
+	unlock, err := lock()
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, err
+	}
+	if hard {
+		tx := s.beginJournal("card.delete")
+		defer tx.end(&err)
+		if err := tx.remove(s.cardPath(projectSlug, number)); err != nil {
 			return model.Card{}, err
 		}
 		now := time.Now().UTC()
+		if err := s.unlinkAllRelationsUnlocked(tx, card, now); err != nil {
+			return model.Card{}, err
+		}
+		if err := s.reparentChildrenUnlocked(tx, card.ID, "", now); err != nil {
+			return model.Card{}, err
+		}
+		if err := tx.commit(); err != nil {
+			return model.Card{}, err
+		}
+		if err := os.RemoveAll(s.attachmentsDir(projectSlug, number)); err != nil {
+			return model.Card{}, err
+		}
 		card.UpdatedAt = now
 		card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.deleted_hard", Details: "file removed"})
 		return card, nil
@@ -545,31 +1204,198 @@ func (s *MarkdownStore) DeleteCard(projectSlug string, number int, hard bool) (m
 	card.Deleted = true
 	card.UpdatedAt = now
 	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.deleted_soft", Details: "marked deleted"})
-	if err := s.writeCard(card); err != nil {
+	if err := s.writeCard(&card); err != nil {
 		return model.Card{}, err
 	}
 	return card, nil
 }
 
+// RestoreCard clears the deleted flag of a soft-deleted card. Hard-deleted
+// cards have no file left to restore; they are told apart from cards that
+// never existed by the project's card sequence.
+func (s *MarkdownStore) RestoreCard(projectSlug string, number int, expectedRevision int) (model.Card, error) {
+	unlock, err := s.lockProjects(projectSlug)
+	if err != nil {
+		return model.Card{}, err
+	}
+	defer unlock()
+
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, err
+		}
+		project, projectErr := s.loadProject(projectSlug)
+		if projectErr == nil && number > 0 && number < project.NextCardSeq {
+			return model.Card{}, fmt.Errorf("card %d was hard deleted and cannot be restored", number)
+		}
+		return model.Card{}, err
+	}
+	if card.MovedTo != "" {
+		return model.Card{}, fmt.Errorf("card %d was moved to %s and cannot be restored", number, card.MovedTo)
+	}
+	if !card.Deleted {
+		return model.Card{}, fmt.Errorf("card %d is not deleted", number)
+	}
+	now := time.Now().UTC()
+	card.Deleted = false
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.restored", Details: "deleted flag cleared"})
+	if err := s.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+// MoveCardToProject re-files a card under the next number of another project,
+// carrying over its content and history. The source file is kept as a deleted
+// tombstone whose MovedTo names the new card, so old references still resolve.
+// Related cards and child cards are updated to point at the new card.
+func (s *MarkdownStore) MoveCardToProject(projectSlug string, number int, targetSlug string, expectedRevision int) (_, _ model.Card, err error) {
+	unlock, err := s.lockStore()
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	defer unlock()
+
+	targetSlug = strings.TrimSpace(targetSlug)
+	if targetSlug == "" {
+		return model.Card{}, model.Card{}, errors.New("target project is required")
+	}
+	if targetSlug == projectSlug {
+		return model.Card{}, model.Card{}, fmt.Errorf("card %d is already in project %s", number, projectSlug)
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if card.MovedTo != "" {
+		return model.Card{}, model.Card{}, fmt.Errorf("card %d was already moved to %s", number, card.MovedTo)
+	}
+	if card.Deleted {
+		return model.Card{}, model.Card{}, fmt.Errorf("card %d is deleted; restore it before moving", number)
+	}
+	target, err := s.loadProject(targetSlug)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := s.checkNextCardFree(target); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+
+	source, err := s.loadProject(projectSlug)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+
+	now := time.Now().UTC()
+	moved := card
+	moved.ProjectSlug = targetSlug
+	moved.Number = target.NextCardSeq
+	moved.ID = fmt.Sprintf("%s/card-%d", targetSlug, moved.Number)
+	moved.Revision = 0
+	moved.UpdatedAt = now
+	details := fmt.Sprintf("moved from %s", card.ID)
+	if status := transferStatus(source, target, card.Status); status != card.Status {
+		moved.Status = status
+		details += "; " + fieldChange("status", card.Status, status)
+	}
+	if moved.Rank, err = s.rankCardUnlocked(moved, model.CardPosition{}); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	moved.History = append(append([]model.HistoryEvent{}, card.History...), model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.transferred",
+		Details:   details,
+	})
+	// Transfers are not refused over a WIP limit, only recorded.
+	if err := s.checkWIPLimitUnlocked(target, &moved, true, now); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	target.NextCardSeq++
+	target.UpdatedAt = now
+	tx := s.beginJournal("card.transfer")
+	defer tx.end(&err)
+	if err := s.moveAttachmentsUnlocked(tx, card, moved); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.writeCard(&moved); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.writeProject(target); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+
+	tombstone := card
+	tombstone.Deleted = true
+	tombstone.MovedTo = moved.ID
+	tombstone.UpdatedAt = now
+	tombstone.Description = nil
+	tombstone.Comments = nil
+	tombstone.Todos = nil
+	tombstone.AcceptanceCriteria = nil
+	tombstone.Labels = nil
+	tombstone.Priority = ""
+	tombstone.DueAt = nil
+	tombstone.Relations = nil
+	tombstone.ParentID = ""
+	tombstone.Notes = ""
+	tombstone.ExtraFrontmatter = ""
+	tombstone.ExtraSections = nil
+	tombstone.Attachments = nil
+	tombstone.History = append(tombstone.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.transferred",
+		Details:   fmt.Sprintf("moved to %s", moved.ID),
+	})
+	if err := tx.writeCard(&tombstone); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := s.relinkRelationsUnlocked(tx, card.ID, moved, now); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := s.reparentChildrenUnlocked(tx, card.ID, moved.ID, now); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	return moved, tombstone, nil
+}
+
+// Snapshot reads every project with its cards. Each project is read under its
+// own lock, one at a time, so writes to the other projects carry on; writes
+// that span projects wait until the snapshot is done.
 func (s *MarkdownStore) Snapshot() ([]model.Project, []model.Card, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	projects, err := s.ListProjects()
+	slugs, err := s.projectSlugsUnlocked()
 	if err != nil {
 		return nil, nil, err
 	}
+	projects := make([]model.Project, 0, len(slugs))
 	cards := make([]model.Card, 0)
-	for _, p := range projects {
-		projectCards, err := s.listProjectCards(p.Slug)
+	for _, slug := range slugs {
+		lock := s.projectLock(slug)
+		lock.RLock()
+		project, err := s.loadProject(slug)
+		var projectCards []model.Card
+		if err == nil {
+			projectCards, err = s.listProjectCards(slug)
+		}
+		lock.RUnlock()
 		if err != nil {
 			return nil, nil, err
 		}
+		projects = append(projects, project)
 		cards = append(cards, projectCards...)
 	}
 	return projects, cards, nil
 }
 
+// listProjectCards reads every card of a project. Callers hold the project's
+// lock, or s.mu exclusively.
 func (s *MarkdownStore) listProjectCards(projectSlug string) ([]model.Card, error) {
 	dirEntries, err := os.ReadDir(s.projectDir(projectSlug))
 	if err != nil {
@@ -587,7 +1413,7 @@ func (s *MarkdownStore) listProjectCards(projectSlug string) ([]model.Card, erro
 		if !ok {
 			continue
 		}
-		card, err := s.GetCard(projectSlug, number)
+		card, err := s.getCardUnlocked(projectSlug, number)
 		if err != nil {
 			return nil, err
 		}
@@ -602,7 +1428,11 @@ func (s *MarkdownStore) loadProject(slug string) (model.Project, error) {
 	if err != nil {
 		return model.Project{}, err
 	}
-	yml, _, err := splitFrontmatter(data)
+	return parseProject(data, slug)
+}
+
+func parseProject(data []byte, slug string) (model.Project, error) {
+	yml, body, err := splitFrontmatter(data)
 	if err != nil {
 		return model.Project{}, err
 	}
@@ -610,13 +1440,29 @@ func (s *MarkdownStore) loadProject(slug string) (model.Project, error) {
 	if err := yaml.Unmarshal(yml, &fm); err != nil {
 		return model.Project{}, err
 	}
+	if fm.FormatVersion != formatVersion {
+		if data, err = migrateFile(data, fm.FormatVersion, formatVersion, projectMigrations); err != nil {
+			return model.Project{}, err
+		}
+		return parseProject(data, slug)
+	}
+	extra, err := extraFrontmatter(yml, projectFrontmatterKeys)
+	if err != nil {
+		return model.Project{}, err
+	}
 	if fm.Slug == "" {
 		fm.Slug = slug
 	}
 	if fm.NextCardSeq <= 0 {
 		fm.NextCardSeq = 1
 	}
-	return model.Project{
+	// A project.md without statuses, or with a workflow the store would
+	// refuse, uses the default workflow.
+	statuses, err := normalizeStatuses(fm.Statuses)
+	if err != nil {
+		statuses = slices.Clone(model.DefaultStatuses)
+	}
+	project := model.Project{
 		Name:        fm.Name,
 		Slug:        fm.Slug,
 		LocalPath:   fm.LocalPath,
@@ -624,44 +1470,159 @@ func (s *MarkdownStore) loadProject(slug string) (model.Project, error) {
 		CreatedAt:   fm.CreatedAt,
 		UpdatedAt:   fm.UpdatedAt,
 		NextCardSeq: fm.NextCardSeq,
-	}, nil
+		Statuses:    statuses,
+		WIPLimits:   keepWIPLimits(fm.WIPLimits, statuses),
+
+		ExtraFrontmatter: extra,
+		ExtraSections:    extraSections(body, projectSections),
+	}
+	project.TransitionRules = keepTransitionRules(transitionRulesFromFrontmatter(fm.TransitionRules), project)
+	return project, nil
 }
 
 func (s *MarkdownStore) writeProject(p model.Project) error {
+	data, err := encodeProject(p)
+	if err != nil {
+		return err
+	}
+	return s.writeFile(s.projectPath(p.Slug), data)
+}
+
+func encodeProject(p model.Project) ([]byte, error) {
 	fm := projectFrontmatter{
-		Name:        p.Name,
-		Slug:        p.Slug,
-		LocalPath:   p.LocalPath,
-		RemoteURL:   p.RemoteURL,
-		CreatedAt:   p.CreatedAt,
-		UpdatedAt:   p.UpdatedAt,
-		NextCardSeq: p.NextCardSeq,
+		FormatVersion:   formatVersion,
+		Name:            p.Name,
+		Slug:            p.Slug,
+		LocalPath:       p.LocalPath,
+		RemoteURL:       p.RemoteURL,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		NextCardSeq:     p.NextCardSeq,
+		WIPLimits:       p.WIPLimits,
+		TransitionRules: transitionRulesToFrontmatter(p.TransitionRules),
+	}
+	if !slices.Equal(p.Statuses, model.DefaultStatuses) {
+		fm.Statuses = p.Statuses
 	}
 	yml, err := yaml.Marshal(&fm)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	var body strings.Builder
+	writeExtraSections(&body, p.ExtraSections, "")
+	body.WriteString("# Project\n")
+	body.WriteString(p.Name)
+	body.WriteByte('\n')
+	writeExtraSections(&body, p.ExtraSections, "Project")
 	buf := bytes.Buffer{}
 	buf.WriteString("---\n")
 	buf.Write(yml)
+	buf.WriteString(p.ExtraFrontmatter)
 	buf.WriteString("---\n")
-	buf.WriteString("# Project\n")
-	buf.WriteString(p.Name)
-	buf.WriteByte('\n')
-	return writeFileAtomic(s.projectPath(p.Slug), buf.Bytes(), 0o644)
+	buf.WriteString(body.String())
+	return buf.Bytes(), nil
 }
 
-func (s *MarkdownStore) writeCard(c model.Card) error {
-	yml, body, err := serializeCard(c)
+// writeCard bumps the card revision and persists it. Every store write goes
+// through here so clients can use the revision for optimistic concurrency.
+func (s *MarkdownStore) writeCard(c *model.Card) error {
+	c.Revision++
+	data, err := encodeCard(*c)
 	if err != nil {
 		return err
 	}
+	path := s.cardPath(c.ProjectSlug, c.Number)
+	if err := s.writeFile(path, data); err != nil {
+		return err
+	}
+	// An edit made outside the store right after the rename would be cached
+	// as this card; checking the size narrows that to an edit of the same
+	// length in the same instant.
+	if info, err := os.Stat(path); err == nil && info.Size() == int64(len(data)) {
+		s.cards.put(path, info, storedCard(*c))
+	}
+	return nil
+}
+
+func encodeCard(c model.Card) ([]byte, error) {
+	yml, body, err := serializeCard(c)
+	if err != nil {
+		return nil, err
+	}
 	buf := bytes.Buffer{}
 	buf.WriteString("---\n")
 	buf.Write(yml)
+	buf.WriteString(c.ExtraFrontmatter)
 	buf.WriteString("---\n")
 	buf.WriteString(body)
-	return writeFileAtomic(s.cardPath(c.ProjectSlug, c.Number), buf.Bytes(), 0o644)
+	return buf.Bytes(), nil
+}
+
+func (s *MarkdownStore) writeFile(path string, data []byte) error {
+	s.rememberWrite(path, data)
+	s.cards.forget(path)
+	return writeFileAtomic(path, data, 0o644)
+}
+
+// rememberWrite is called before the rename so a watcher can never observe the
+// new content ahead of the record. A few recent sums are kept because events
+// for consecutive writes may be observed after the file has moved on.
+func (s *MarkdownStore) rememberWrite(path string, data []byte) {
+	sum := sha256.Sum256(data)
+	s.knownMu.Lock()
+	defer s.knownMu.Unlock()
+
+	entry := s.known[path]
+	entry.removed = false
+	entry.sums = append(entry.sums, sum)
+	if len(entry.sums) > knownWritesPerFile {
+		entry.sums = entry.sums[len(entry.sums)-knownWritesPerFile:]
+	}
+	s.known[path] = entry
+}
+
+func (s *MarkdownStore) rememberRemoval(path string) {
+	s.knownMu.Lock()
+	defer s.knownMu.Unlock()
+
+	entry := s.known[path]
+	entry.removed = true
+	s.known[path] = entry
+	s.cards.forget(path)
+}
+
+// observe compares the current state of path with what the store last wrote or
+// observed there. It reports whether the file is gone and whether the state
+// differs from what the store already knows about.
+func (s *MarkdownStore) observe(path string) (removed bool, changed bool, err error) {
+	data, readErr := os.ReadFile(path)
+
+	s.knownMu.Lock()
+	defer s.knownMu.Unlock()
+
+	entry, seen := s.known[path]
+	if errors.Is(readErr, os.ErrNotExist) {
+		if seen && entry.removed {
+			return true, false, nil
+		}
+		s.known[path] = knownFile{removed: true}
+		return true, true, nil
+	}
+	if readErr != nil {
+		return false, false, readErr
+	}
+	sum := sha256.Sum256(data)
+	if seen {
+		for _, known := range entry.sums {
+			if known == sum {
+				entry.removed = false
+				s.known[path] = entry
+				return false, false, nil
+			}
+		}
+	}
+	s.known[path] = knownFile{sums: [][sha256.Size]byte{sum}}
+	return false, true, nil
 }
 
 func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
@@ -703,31 +1664,46 @@ func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
 
 func serializeCard(c model.Card) ([]byte, string, error) {
 	fm := cardFrontmatter{
+		FormatVersion:             formatVersion,
 		ID:                        c.ID,
 		ProjectSlug:               c.ProjectSlug,
 		Number:                    c.Number,
 		Title:                     c.Title,
 		Branch:                    c.Branch,
 		Status:                    c.Status,
+		Rank:                      c.Rank,
+		Labels:                    c.Labels,
+		Priority:                  c.Priority,
+		DueAt:                     c.DueAt,
+		Relations:                 relationsToFrontmatter(c.Relations),
+		Parent:                    c.ParentID,
+		Attachments:               attachmentsToFrontmatter(c.Attachments),
 		Deleted:                   c.Deleted,
+		Revision:                  c.Revision,
 		CreatedAt:                 c.CreatedAt,
 		UpdatedAt:                 c.UpdatedAt,
 		NextTodoID:                c.NextTodoID,
 		NextAcceptanceCriterionID: c.NextAcceptanceCriterionID,
+		MovedTo:                   c.MovedTo,
 	}
 	yml, err := yaml.Marshal(&fm)
 	if err != nil {
 		return nil, "", err
 	}
 	var body strings.Builder
+	writeExtraSections(&body, c.ExtraSections, "")
 	body.WriteString("# Description\n")
 	writeTextEvents(&body, c.Description)
+	writeExtraSections(&body, c.ExtraSections, "Description")
 	body.WriteString("\n# Todos\n")
 	writeTodos(&body, c.Todos)
+	writeExtraSections(&body, c.ExtraSections, "Todos")
 	body.WriteString("\n# Acceptance Criteria\n")
 	writeAcceptanceCriteria(&body, c.AcceptanceCriteria)
+	writeExtraSections(&body, c.ExtraSections, "Acceptance Criteria")
 	body.WriteString("\n# Comments\n")
 	writeTextEvents(&body, c.Comments)
+	writeExtraSections(&body, c.ExtraSections, "Comments")
 	body.WriteString("\n# History\n")
 	if len(c.History) == 0 {
 		body.WriteString("(none)\n")
@@ -738,10 +1714,11 @@ func serializeCard(c model.Card) ([]byte, string, error) {
 			body.WriteString(" | ")
 			body.WriteString(event.Type)
 			body.WriteByte('\n')
-			body.WriteString(strings.TrimSpace(event.Details))
+			body.WriteString(escapeBody(event.Details))
 			body.WriteString("\n\n")
 		}
 	}
+	writeExtraSections(&body, c.ExtraSections, "History")
 	return yml, body.String(), nil
 }
 
@@ -754,7 +1731,7 @@ func writeTextEvents(body *strings.Builder, events []model.TextEvent) {
 		body.WriteString("## ")
 		body.WriteString(event.Timestamp.UTC().Format(time.RFC3339))
 		body.WriteByte('\n')
-		body.WriteString(strings.TrimSpace(event.Body))
+		body.WriteString(escapeBody(event.Body))
 		body.WriteString("\n\n")
 	}
 }
@@ -774,7 +1751,7 @@ func writeTodos(body *strings.Builder, todos []model.Todo) {
 		body.WriteString(" | ")
 		body.WriteString(status)
 		body.WriteByte('\n')
-		body.WriteString(strings.TrimSpace(todo.Text))
+		body.WriteString(escapeBody(todo.Text))
 		body.WriteString("\n\n")
 	}
 }
@@ -794,7 +1771,7 @@ func writeAcceptanceCriteria(body *strings.Builder, criteria []model.AcceptanceC
 		body.WriteString(" | ")
 		body.WriteString(status)
 		body.WriteByte('\n')
-		body.WriteString(strings.TrimSpace(criterion.Text))
+		body.WriteString(escapeBody(criterion.Text))
 		body.WriteString("\n\n")
 	}
 }
@@ -846,6 +1823,17 @@ func parseCard(data []byte) (model.Card, error) {
 	if err := yaml.Unmarshal(yml, &fm); err != nil {
 		return model.Card{}, err
 	}
+	if fm.FormatVersion != formatVersion {
+		if data, err = migrateFile(data, fm.FormatVersion, formatVersion, cardMigrations); err != nil {
+			return model.Card{}, err
+		}
+		return parseCard(data)
+	}
+	extra, err := extraFrontmatter(yml, cardFrontmatterKeys)
+	if err != nil {
+		return model.Card{}, err
+	}
+	sections := extraSections(body, cardSections)
 	desc, todos, acceptanceCriteria, comments, history := parseSections(body)
 	nextTodo := fm.NextTodoID
 	if nextTodo <= 0 {
@@ -855,6 +1843,15 @@ func parseCard(data []byte) (model.Card, error) {
 	if nextAcceptanceCriterion <= 0 {
 		nextAcceptanceCriterion = nextAcceptanceCriterionID(acceptanceCriteria)
 	}
+	// Cards written before revisions existed, or created by hand, start at 1.
+	revision := fm.Revision
+	if revision <= 0 {
+		revision = 1
+	}
+	rank := strings.TrimSpace(fm.Rank)
+	if !validRank(rank) {
+		rank = defaultRank(fm.Number)
+	}
 	return model.Card{
 		ID:                        fm.ID,
 		ProjectSlug:               fm.ProjectSlug,
@@ -862,7 +1859,15 @@ func parseCard(data []byte) (model.Card, error) {
 		Title:                     fm.Title,
 		Branch:                    fm.Branch,
 		Status:                    fm.Status,
+		Rank:                      rank,
+		Labels:                    normalizeLabels(fm.Labels),
+		Priority:                  strings.ToUpper(strings.TrimSpace(fm.Priority)),
+		DueAt:                     fm.DueAt,
+		Relations:                 relationsFromFrontmatter(fm.Relations),
+		ParentID:                  strings.TrimSpace(fm.Parent),
+		Attachments:               attachmentsFromFrontmatter(fm.Attachments),
 		Deleted:                   fm.Deleted,
+		Revision:                  revision,
 		CreatedAt:                 fm.CreatedAt,
 		UpdatedAt:                 fm.UpdatedAt,
 		Description:               desc,
@@ -872,13 +1877,14 @@ func parseCard(data []byte) (model.Card, error) {
 		History:                   history,
 		NextTodoID:                nextTodo,
 		NextAcceptanceCriterionID: nextAcceptanceCriterion,
+		MovedTo:                   fm.MovedTo,
+		Notes:                     notesOf(sections),
+		ExtraFrontmatter:          extra,
+		ExtraSections:             sections,
 	}, nil
 }
 
 func parseSections(body string) ([]model.TextEvent, []model.Todo, []model.AcceptanceCriterion, []model.TextEvent, []model.HistoryEvent) {
-	scanner := bufio.NewScanner(strings.NewReader(body))
-	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
-
 	var (
 		section string
 		heading string
@@ -894,12 +1900,15 @@ func parseSections(body string) ([]model.TextEvent, []model.Todo, []model.Accept
 		if heading == "" {
 			return
 		}
+		// "(none)" marks an empty list; a body that really is "(none)" is
+		// escaped, so the check runs before unescaping.
 		text := strings.TrimSpace(strings.Join(lines, "\n"))
-		if text == "" || text == "(none)" {
+		if (text == "" && section != "History") || text == "(none)" {
 			heading = ""
 			lines = nil
 			return
 		}
+		text = unescapeBody(text)
 		switch section {
 		case "Description":
 			if ts, err := time.Parse(time.RFC3339, heading); err == nil {
@@ -947,8 +1956,7 @@ func parseSections(body string) ([]model.TextEvent, []model.Todo, []model.Accept
 		lines = nil
 	}
 
-	for scanner.Scan() {
-		line := scanner.Text()
+	for _, line := range strings.Split(body, "\n") {
 		if strings.HasPrefix(line, "# ") {
 			flush()
 			section = strings.TrimSpace(strings.TrimPrefix(line, "# "))
@@ -982,17 +1990,66 @@ func splitFrontmatter(data []byte) ([]byte, string, error) {
 	return []byte(yml), body, nil
 }
 
-func validateStatus(status string) error {
+func validateStatus(project model.Project, status string) error {
 	status = strings.TrimSpace(status)
 	if status == "" {
 		return errors.New("status is required")
 	}
-	if _, ok := model.AllowedStatus[status]; !ok {
-		return fmt.Errorf("invalid status %q", status)
+	if !project.HasStatus(status) {
+		return fmt.Errorf("invalid status %q: project %s uses %s", status, project.Slug, strings.Join(project.Statuses, ", "))
 	}
 	return nil
 }
 
+// validatePriority returns the canonical upper-case form of a priority. An
+// empty priority is valid and means none.
+func validatePriority(priority string) (string, error) {
+	priority = strings.ToUpper(strings.TrimSpace(priority))
+	if priority == "" {
+		return "", nil
+	}
+	if _, ok := model.AllowedPriority[priority]; !ok {
+		return "", fmt.Errorf("invalid priority %q: use P0, P1, P2 or P3", priority)
+	}
+	return priority, nil
+}
+
+var labelPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+const maxLabelLength = 50
+
+// validateLabel returns the canonical lowercase form of a label.
+func validateLabel(label string) (string, error) {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == "" {
+		return "", errors.New("label is required")
+	}
+	if len(label) > maxLabelLength {
+		return "", fmt.Errorf("label must be at most %d characters", maxLabelLength)
+	}
+	if !labelPattern.MatchString(label) {
+		return "", fmt.Errorf("invalid label %q: use letters, digits, '.', '_' and '-'", label)
+	}
+	return label, nil
+}
+
+// normalizeLabels lowercases, sorts and de-duplicates labels read from disk,
+// dropping blank ones a hand edit may have left behind.
+func normalizeLabels(labels []string) []string {
+	if len(labels) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(strings.TrimSpace(label))
+		if label != "" {
+			out = append(out, label)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
 func validateBranchName(branch string) error {
 	branch = strings.TrimSpace(branch)
 	if branch == "" {
@@ -1,12 +1,19 @@
 package store
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
 )
 
 func TestMarkdownStoreProjectAndCardLifecycle(t *testing.T) {
@@ -31,7 +38,7 @@ func TestMarkdownStoreProjectAndCardLifecycle(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "Alpha Project", loadedProject.Name)
 
-	card, err := s.CreateCard("alpha-project", "Task A", "first description", "feature/task-a", "Todo")
+	card, err := s.CreateCard("alpha-project", "Task A", "first description", "feature/task-a", "Todo", "", false)
 	require.NoError(t, err)
 	require.Equal(t, "alpha-project/card-1", card.ID)
 	require.Equal(t, "feature/task-a", card.Branch)
@@ -39,29 +46,29 @@ func TestMarkdownStoreProjectAndCardLifecycle(t *testing.T) {
 	require.Len(t, card.Description, 1)
 	require.Len(t, card.History, 1)
 
-	card, err = s.AppendDescription("alpha-project", 1, "more details")
+	card, err = s.AppendDescription("alpha-project", 1, "more details", 0)
 	require.NoError(t, err)
 	require.Len(t, card.Description, 2)
 	require.Contains(t, card.History[len(card.History)-1].Details, "description")
 
-	card, err = s.AddComment("alpha-project", 1, "looks good")
+	card, err = s.AddComment("alpha-project", 1, "looks good", 0)
 	require.NoError(t, err)
 	require.Len(t, card.Comments, 1)
 	require.Contains(t, card.History[len(card.History)-1].Type, "commented")
 
-	card, err = s.MoveCard("alpha-project", 1, "Doing")
+	card, err = s.MoveCard("alpha-project", 1, "Doing", model.CardPosition{}, false, 0)
 	require.NoError(t, err)
 	require.Equal(t, "Doing", card.Status)
 
-	softDeleted, err := s.DeleteCard("alpha-project", 1, false)
+	softDeleted, err := s.DeleteCard("alpha-project", 1, false, 0)
 	require.NoError(t, err)
 	require.True(t, softDeleted.Deleted)
 
-	card2, err := s.CreateCard("alpha-project", "Task B", "", "", "Todo")
+	card2, err := s.CreateCard("alpha-project", "Task B", "", "", "Todo", "", false)
 	require.NoError(t, err)
 	require.Equal(t, 2, card2.Number)
 
-	hardDeleted, err := s.DeleteCard("alpha-project", 2, true)
+	hardDeleted, err := s.DeleteCard("alpha-project", 2, true, 0)
 	require.NoError(t, err)
 	require.Equal(t, 2, hardDeleted.Number)
 	_, err = s.GetCard("alpha-project", 2)
@@ -88,7 +95,7 @@ func TestMarkdownStoreValidationAndParsingHelpers(t *testing.T) {
 	_, err = s.CreateProject("   ", "", "")
 	require.ErrorContains(t, err, "name is required")
 
-	_, err = s.CreateCard("missing", "Task", "", "", "Todo")
+	_, err = s.CreateCard("missing", "Task", "", "", "Todo", "", false)
 	require.Error(t, err)
 	require.True(t, errors.Is(err, os.ErrNotExist))
 
@@ -96,33 +103,33 @@ func TestMarkdownStoreValidationAndParsingHelpers(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "valid", project.Slug)
 
-	_, err = s.CreateCard("valid", "", "", "", "Todo")
+	_, err = s.CreateCard("valid", "", "", "", "Todo", "", false)
 	require.ErrorContains(t, err, "title is required")
 
-	_, err = s.CreateCard("valid", "Task", "", "", "Blocked")
+	_, err = s.CreateCard("valid", "Task", "", "", "Blocked", "", false)
 	require.ErrorContains(t, err, "invalid status")
 
-	_, err = s.CreateCard("valid", "Task", "", "bad branch", "Todo")
+	_, err = s.CreateCard("valid", "Task", "", "bad branch", "Todo", "", false)
 	require.ErrorContains(t, err, "invalid branch name")
 
-	card, err := s.CreateCard("valid", "Task", "", "", "Todo")
+	card, err := s.CreateCard("valid", "Task", "", "", "Todo", "", false)
 	require.NoError(t, err)
 	require.Equal(t, 1, card.Number)
 
-	_, err = s.AppendDescription("valid", 1, "   ")
+	_, err = s.AppendDescription("valid", 1, "   ", 0)
 	require.ErrorContains(t, err, "description body is required")
 
-	_, err = s.AddComment("valid", 1, " ")
+	_, err = s.AddComment("valid", 1, " ", 0)
 	require.ErrorContains(t, err, "comment body is required")
 
-	_, err = s.MoveCard("valid", 1, "")
+	_, err = s.MoveCard("valid", 1, "", model.CardPosition{}, false, 0)
 	require.ErrorContains(t, err, "status is required")
 
 	_, err = s.GetCard("valid", 99)
 	require.Error(t, err)
 	require.True(t, errors.Is(err, os.ErrNotExist))
 
-	_, err = s.DeleteCard("valid", 99, false)
+	_, err = s.DeleteCard("valid", 99, false, 0)
 	require.Error(t, err)
 	require.True(t, errors.Is(err, os.ErrNotExist))
 
@@ -141,9 +148,9 @@ func TestMarkdownStoreValidationAndParsingHelpers(t *testing.T) {
 	_, _, err = splitFrontmatter([]byte("---\na: b\n"))
 	require.ErrorContains(t, err, "invalid frontmatter")
 
-	require.NoError(t, validateStatus("Todo"))
-	require.ErrorContains(t, validateStatus(""), "status is required")
-	require.ErrorContains(t, validateStatus("invalid"), "invalid status")
+	require.NoError(t, validateStatus(project, "Todo"))
+	require.ErrorContains(t, validateStatus(project, ""), "status is required")
+	require.ErrorContains(t, validateStatus(project, "invalid"), "invalid status")
 
 	require.Equal(t, "hello-world", Slugify(" Hello, World "))
 	require.Equal(t, "project", Slugify("***"))
@@ -157,6 +164,346 @@ func TestMarkdownStoreValidationAndParsingHelpers(t *testing.T) {
 	require.False(t, ok)
 }
 
+func TestMarkdownStoreBumpsCardRevisionOnEveryWrite(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+
+	card, err := s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	require.Equal(t, 1, card.Revision)
+
+	card, err = s.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, card.Revision)
+
+	_, _, err = s.AddTodo("alpha", 1, "todo", 0)
+	require.NoError(t, err)
+	card, err = s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, card.Revision)
+
+	// Cards without a stored revision (older files, hand-written ones) read as 1.
+	raw, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "revision: 3\n")
+	require.NoError(t, os.WriteFile(s.cardPath("alpha", 1), []byte(strings.Replace(string(raw), "revision: 3\n", "", 1)), 0o644))
+	card, err = s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, card.Revision)
+}
+
+func TestMarkdownStoreRejectsStaleRevision(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	card, err := s.AddComment("alpha", 1, "first", 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, card.Revision)
+
+	// Migrating the card to a new workflow is a write the caller did not make
+	// through If-Match, and still moves the card past the revision it holds.
+	_, _, err = s.SetProjectStatuses("alpha", []string{"Backlog", "Doing", "Done"}, map[string]string{"Todo": "Backlog"})
+	require.NoError(t, err)
+	before, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+
+	_, err = s.AddComment("alpha", 1, "second", 2)
+	var stale *model.StaleRevisionError
+	require.ErrorAs(t, err, &stale)
+	require.Equal(t, 2, stale.Expected)
+	require.Equal(t, 3, stale.Current.Revision)
+	require.Equal(t, "Backlog", stale.Current.Status)
+	after, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+	require.Equal(t, string(before), string(after))
+
+	_, err = s.CreateProject("Beta", "", "")
+	require.NoError(t, err)
+	_, _, err = s.MoveCardToProject("alpha", 1, "beta", 2)
+	require.ErrorAs(t, err, &stale)
+	_, err = os.Stat(s.cardPath("beta", 1))
+	require.ErrorIs(t, err, os.ErrNotExist)
+	card, err = s.AddComment("alpha", 1, "second", 3)
+	require.NoError(t, err)
+	require.Equal(t, 4, card.Revision)
+}
+
+func TestMarkdownStoreUpdateProjectKeepsSlug(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	created, err := s.CreateProject("Alpha", "/tmp/alpha", "git@example.com:org/alpha.git")
+	require.NoError(t, err)
+
+	name := "Alpha Renamed"
+	localPath := ""
+	project, err := s.UpdateProject("alpha", model.ProjectPatch{Name: &name, LocalPath: &localPath})
+	require.NoError(t, err)
+	require.Equal(t, "alpha", project.Slug)
+	require.Equal(t, "Alpha Renamed", project.Name)
+	require.Equal(t, "", project.LocalPath)
+	require.Equal(t, "git@example.com:org/alpha.git", project.RemoteURL)
+	require.Equal(t, created.CreatedAt, project.CreatedAt)
+
+	loaded, err := s.GetProject("alpha")
+	require.NoError(t, err)
+	require.Equal(t, project, loaded)
+
+	_, err = s.UpdateProject("alpha", model.ProjectPatch{})
+	require.Error(t, err)
+	blank := " "
+	_, err = s.UpdateProject("alpha", model.ProjectPatch{Name: &blank})
+	require.Error(t, err)
+	_, err = s.UpdateProject("missing", model.ProjectPatch{Name: &name})
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestMarkdownStoreUpdateCardRecordsChangedFields(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "feature/a", "Todo", "", false)
+	require.NoError(t, err)
+
+	title := "Renamed"
+	branch := ""
+	card, err := s.UpdateCard("alpha", 1, model.CardPatch{Title: &title, Branch: &branch}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", card.Title)
+	require.Equal(t, "", card.Branch)
+	require.Equal(t, "Todo", card.Status)
+	require.Equal(t, 2, card.Revision)
+	last := card.History[len(card.History)-1]
+	require.Equal(t, "card.updated", last.Type)
+	require.Equal(t, `title: "Task" -> "Renamed"; branch: "feature/a" -> ""`, last.Details)
+
+	// Patching fields to their current values is a no-op and keeps the revision.
+	card, err = s.UpdateCard("alpha", 1, model.CardPatch{Title: &title}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, card.Revision)
+
+	_, err = s.UpdateCard("alpha", 1, model.CardPatch{}, false, 0)
+	require.Error(t, err)
+	empty := " "
+	_, err = s.UpdateCard("alpha", 1, model.CardPatch{Title: &empty}, false, 0)
+	require.Error(t, err)
+	badBranch := "bad branch"
+	_, err = s.UpdateCard("alpha", 1, model.CardPatch{Branch: &badBranch}, false, 0)
+	require.Error(t, err)
+	_, err = s.UpdateCard("alpha", 9, model.CardPatch{Title: &title}, false, 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestMarkdownStoreRestoreCard(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Keep", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Gone", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	_, err = s.RestoreCard("alpha", 1, 0)
+	require.EqualError(t, err, "card 1 is not deleted")
+
+	_, err = s.DeleteCard("alpha", 1, false, 0)
+	require.NoError(t, err)
+	card, err := s.RestoreCard("alpha", 1, 0)
+	require.NoError(t, err)
+	require.False(t, card.Deleted)
+	require.Equal(t, "card.restored", card.History[len(card.History)-1].Type)
+	loaded, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.False(t, loaded.Deleted)
+
+	_, err = s.DeleteCard("alpha", 2, true, 0)
+	require.NoError(t, err)
+	_, err = s.RestoreCard("alpha", 2, 0)
+	require.EqualError(t, err, "card 2 was hard deleted and cannot be restored")
+	require.False(t, errors.Is(err, os.ErrNotExist))
+
+	_, err = s.RestoreCard("alpha", 3, 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestMarkdownStoreCardLabels(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	card, err := s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	card, err = s.AddLabel("alpha", card.Number, " Needs-Design ", 0)
+	require.NoError(t, err)
+	card, err = s.AddLabel("alpha", card.Number, "bug", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bug", "needs-design"}, card.Labels)
+	require.Equal(t, "card.label.added", card.History[len(card.History)-1].Type)
+
+	_, err = s.AddLabel("alpha", card.Number, "BUG", 0)
+	require.ErrorContains(t, err, "already has label")
+	_, err = s.AddLabel("alpha", card.Number, "two words", 0)
+	require.ErrorContains(t, err, "invalid label")
+	_, err = s.AddLabel("alpha", card.Number, "", 0)
+	require.EqualError(t, err, "label is required")
+
+	card, err = s.RemoveLabel("alpha", card.Number, "needs-design", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bug"}, card.Labels)
+	_, err = s.RemoveLabel("alpha", card.Number, "needs-design", 0)
+	require.ErrorContains(t, err, "has no label")
+
+	loaded, err := s.GetCard("alpha", card.Number)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bug"}, loaded.Labels)
+	raw, err := os.ReadFile(s.cardPath("alpha", card.Number))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "labels:\n    - bug\n")
+
+	// Hand-edited labels are normalized on read.
+	require.NoError(t, os.WriteFile(s.cardPath("alpha", card.Number), []byte(strings.Replace(string(raw), "    - bug\n", "    - Infra\n    - bug\n    - infra\n", 1)), 0o644))
+	loaded, err = s.GetCard("alpha", card.Number)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bug", "infra"}, loaded.Labels)
+}
+
+func TestMarkdownStoreMoveCardToProject(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("beta", "Existing", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	card, err := s.CreateCard("alpha", "Misfiled", "details", "feature/x", "Doing", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddTodo("alpha", card.Number, "check", 0)
+	require.NoError(t, err)
+
+	_, _, err = s.MoveCardToProject("alpha", card.Number, "alpha", 0)
+	require.Error(t, err)
+	_, _, err = s.MoveCardToProject("alpha", card.Number, "gamma", 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	moved, tombstone, err := s.MoveCardToProject("alpha", card.Number, "beta", 0)
+	require.NoError(t, err)
+	require.Equal(t, "beta/card-2", moved.ID)
+	require.Equal(t, 1, moved.Revision)
+	require.Equal(t, "feature/x", moved.Branch)
+	require.Len(t, moved.Description, 1)
+	require.Len(t, moved.Todos, 1)
+	require.Equal(t, card.CreatedAt, moved.CreatedAt)
+	require.True(t, tombstone.Deleted)
+	require.Equal(t, "beta/card-2", tombstone.MovedTo)
+	require.Empty(t, tombstone.Todos)
+
+	beta, err := s.GetProject("beta")
+	require.NoError(t, err)
+	require.Equal(t, 3, beta.NextCardSeq)
+
+	loaded, err := s.GetCard("alpha", card.Number)
+	require.NoError(t, err)
+	require.Equal(t, "beta/card-2", loaded.MovedTo)
+	_, _, err = s.MoveCardToProject("alpha", card.Number, "beta", 0)
+	require.Error(t, err)
+	_, err = s.RestoreCard("alpha", card.Number, 0)
+	require.ErrorContains(t, err, "was moved to beta/card-2")
+}
+
+func TestMarkdownStoreMoveCardToProjectKeepsCardAtNextNumber(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("beta", "Existing", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	card, err := s.CreateCard("alpha", "Misfiled", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	// A hand edit sets beta's sequence back onto its existing card.
+	projectPath := filepath.Join(root, "projects", "beta", "project.md")
+	data, err := os.ReadFile(projectPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(projectPath, []byte(strings.Replace(string(data), "next_card_seq: 2", "next_card_seq: 1", 1)), 0o644))
+	existing, err := os.ReadFile(filepath.Join(root, "projects", "beta", "card-1.md"))
+	require.NoError(t, err)
+
+	_, _, err = s.MoveCardToProject("alpha", card.Number, "beta", 0)
+	require.ErrorIs(t, err, os.ErrExist)
+	require.ErrorContains(t, err, "card-1.md already exists in project beta")
+	after, err := os.ReadFile(filepath.Join(root, "projects", "beta", "card-1.md"))
+	require.NoError(t, err)
+	require.Equal(t, existing, after)
+	source, err := s.GetCard("alpha", card.Number)
+	require.NoError(t, err)
+	require.Empty(t, source.MovedTo)
+	require.Equal(t, card.Revision, source.Revision)
+}
+
+func TestMarkdownStoreProjectTrashLifecycle(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteProject("alpha"))
+
+	trashed, err := s.ListTrashedProjects()
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	require.Equal(t, "alpha", trashed[0].Slug)
+	require.Equal(t, "Alpha", trashed[0].Name)
+	require.Equal(t, 1, trashed[0].CardsCount)
+	require.True(t, strings.HasPrefix(trashed[0].ID, "alpha-"))
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, _, err = s.RestoreTrashedProject(trashed[0].ID)
+	require.ErrorIs(t, err, os.ErrExist)
+	require.NoError(t, s.DeleteProject("alpha"))
+
+	_, _, err = s.RestoreTrashedProject("../alpha")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, os.ErrNotExist))
+
+	project, cards, err := s.RestoreTrashedProject(trashed[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "alpha", project.Slug)
+	require.Len(t, cards, 1)
+
+	remaining, err := s.ListTrashedProjects()
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+
+	purged, err := s.PurgeTrashBefore(remaining[0].DeletedAt)
+	require.NoError(t, err)
+	require.Empty(t, purged)
+	purged, err = s.PurgeTrashBefore(remaining[0].DeletedAt.Add(time.Millisecond))
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+
+	remaining, err = s.ListTrashedProjects()
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+}
+
 func TestListProjectCardsSkipsInvalidCardFilenames(t *testing.T) {
 	root := t.TempDir()
 	s, err := NewMarkdownStore(root)
@@ -164,7 +511,7 @@ func TestListProjectCardsSkipsInvalidCardFilenames(t *testing.T) {
 
 	_, err = s.CreateProject("Alpha", "", "")
 	require.NoError(t, err)
-	_, err = s.CreateCard("alpha", "Task", "", "", "Todo")
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
 	require.NoError(t, err)
 
 	projectDir := filepath.Join(root, "projects", "alpha")
@@ -183,39 +530,40 @@ func TestMarkdownStoreCardTodosLifecycle(t *testing.T) {
 
 	_, err = s.CreateProject("Todo Board", "", "")
 	require.NoError(t, err)
-	_, err = s.CreateCard("todo-board", "Task", "", "", "Todo")
+	_, err = s.CreateCard("todo-board", "Task", "", "", "Todo", "", false)
 	require.NoError(t, err)
 
-	first, err := s.AddTodo("todo-board", 1, "Write tests")
+	first, _, err := s.AddTodo("todo-board", 1, "Write tests", 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, first.ID)
 	require.Equal(t, "Write tests", first.Text)
 	require.False(t, first.Completed)
 
-	second, err := s.AddTodo("todo-board", 1, "Write tests")
+	second, _, err := s.AddTodo("todo-board", 1, "Write tests", 0)
 	require.NoError(t, err)
 	require.Equal(t, 2, second.ID)
 
-	_, err = s.AddTodo("todo-board", 1, "   ")
+	_, _, err = s.AddTodo("todo-board", 1, "   ", 0)
 	require.ErrorContains(t, err, "todo text is required")
 
-	updated, err := s.SetTodoCompleted("todo-board", 1, 2, true)
+	completed, reopened := true, false
+	updated, _, err := s.UpdateTodo("todo-board", 1, 2, model.ChecklistItemPatch{Completed: &completed}, 0)
 	require.NoError(t, err)
 	require.Equal(t, 2, updated.ID)
 	require.True(t, updated.Completed)
 
-	updated, err = s.SetTodoCompleted("todo-board", 1, 2, false)
+	updated, _, err = s.UpdateTodo("todo-board", 1, 2, model.ChecklistItemPatch{Completed: &reopened}, 0)
 	require.NoError(t, err)
 	require.False(t, updated.Completed)
 
-	_, err = s.SetTodoCompleted("todo-board", 1, 0, true)
+	_, _, err = s.UpdateTodo("todo-board", 1, 0, model.ChecklistItemPatch{Completed: &completed}, 0)
 	require.ErrorContains(t, err, "todo id is required")
 
-	deleted, err := s.DeleteTodo("todo-board", 1, 1)
+	deleted, _, err := s.DeleteTodo("todo-board", 1, 1, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, deleted.ID)
 
-	third, err := s.AddTodo("todo-board", 1, "Ship it")
+	third, _, err := s.AddTodo("todo-board", 1, "Ship it", 0)
 	require.NoError(t, err)
 	require.Equal(t, 3, third.ID)
 
@@ -232,34 +580,35 @@ func TestMarkdownStoreAcceptanceCriteriaLifecycle(t *testing.T) {
 
 	_, err = s.CreateProject("AC Board", "", "")
 	require.NoError(t, err)
-	_, err = s.CreateCard("ac-board", "Task", "", "", "Todo")
+	_, err = s.CreateCard("ac-board", "Task", "", "", "Todo", "", false)
 	require.NoError(t, err)
 
-	first, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement A")
+	first, _, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement A", 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, first.ID)
 	require.False(t, first.Completed)
 
-	second, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement B")
+	second, _, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement B", 0)
 	require.NoError(t, err)
 	require.Equal(t, 2, second.ID)
 
-	_, err = s.AddAcceptanceCriterion("ac-board", 1, "   ")
+	_, _, err = s.AddAcceptanceCriterion("ac-board", 1, "   ", 0)
 	require.ErrorContains(t, err, "acceptance criterion text is required")
 
-	done, err := s.SetAcceptanceCriterionCompleted("ac-board", 1, 2, true)
+	completed, reopened := true, false
+	done, _, err := s.UpdateAcceptanceCriterion("ac-board", 1, 2, model.ChecklistItemPatch{Completed: &completed}, 0)
 	require.NoError(t, err)
 	require.True(t, done.Completed)
 
-	undo, err := s.SetAcceptanceCriterionCompleted("ac-board", 1, 2, false)
+	undo, _, err := s.UpdateAcceptanceCriterion("ac-board", 1, 2, model.ChecklistItemPatch{Completed: &reopened}, 0)
 	require.NoError(t, err)
 	require.False(t, undo.Completed)
 
-	removed, err := s.DeleteAcceptanceCriterion("ac-board", 1, 1)
+	removed, _, err := s.DeleteAcceptanceCriterion("ac-board", 1, 1, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, removed.ID)
 
-	third, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement C")
+	third, _, err := s.AddAcceptanceCriterion("ac-board", 1, "Requirement C", 0)
 	require.NoError(t, err)
 	require.Equal(t, 3, third.ID)
 
@@ -269,3 +618,886 @@ func TestMarkdownStoreAcceptanceCriteriaLifecycle(t *testing.T) {
 	require.Equal(t, 2, ac[0].ID)
 	require.Equal(t, 3, ac[1].ID)
 }
+
+func TestMarkdownStoreEditAndReorderChecklistItems(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Edit Board", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("edit-board", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	for _, text := range []string{"First", "Secnd", "Third"} {
+		_, _, err = s.AddTodo("edit-board", 1, text, 0)
+		require.NoError(t, err)
+		_, _, err = s.AddAcceptanceCriterion("edit-board", 1, text, 0)
+		require.NoError(t, err)
+	}
+
+	text := "Second"
+	todo, _, err := s.UpdateTodo("edit-board", 1, 2, model.ChecklistItemPatch{Text: &text}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, todo.ID)
+	require.Equal(t, "Second", todo.Text)
+
+	first, last, past := 1, 3, 4
+	todo, _, err = s.UpdateTodo("edit-board", 1, 3, model.ChecklistItemPatch{Position: &first}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, todo.ID)
+
+	criterion, _, err := s.UpdateAcceptanceCriterion("edit-board", 1, 1, model.ChecklistItemPatch{Text: &text, Position: &last}, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Second", criterion.Text)
+
+	_, _, err = s.UpdateTodo("edit-board", 1, 1, model.ChecklistItemPatch{Position: &past}, 0)
+	require.ErrorContains(t, err, "position must be between 1 and 3")
+	blank := "  "
+	_, _, err = s.UpdateTodo("edit-board", 1, 1, model.ChecklistItemPatch{Text: &blank}, 0)
+	require.ErrorContains(t, err, "todo text is required")
+	_, _, err = s.UpdateAcceptanceCriterion("edit-board", 1, 1, model.ChecklistItemPatch{}, 0)
+	require.ErrorContains(t, err, "at least one field is required")
+
+	require.NoError(t, s.Close())
+	reloaded, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	card, err := reloaded.GetCard("edit-board", 1)
+	require.NoError(t, err)
+	require.Equal(t, []model.Todo{
+		{ID: 3, Text: "Third"},
+		{ID: 1, Text: "First"},
+		{ID: 2, Text: "Second"},
+	}, card.Todos)
+	require.Equal(t, []int{2, 3, 1}, []int{card.AcceptanceCriteria[0].ID, card.AcceptanceCriteria[1].ID, card.AcceptanceCriteria[2].ID})
+	require.Equal(t, 4, card.NextTodoID)
+
+	var details []string
+	for _, event := range card.History {
+		if event.Type == "card.todo.updated" || event.Type == "card.acceptance.updated" {
+			details = append(details, event.Details)
+		}
+	}
+	require.Equal(t, []string{
+		`todo_id=2 text: "Secnd" -> "Second"`,
+		"todo_id=3 position: 3 -> 1",
+		`criterion_id=1 text: "First" -> "Second"; position: 1 -> 3`,
+	}, details)
+}
+
+func TestMarkdownStoreCardPriorityAndDue(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	card, err := s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	card, err = s.SetCardPriority("alpha", card.Number, " p1 ", 0)
+	require.NoError(t, err)
+	require.Equal(t, "P1", card.Priority)
+	require.Equal(t, "card.priority.updated", card.History[len(card.History)-1].Type)
+	_, err = s.SetCardPriority("alpha", card.Number, "P4", 0)
+	require.ErrorContains(t, err, "invalid priority")
+
+	dueAt := time.Date(2026, 3, 1, 13, 30, 0, 0, time.FixedZone("CET", 3600))
+	card, err = s.SetCardDue("alpha", card.Number, &dueAt, 0)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC), *card.DueAt)
+	require.Equal(t, "card.due.updated", card.History[len(card.History)-1].Type)
+
+	raw, err := os.ReadFile(s.cardPath("alpha", card.Number))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "priority: P1\n")
+	require.Contains(t, string(raw), "due_at: 2026-03-01T12:30:00Z\n")
+	loaded, err := s.GetCard("alpha", card.Number)
+	require.NoError(t, err)
+	require.Equal(t, "P1", loaded.Priority)
+	require.True(t, card.DueAt.Equal(*loaded.DueAt))
+
+	revision := loaded.Revision
+	loaded, err = s.SetCardDue("alpha", card.Number, &dueAt, 0)
+	require.NoError(t, err)
+	require.Equal(t, revision, loaded.Revision, "setting the same due date is a no-op")
+
+	loaded, err = s.SetCardPriority("alpha", card.Number, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, loaded.Priority)
+	loaded, err = s.SetCardDue("alpha", card.Number, nil, 0)
+	require.NoError(t, err)
+	require.Nil(t, loaded.DueAt)
+	raw, err = os.ReadFile(s.cardPath("alpha", card.Number))
+	require.NoError(t, err)
+	frontmatter := strings.SplitN(string(raw), "---\n", 3)[1]
+	require.NotContains(t, frontmatter, "priority:")
+	require.NotContains(t, frontmatter, "due_at:")
+}
+
+func TestMarkdownStoreCardRelations(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	for _, title := range []string{"Schema", "API", "Old API"} {
+		_, err = s.CreateCard("alpha", title, "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("beta", "Client", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	card, blocker, err := s.AddRelation("alpha", 2, model.RelationBlockedBy, "alpha/card-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardRelation{{Type: model.RelationBlockedBy, CardID: "alpha/card-1"}}, card.Relations)
+	require.Equal(t, []model.CardRelation{{Type: model.RelationBlocks, CardID: "alpha/card-2"}}, blocker.Relations)
+	require.Equal(t, "card.relation.added", blocker.History[len(blocker.History)-1].Type)
+
+	_, client, err := s.AddRelation("alpha", 2, model.RelationBlocks, "beta/card-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardRelation{{Type: model.RelationBlockedBy, CardID: "alpha/card-2"}}, client.Relations)
+	_, duplicate, err := s.AddRelation("alpha", 3, model.RelationDuplicates, "alpha/card-2", 0)
+	require.NoError(t, err)
+	require.Contains(t, duplicate.Relations, model.CardRelation{Type: model.RelationDuplicatedBy, CardID: "alpha/card-3"})
+
+	_, _, err = s.AddRelation("alpha", 2, model.RelationBlockedBy, "alpha/card-1", 0)
+	require.ErrorContains(t, err, "already")
+	_, _, err = s.AddRelation("alpha", 2, "parent_of", "alpha/card-1", 0)
+	require.ErrorContains(t, err, "invalid relation type")
+	_, _, err = s.AddRelation("alpha", 2, model.RelationRelatesTo, "alpha/card-2", 0)
+	require.ErrorContains(t, err, "itself")
+	_, _, err = s.AddRelation("alpha", 2, model.RelationRelatesTo, "alpha/card-9", 0)
+	require.ErrorContains(t, err, "not found")
+	_, _, err = s.AddRelation("alpha", 2, model.RelationRelatesTo, "card-1", 0)
+	require.ErrorContains(t, err, "invalid card id")
+
+	raw, err := os.ReadFile(s.cardPath("alpha", 2))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "relations:\n    - type: blocked_by\n      card: alpha/card-1\n")
+
+	card, blocker, err = s.RemoveRelation("alpha", 2, model.RelationBlockedBy, "alpha/card-1", 0)
+	require.NoError(t, err)
+	require.NotContains(t, card.Relations, model.CardRelation{Type: model.RelationBlockedBy, CardID: "alpha/card-1"})
+	require.Empty(t, blocker.Relations)
+	_, _, err = s.RemoveRelation("alpha", 2, model.RelationBlockedBy, "alpha/card-1", 0)
+	require.ErrorContains(t, err, "has no blocked_by relation")
+
+	// Transferring a card repoints the other side of its relations.
+	moved, tombstone, err := s.MoveCardToProject("alpha", 2, "beta", 0)
+	require.NoError(t, err)
+	require.Empty(t, tombstone.Relations)
+	require.Len(t, moved.Relations, 2)
+	client, err = s.GetCard("beta", 1)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardRelation{{Type: model.RelationBlockedBy, CardID: moved.ID}}, client.Relations)
+	duplicate, err = s.GetCard("alpha", 3)
+	require.NoError(t, err)
+	require.Equal(t, []model.CardRelation{{Type: model.RelationDuplicates, CardID: moved.ID}}, duplicate.Relations)
+
+	// Hard deleting a card drops the other side of its relations.
+	_, err = s.DeleteCard("beta", moved.Number, true, 0)
+	require.NoError(t, err)
+	client, err = s.GetCard("beta", 1)
+	require.NoError(t, err)
+	require.Empty(t, client.Relations)
+	duplicate, err = s.GetCard("alpha", 3)
+	require.NoError(t, err)
+	require.Empty(t, duplicate.Relations)
+}
+
+func TestMarkdownStoreParentCards(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	epic, err := s.CreateCard("alpha", "Epic", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	child, err := s.CreateCard("alpha", "Child", "", "", "Todo", " alpha/card-1 ", false)
+	require.NoError(t, err)
+	require.Equal(t, epic.ID, child.ParentID)
+	require.Equal(t, "status=Todo parent=alpha/card-1", child.History[0].Details)
+	remote, err := s.CreateCard("beta", "Remote child", "", "", "Todo", epic.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, epic.ID, remote.ParentID)
+
+	raw, err := os.ReadFile(s.cardPath("alpha", 2))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "parent: alpha/card-1\n")
+	reloaded, err := s.GetCard("alpha", 2)
+	require.NoError(t, err)
+	require.Equal(t, epic.ID, reloaded.ParentID)
+
+	_, err = s.CreateCard("alpha", "Orphan", "", "", "Todo", "alpha/card-9", false)
+	require.ErrorContains(t, err, "parent card alpha/card-9 not found")
+	_, err = s.CreateCard("alpha", "Orphan", "", "", "Todo", "card-1", false)
+	require.ErrorContains(t, err, "invalid card id")
+	_, err = s.DeleteCard("alpha", 2, false, 0)
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Orphan", "", "", "Todo", "alpha/card-2", false)
+	require.ErrorContains(t, err, "is deleted")
+
+	// Transferring the parent repoints its children, wherever they live.
+	moved, tombstone, err := s.MoveCardToProject("alpha", 1, "beta", 0)
+	require.NoError(t, err)
+	require.Empty(t, tombstone.ParentID)
+	_, err = s.CreateCard("alpha", "Orphan", "", "", "Todo", tombstone.ID, false)
+	require.ErrorContains(t, err, "was moved to "+moved.ID)
+	child, err = s.GetCard("alpha", 2)
+	require.NoError(t, err)
+	require.Equal(t, moved.ID, child.ParentID)
+	require.Equal(t, "card.parent.updated", child.History[len(child.History)-1].Type)
+	remote, err = s.GetCard("beta", 1)
+	require.NoError(t, err)
+	require.Equal(t, moved.ID, remote.ParentID)
+
+	// Hard deleting the parent detaches its children.
+	_, err = s.DeleteCard("beta", moved.Number, true, 0)
+	require.NoError(t, err)
+	remote, err = s.GetCard("beta", 1)
+	require.NoError(t, err)
+	require.Empty(t, remote.ParentID)
+}
+
+func TestMarkdownStoreCardAttachments(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err = s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	log, _, err := s.AddAttachment("alpha", 1, " build.log ", "", []byte("line 1\n"), 0)
+	require.NoError(t, err)
+	require.Equal(t, "build.log", log.Filename)
+	require.Equal(t, int64(7), log.Size)
+	require.Equal(t, "text/plain; charset=utf-8", log.ContentType)
+	sum := sha256.Sum256([]byte("line 1\n"))
+	require.Equal(t, hex.EncodeToString(sum[:]), log.SHA256)
+	_, _, err = s.AddAttachment("alpha", 1, "design.pdf", "application/pdf", []byte("%PDF-1.7"), 0)
+	require.NoError(t, err)
+
+	blobPath := filepath.Join(dataDir, "projects", "alpha", "attachments", "card-1", "build.log")
+	require.FileExists(t, blobPath)
+	raw, err := os.ReadFile(s.cardPath("alpha", 1))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "attachments:\n    - filename: build.log\n      size: 7\n      content_type: text/plain; charset=utf-8\n      sha256: "+log.SHA256+"\n")
+
+	// Uploading the same name again replaces the blob and its entry.
+	replaced, _, err := s.AddAttachment("alpha", 1, "build.log", "text/plain", []byte("line 1\nline 2\n"), 0)
+	require.NoError(t, err)
+	require.NotEqual(t, log.SHA256, replaced.SHA256)
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Len(t, card.Attachments, 2)
+	require.Equal(t, "card.attachment.replaced", card.History[len(card.History)-1].Type)
+	attachment, data, err := s.ReadAttachment("alpha", 1, "build.log")
+	require.NoError(t, err)
+	require.Equal(t, replaced, attachment)
+	require.Equal(t, "line 1\nline 2\n", string(data))
+
+	for _, name := range []string{"", "../card-1.md", `dir\file`, ".hidden"} {
+		_, _, err = s.AddAttachment("alpha", 1, name, "", []byte("x"), 0)
+		require.Error(t, err, name)
+	}
+	_, _, err = s.ReadAttachment("alpha", 1, "missing.txt")
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, _, err = s.DeleteAttachment("alpha", 1, "missing.txt", 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// Soft delete keeps the blobs so a restore brings them back.
+	_, err = s.DeleteCard("alpha", 1, false, 0)
+	require.NoError(t, err)
+	require.FileExists(t, blobPath)
+	_, err = s.RestoreCard("alpha", 1, 0)
+	require.NoError(t, err)
+
+	// A transfer re-files the blobs under the new card.
+	moved, tombstone, err := s.MoveCardToProject("alpha", 1, "beta", 0)
+	require.NoError(t, err)
+	require.Empty(t, tombstone.Attachments)
+	require.Len(t, moved.Attachments, 2)
+	require.NoFileExists(t, blobPath)
+	_, data, err = s.ReadAttachment("beta", moved.Number, "build.log")
+	require.NoError(t, err)
+	require.Equal(t, "line 1\nline 2\n", string(data))
+
+	removed, _, err := s.DeleteAttachment("beta", moved.Number, "design.pdf", 0)
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", removed.ContentType)
+	require.NoFileExists(t, filepath.Join(dataDir, "projects", "beta", "attachments", "card-1", "design.pdf"))
+
+	// Hard delete removes the card's attachment directory.
+	_, err = s.DeleteCard("beta", moved.Number, true, 0)
+	require.NoError(t, err)
+	require.NoDirExists(t, filepath.Join(dataDir, "projects", "beta", "attachments", "card-1"))
+}
+
+func TestMarkdownStoreIgnoresUnsafeAttachmentFilenames(t *testing.T) {
+	dataDir := t.TempDir()
+	s, err := NewMarkdownStore(dataDir)
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddAttachment("alpha", 1, "build.log", "", []byte("line 1\n"), 0)
+	require.NoError(t, err)
+
+	// A hand edit that points an entry outside the attachment directory.
+	path := s.cardPath("alpha", 1)
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	edited := strings.Replace(string(raw), "attachments:\n", "attachments:\n    - filename: ../../../../etc/passwd\n      size: 1\n", 1)
+	require.NoError(t, os.WriteFile(path, []byte(edited), 0o644))
+
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Len(t, card.Attachments, 1)
+	require.Equal(t, "build.log", card.Attachments[0].Filename)
+	_, _, err = s.ReadAttachment("alpha", 1, "../../../../etc/passwd")
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, _, err = s.DeleteAttachment("alpha", 1, "../../../../etc/passwd", 0)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	report, err := s.Doctor(false)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, DoctorInvalidAttachment, report.Issues[0].Kind)
+	require.Equal(t, `attachments with invalid filenames are ignored: "../../../../etc/passwd"`, report.Issues[0].Detail)
+
+	_, err = s.Doctor(true)
+	require.NoError(t, err)
+	raw, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "filename: ../")
+	report, err = s.Doctor(false)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+}
+
+func TestMarkdownStoreProjectStatuses(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	project, err := s.CreateProject("Flow", "", "")
+	require.NoError(t, err)
+	require.Equal(t, model.DefaultStatuses, project.Statuses)
+	_, err = s.CreateProject("Release", "", "")
+	require.NoError(t, err)
+
+	for _, status := range []string{"Todo", "Review", "Done"} {
+		_, err = s.CreateCard("flow", "Task "+status, "", "", status, "", false)
+		require.NoError(t, err)
+	}
+
+	_, _, err = s.SetProjectStatuses("flow", []string{"Backlog", "Doing", "Shipped"}, map[string]string{"Todo": "Backlog"})
+	require.ErrorContains(t, err, "cards still use status Done, Review")
+	_, _, err = s.SetProjectStatuses("flow", []string{"Backlog", "backlog"}, nil)
+	require.ErrorContains(t, err, "listed twice")
+	_, _, err = s.SetProjectStatuses("flow", []string{"Backlog"}, nil)
+	require.ErrorContains(t, err, "at least two statuses")
+	_, _, err = s.SetProjectStatuses("flow", []string{}, nil)
+	require.ErrorContains(t, err, "at least two statuses")
+	_, _, err = s.SetProjectStatuses("flow", nil, nil)
+	require.ErrorContains(t, err, "at least two statuses")
+	_, _, err = s.SetProjectStatuses("flow", []string{"Backlog", "Shipped"}, map[string]string{"Todo": "Missing"})
+	require.ErrorContains(t, err, "not in the new statuses")
+	_, _, err = s.SetProjectStatuses("missing", []string{"Backlog", "Shipped"}, nil)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	project, migrated, err := s.SetProjectStatuses("flow", []string{"Backlog", "Doing", "Shipped"}, map[string]string{"Todo": "Backlog", "Review": "Doing", "Done": "Shipped"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Backlog", "Doing", "Shipped"}, project.Statuses)
+	require.Equal(t, "Shipped", project.DoneStatus())
+	require.Len(t, migrated, 3)
+	card, err := s.GetCard("flow", 2)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", card.Status)
+	require.Equal(t, "card.status.migrated", card.History[len(card.History)-1].Type)
+
+	// The workflow lives in project.md and survives a reload.
+	raw, err := os.ReadFile(filepath.Join(root, "projects", "flow", "project.md"))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "statuses:")
+	require.NoError(t, s.Close())
+	reloaded, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	project, err = reloaded.GetProject("flow")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Backlog", "Doing", "Shipped"}, project.Statuses)
+
+	_, err = s.CreateCard("flow", "Old status", "", "", "Todo", "", false)
+	require.ErrorContains(t, err, `invalid status "Todo"`)
+	_, err = s.MoveCard("flow", 1, "Review", model.CardPosition{}, false, 0)
+	require.ErrorContains(t, err, `invalid status "Review"`)
+	_, err = s.MoveCard("flow", 1, "Shipped", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+
+	// Transfer keeps a status both projects share and maps done to done.
+	moved, _, err := s.MoveCardToProject("flow", 2, "release", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", moved.Status)
+	moved, _, err = s.MoveCardToProject("flow", 1, "release", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Done", moved.Status)
+	_, err = s.CreateCard("flow", "Fresh", "", "", "Backlog", "", false)
+	require.NoError(t, err)
+	moved, _, err = s.MoveCardToProject("flow", 4, "release", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Todo", moved.Status)
+}
+
+func TestMarkdownStoreWIPLimits(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Limited", "", "")
+	require.NoError(t, err)
+
+	project, err := s.UpdateProject("limited", model.ProjectPatch{WIPLimits: map[string]int{"Doing": 1, "Review": 2}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"Doing": 1, "Review": 2}, project.WIPLimits)
+	_, err = s.UpdateProject("limited", model.ProjectPatch{WIPLimits: map[string]int{"QA": 1}})
+	require.ErrorContains(t, err, `invalid status "QA"`)
+	_, err = s.UpdateProject("limited", model.ProjectPatch{WIPLimits: map[string]int{"Doing": -1}})
+	require.ErrorContains(t, err, "must not be negative")
+	project, err = s.UpdateProject("limited", model.ProjectPatch{WIPLimits: map[string]int{"Review": 0}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"Doing": 1}, project.WIPLimits)
+
+	require.NoError(t, s.Close())
+	reloaded, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	project, err = reloaded.GetProject("limited")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"Doing": 1}, project.WIPLimits)
+
+	// Writes past a limit are refused unless forced, and forced ones are
+	// recorded.
+	first, err := s.CreateCard("limited", "First", "", "", "Doing", "", false)
+	require.NoError(t, err)
+	require.Len(t, first.History, 1)
+	_, err = s.CreateCard("limited", "Second", "", "", "Doing", "", false)
+	var wipErr *model.WIPLimitError
+	require.ErrorAs(t, err, &wipErr)
+	require.Equal(t, model.WIPLimitError{Status: "Doing", Limit: 1}, *wipErr)
+	require.NoFileExists(t, filepath.Join(root, "projects", "limited", "card-2.md"))
+	second, err := s.CreateCard("limited", "Second", "", "", "Doing", "", true)
+	require.NoError(t, err)
+	require.Equal(t, 2, second.Number)
+	require.Equal(t, "card.wip_limit.exceeded", second.History[len(second.History)-1].Type)
+	require.Equal(t, "Doing holds 2 cards; limit 1", second.History[len(second.History)-1].Details)
+
+	third, err := s.CreateCard("limited", "Third", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, err = s.MoveCard("limited", third.Number, "Doing", model.CardPosition{}, false, 0)
+	require.ErrorAs(t, err, &wipErr)
+	doing := "Doing"
+	_, err = s.UpdateCard("limited", third.Number, model.CardPatch{Status: &doing}, false, 0)
+	require.ErrorAs(t, err, &wipErr)
+	third, err = s.MoveCard("limited", third.Number, "Doing", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, third.Revision, "refused writes leave the card as it was")
+	require.Equal(t, "Doing holds 3 cards; limit 1", third.History[len(third.History)-1].Details)
+	_, err = s.DeleteCard("limited", second.Number, false, 0)
+	require.NoError(t, err)
+	first, err = s.UpdateCard("limited", first.Number, model.CardPatch{Status: &doing, Title: &doing}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, "card.updated", first.History[len(first.History)-1].Type, "staying in a status is no violation")
+
+	project, _, err = s.SetProjectStatuses("limited", []string{"Todo", "Done"}, map[string]string{"Doing": "Todo"})
+	require.NoError(t, err)
+	require.Nil(t, project.WIPLimits)
+}
+
+func TestMarkdownStoreTransitionRules(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Ruled", "", "")
+	require.NoError(t, err)
+
+	_, err = s.SetTransitionRules("ruled", []model.TransitionRule{{To: "QA", Requires: []string{"branch"}}})
+	require.ErrorContains(t, err, `invalid status "QA"`)
+	_, err = s.SetTransitionRules("ruled", []model.TransitionRule{{To: "Done", Requires: []string{"reviewed"}}})
+	require.ErrorContains(t, err, "reviewed")
+	_, err = s.SetTransitionRules("ruled", []model.TransitionRule{{From: "Done", To: "Done", Requires: []string{"branch"}}})
+	require.Error(t, err)
+	_, err = s.SetTransitionRules("ruled", []model.TransitionRule{{To: "Done"}})
+	require.Error(t, err)
+
+	rules := []model.TransitionRule{
+		{From: " Review ", To: "Done", Requires: []string{"Acceptance_Criteria_Completed", "todos_completed", "todos_completed"}},
+		{To: "Review", Requires: []string{"branch"}},
+	}
+	project, err := s.SetTransitionRules("ruled", rules)
+	require.NoError(t, err)
+	want := []model.TransitionRule{
+		{From: "Review", To: "Done", Requires: []string{"acceptance_criteria_completed", "todos_completed"}},
+		{To: "Review", Requires: []string{"branch"}},
+	}
+	require.Equal(t, want, project.TransitionRules)
+
+	require.NoError(t, s.Close())
+	reloaded, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+	project, err = reloaded.GetProject("ruled")
+	require.NoError(t, err)
+	require.Equal(t, want, project.TransitionRules)
+
+	// A move that breaks a rule is refused unless forced, and only a forced
+	// one is recorded as an override.
+	card, err := s.CreateCard("ruled", "Ship it", "", "", "Doing", "", false)
+	require.NoError(t, err)
+	_, err = s.MoveCard("ruled", card.Number, "Review", model.CardPosition{}, false, 0)
+	var rulesErr *model.TransitionError
+	require.ErrorAs(t, err, &rulesErr)
+	require.EqualError(t, err, "card 1 cannot move from Doing to Review: no branch set; force the move to override")
+	card, err = s.MoveCard("ruled", card.Number, "Review", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, card.Revision, "the refused move wrote nothing")
+	last := card.History[len(card.History)-1]
+	require.Equal(t, "card.transition.overridden", last.Type)
+	require.Equal(t, "Doing -> Review: no branch set", last.Details)
+
+	_, _, err = s.AddAcceptanceCriterion("ruled", card.Number, "Works", 0)
+	require.NoError(t, err)
+	done := "Done"
+	_, err = s.UpdateCard("ruled", card.Number, model.CardPatch{Status: &done}, false, 0)
+	require.ErrorAs(t, err, &rulesErr)
+	require.Equal(t, []string{"1 of 1 acceptance criteria open"}, rulesErr.Unmet)
+	card, err = s.UpdateCard("ruled", card.Number, model.CardPatch{Status: &done}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Review -> Done: 1 of 1 acceptance criteria open", card.History[len(card.History)-1].Details)
+
+	// A card meeting the rules gets no override event, forced or not.
+	branch := "feature/ship"
+	card, err = s.CreateCard("ruled", "Branched", "", branch, "Doing", "", false)
+	require.NoError(t, err)
+	card, err = s.MoveCard("ruled", card.Number, "Review", model.CardPosition{}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, "card.moved", card.History[len(card.History)-1].Type)
+
+	project, _, err = s.SetProjectStatuses("ruled", []string{"Todo", "Doing", "Done"}, map[string]string{"Review": "Doing"})
+	require.NoError(t, err)
+	require.Nil(t, project.TransitionRules, "rules naming a dropped status go with it")
+
+	project, err = s.SetTransitionRules("ruled", []model.TransitionRule{{To: "Done", Requires: []string{"todos_completed"}}})
+	require.NoError(t, err)
+	require.Len(t, project.TransitionRules, 1)
+	project, err = s.SetTransitionRules("ruled", nil)
+	require.NoError(t, err)
+	require.Nil(t, project.TransitionRules)
+}
+
+func TestMarkdownStoreRefusesBlockedMovesUnlessForced(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	for _, name := range []string{"Alpha", "Beta"} {
+		_, err := s.CreateProject(name, "", "")
+		require.NoError(t, err)
+	}
+	_, _, err = s.SetProjectStatuses("alpha", []string{"Backlog", "Todo", "Doing", "Done"}, nil)
+	require.NoError(t, err)
+	for _, title := range []string{"Blocked", "Other"} {
+		_, err = s.CreateCard("alpha", title, "", "", "Backlog", "", false)
+		require.NoError(t, err)
+	}
+	_, err = s.CreateCard("beta", "Blocker", "", "", "Doing", "", false)
+	require.NoError(t, err)
+	_, _, err = s.AddRelation("alpha", 1, model.RelationBlockedBy, "beta/card-1", 0)
+	require.NoError(t, err)
+
+	var blockedErr *model.BlockedError
+	_, err = s.MoveCard("alpha", 1, "Todo", model.CardPosition{}, false, 0)
+	require.ErrorAs(t, err, &blockedErr)
+	require.Equal(t, model.BlockedError{Number: 1, Blockers: []string{"beta/card-1"}}, *blockedErr)
+	doing := "Doing"
+	_, err = s.UpdateCard("alpha", 1, model.CardPatch{Status: &doing}, false, 0)
+	require.ErrorAs(t, err, &blockedErr)
+	card, err := s.MoveCard("alpha", 1, "Backlog", model.CardPosition{After: 2}, false, 0)
+	require.NoError(t, err, "reordering within the first status is no guarded move")
+	require.Equal(t, "Backlog", card.Status)
+	_, err = s.MoveCard("alpha", 2, "Doing", model.CardPosition{}, false, 0)
+	require.NoError(t, err, "an unblocked card moves freely")
+
+	card, err = s.UpdateCard("alpha", 1, model.CardPatch{Status: &doing}, true, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", card.Status)
+	_, err = s.MoveCard("alpha", 1, "Backlog", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+
+	_, err = s.MoveCard("beta", 1, "Done", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+	card, err = s.MoveCard("alpha", 1, "Todo", model.CardPosition{}, false, 0)
+	require.NoError(t, err, "a blocker in its done status no longer blocks")
+	require.Equal(t, "Todo", card.Status)
+}
+
+func TestMarkdownStoreCardRanks(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Ranked", "", "")
+	require.NoError(t, err)
+	for _, title := range []string{"One", "Two", "Three"} {
+		_, err := s.CreateCard("ranked", title, "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+	column := func(status string) []int {
+		t.Helper()
+		_, cards, err := s.Snapshot()
+		require.NoError(t, err)
+		cards = slices.DeleteFunc(cards, func(card model.Card) bool { return card.Status != status })
+		slices.SortFunc(cards, compareCardRanks)
+		out := make([]int, 0, len(cards))
+		for _, card := range cards {
+			out = append(out, card.Number)
+		}
+		return out
+	}
+	require.Equal(t, []int{1, 2, 3}, column("Todo"), "new cards go to the bottom")
+
+	card, err := s.MoveCard("ranked", 3, "Todo", model.CardPosition{Before: 1}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, "status=Todo before=1", card.History[len(card.History)-1].Details)
+	require.Equal(t, []int{3, 1, 2}, column("Todo"))
+	card, err = s.MoveCard("ranked", 2, "Todo", model.CardPosition{After: 3}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 2, 1}, column("Todo"))
+
+	unchanged, err := s.MoveCard("ranked", 2, "Todo", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, card.Rank, unchanged.Rank, "a move without a position keeps the rank")
+
+	_, err = s.MoveCard("ranked", 1, "Doing", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+	_, err = s.MoveCard("ranked", 2, "Doing", model.CardPosition{Before: 1}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 1}, column("Doing"))
+	require.Equal(t, []int{3}, column("Todo"))
+
+	_, err = s.MoveCard("ranked", 3, "Todo", model.CardPosition{Before: 1}, false, 0)
+	require.ErrorContains(t, err, "card 1 is not in status Todo")
+	_, err = s.MoveCard("ranked", 3, "Todo", model.CardPosition{Before: 9}, false, 0)
+	require.ErrorContains(t, err, "card 9 not found")
+	_, err = s.MoveCard("ranked", 3, "Todo", model.CardPosition{After: 3}, false, 0)
+	require.ErrorContains(t, err, "relative to itself")
+	_, err = s.MoveCard("ranked", 1, "Doing", model.CardPosition{Before: 2, After: 2}, false, 0)
+	require.Error(t, err)
+
+	// Cards written before ranks existed sort by number until they move.
+	path := filepath.Join(root, "projects", "ranked", "card-3.md")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := slices.DeleteFunc(strings.Split(string(data), "\n"), func(line string) bool { return strings.HasPrefix(line, "rank:") })
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644))
+	legacy, err := s.GetCard("ranked", 3)
+	require.NoError(t, err)
+	require.Equal(t, defaultRank(3), legacy.Rank)
+	moved, err := s.MoveCard("ranked", 3, "Doing", model.CardPosition{After: 2}, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3, 1}, column("Doing"))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "rank: "+moved.Rank+"\n")
+}
+
+func TestMarkdownStoreDoctor(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Doc", "", "")
+	require.NoError(t, err)
+	for _, title := range []string{"One", "Two", "Three"} {
+		_, err := s.CreateCard("doc", title, "", "", "Todo", "", false)
+		require.NoError(t, err)
+	}
+	for _, text := range []string{"first", "second"} {
+		_, _, err := s.AddTodo("doc", 3, text, 0)
+		require.NoError(t, err)
+	}
+	dir := filepath.Join(root, "projects", "doc")
+	edit := func(name, old, replacement string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(data), old)
+		require.NoError(t, os.WriteFile(path, []byte(strings.Replace(string(data), old, replacement, 1)), 0o644))
+	}
+	edit("card-2.md", "number: 2\n", "number: 7\n")
+	edit("card-3.md", "## 2 | open", "## 1 | open")
+	edit("card-3.md", "next_todo_id: 3", "next_todo_id: 2")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "card-5.md"), []byte("---\ntitle: [unclosed\n---\n"), 0o644))
+	edit("project.md", "next_card_seq: 4", "next_card_seq: 2")
+	stale := filepath.Join(dir, ".tmp-123")
+	require.NoError(t, os.WriteFile(stale, []byte("partial"), 0o644))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(stale, old, old))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".tmp-456"), []byte("in flight"), 0o644))
+
+	_, _, err = s.Snapshot()
+	require.Error(t, err, "the unparsable card breaks a plain snapshot")
+
+	kinds := func(report DoctorReport) map[string]string {
+		out := map[string]string{}
+		for _, issue := range report.Issues {
+			out[issue.Path+" "+issue.Kind] = issue.Detail
+		}
+		return out
+	}
+	report, err := s.Doctor(false)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.ProjectsChecked)
+	require.Equal(t, 3, report.CardsChecked)
+	require.Equal(t, map[string]string{
+		"projects/doc/.tmp-123 temp_file":          "left behind by an interrupted write",
+		"projects/doc/card-2.md card_mismatch":     "frontmatter has id=doc/card-2 project=doc number=7",
+		"projects/doc/card-3.md duplicate_todo_id": "todo ids used more than once: 1",
+		"projects/doc/card-5.md parse_error":       "yaml: line 1: did not find expected ',' or ']'",
+		"projects/doc/project.md next_card_seq":    "next_card_seq is 2 but card-5.md exists",
+	}, kinds(report))
+	require.Equal(t, 5, report.Unfixed())
+	require.FileExists(t, stale, "a report without fix changes nothing")
+
+	report, err = s.Doctor(true)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 5)
+	require.Zero(t, report.Unfixed())
+
+	report, err = s.Doctor(false)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+	require.NoFileExists(t, stale)
+	require.FileExists(t, filepath.Join(dir, ".tmp-456"), "a recent temp file may belong to a write in progress")
+	quarantined, err := filepath.Glob(filepath.Join(root, ".quarantine", "*", "projects", "doc", "card-5.md"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+
+	projects, cards, err := s.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, 6, projects[0].NextCardSeq, "numbers of quarantined cards are not reused")
+	require.Len(t, cards, 3)
+	require.Equal(t, 2, cards[1].Number)
+	require.Equal(t, "card.repaired", cards[1].History[len(cards[1].History)-1].Type)
+	require.Equal(t, []int{1, 2}, []int{cards[2].Todos[0].ID, cards[2].Todos[1].ID})
+	require.Equal(t, 3, cards[2].NextTodoID)
+}
+
+func TestMarkdownStoreQuarantineUnreadable(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Fine", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "projects", "orphan"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "projects", "alpha", "card-2.md"), []byte("no frontmatter"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "projects", "alpha", "card-3.md"), []byte("---\nnumber: 1\n---\n"), 0o644))
+
+	quarantined, err := s.QuarantineUnreadable()
+	require.NoError(t, err)
+	paths := make([]string, 0, len(quarantined))
+	for _, issue := range quarantined {
+		require.True(t, issue.Fixed)
+		paths = append(paths, issue.Path)
+	}
+	require.Equal(t, []string{"projects/alpha/card-2.md", "projects/orphan"}, paths)
+
+	projects, cards, err := s.Snapshot()
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Len(t, cards, 2, "a card that parses is left for doctor to repair")
+}
+
+func TestMarkdownStoreKeepsHandWrittenContent(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Hand", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("hand", "Edited by hand", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	cardPath := filepath.Join(root, "projects", "hand", "card-1.md")
+	data, err := os.ReadFile(cardPath)
+	require.NoError(t, err)
+	edited := strings.Replace(string(data), "status: Todo\n", "status: Todo\nestimate: 3\nlinks:\n    design: https://example.com/d\n", 1)
+	edited = strings.Replace(edited, "# Todos\n", "# Notes\nTalked to ops.\n\n## Open questions\n- rollout window?\n\n# Todos\n", 1)
+	edited += "# Links\n- https://example.com/ticket\n"
+	require.NoError(t, os.WriteFile(cardPath, []byte(edited), 0o644))
+
+	card, err := s.AddComment("hand", 1, "still kept", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Talked to ops.\n\n## Open questions\n- rollout window?", card.Notes)
+	_, err = s.MoveCard("hand", 1, "Doing", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+
+	data, err = os.ReadFile(cardPath)
+	require.NoError(t, err)
+	raw := string(data)
+	require.Contains(t, raw, "estimate: 3\nlinks:\n    design: https://example.com/d\n---\n")
+	require.Contains(t, raw, "# Notes\nTalked to ops.\n\n## Open questions\n- rollout window?\n\n# Todos\n")
+	require.True(t, strings.HasSuffix(raw, "\n# Links\n- https://example.com/ticket\n"), raw)
+	card, err = s.GetCard("hand", 1)
+	require.NoError(t, err)
+	require.Equal(t, "Doing", card.Status)
+	require.Len(t, card.Comments, 1)
+	require.Empty(t, card.Todos, "a notes subheading is not read as a todo")
+
+	projectPath := filepath.Join(root, "projects", "hand", "project.md")
+	data, err = os.ReadFile(projectPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(projectPath, []byte(strings.Replace(string(data), "next_card_seq: 2\n", "next_card_seq: 2\nowner: ops\n", 1)+"\n# Links\n- https://example.com/wiki\n"), 0o644))
+	name := "Hand Made"
+	_, err = s.UpdateProject("hand", model.ProjectPatch{Name: &name})
+	require.NoError(t, err)
+	data, err = os.ReadFile(projectPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "owner: ops\n---\n# Project\nHand Made\n\n# Links\n- https://example.com/wiki\n")
+}
+
+func TestMarkdownStoreEscapesEntryBodies(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewMarkdownStore(root)
+	require.NoError(t, err)
+
+	_, err = s.CreateProject("Escape", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("escape", "Pasted markdown", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	comment := "Release notes:\n# Todos\n## 1 | done\n(none)\n\\ trailing backslash"
+	_, err = s.AddComment("escape", 1, comment, 0)
+	require.NoError(t, err)
+	_, _, err = s.AddTodo("escape", 1, "## not a heading", 0)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(root, "projects", "escape", "card-1.md"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "Release notes:\n\\# Todos\n\\## 1 | done\n\\(none)\n\\\\ trailing backslash\n")
+
+	card, err := s.GetCard("escape", 1)
+	require.NoError(t, err)
+	require.Len(t, card.Comments, 1)
+	require.Equal(t, comment, card.Comments[0].Body)
+	require.Len(t, card.Todos, 1)
+	require.Equal(t, "## not a heading", card.Todos[0].Text)
+}
@@ -15,7 +15,7 @@ import (
 func TestMarkdownStoreUsesRWMutex(t *testing.T) {
 	s, err := NewMarkdownStore(t.TempDir())
 	require.NoError(t, err)
-	require.Equal(t, reflect.TypeOf(sync.RWMutex{}), reflect.TypeOf(s.mu))
+	require.Equal(t, reflect.TypeOf(&sync.RWMutex{}), reflect.TypeOf(&s.mu))
 }
 
 func TestGetProjectBlocksWhileWriteLockHeld(t *testing.T) {
@@ -96,3 +96,33 @@ func TestValidateBranchName(t *testing.T) {
 	require.Error(t, validateBranchName("foo/.bar"))
 	require.Error(t, validateBranchName("foo/bar.lock/baz"))
 }
+
+func TestRankBetween(t *testing.T) {
+	t.Parallel()
+
+	pairs := [][2]string{
+		{"", ""}, {"", "1"}, {"i", ""}, {"z", ""}, {"i", "j"}, {"a", "a1"}, {"0i", "1"}, {"zzz", ""}, {"", "01"},
+	}
+	for _, pair := range pairs {
+		rank := rankBetween(pair[0], pair[1])
+		require.True(t, validRank(rank), "rank %q between %q and %q", rank, pair[0], pair[1])
+		require.Greater(t, rank, pair[0])
+		if pair[1] != "" {
+			require.Less(t, rank, pair[1])
+		}
+	}
+
+	// Repeatedly inserting at the top keeps producing distinct, ordered ranks.
+	upper := rankBetween("", "")
+	for range 100 {
+		rank := rankBetween("", upper)
+		require.Less(t, rank, upper)
+		upper = rank
+	}
+
+	require.Less(t, defaultRank(9), defaultRank(10))
+	require.Less(t, defaultRank(35), defaultRank(36))
+	require.True(t, validRank(defaultRank(1)))
+	require.False(t, validRank("a0"))
+	require.False(t, validRank("A"))
+}
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// validateParentUnlocked checks that a parent card ID names a live card and
+// returns it trimmed. An empty ID means no parent.
+func (s *MarkdownStore) validateParentUnlocked(parentID string) (string, error) {
+	parentID = strings.TrimSpace(parentID)
+	if parentID == "" {
+		return "", nil
+	}
+	slug, number, err := model.ParseCardID(parentID)
+	if err != nil {
+		return "", err
+	}
+	parent, err := s.getCardUnlocked(slug, number)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("parent card %s not found", parentID)
+		}
+		return "", err
+	}
+	if parent.MovedTo != "" {
+		return "", fmt.Errorf("parent card %s was moved to %s", parentID, parent.MovedTo)
+	}
+	if parent.Deleted {
+		return "", fmt.Errorf("parent card %s is deleted", parentID)
+	}
+	return parent.ID, nil
+}
+
+// reparentChildrenUnlocked points every child of oldID at newID, or detaches
+// the children when newID is empty. Children may live in any project, so all
+// of them are scanned.
+func (s *MarkdownStore) reparentChildrenUnlocked(tx *journalTx, oldID, newID string, now time.Time) error {
+	projects, err := s.listProjectsUnlocked()
+	if err != nil {
+		return err
+	}
+	for _, project := range projects {
+		cards, err := s.listProjectCards(project.Slug)
+		if err != nil {
+			return err
+		}
+		for _, child := range cards {
+			if child.ParentID != oldID {
+				continue
+			}
+			child.ParentID = newID
+			child.UpdatedAt = now
+			child.History = append(child.History, model.HistoryEvent{Timestamp: now, Type: "card.parent.updated", Details: fieldChange("parent", oldID, newID)})
+			if err := tx.writeCard(&child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// Ranks order the cards of a status column. They are base-36 strings compared
+// byte-wise, so a card can always be placed between two others by rewriting
+// only its own rank. A rank never ends in '0', which keeps room below it.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// rankBetween returns a rank sorting after before and before after. An empty
+// before means the top of the column and an empty after the bottom.
+func rankBetween(before, after string) string {
+	var out []byte
+	upperBound := after != ""
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(before) {
+			lo = strings.IndexByte(rankDigits, before[i])
+		}
+		hi := len(rankDigits)
+		if upperBound && i < len(after) {
+			hi = strings.IndexByte(rankDigits, after[i])
+		}
+		if hi-lo > 1 {
+			return string(append(out, rankDigits[(lo+hi)/2]))
+		}
+		out = append(out, rankDigits[lo])
+		if hi > lo {
+			upperBound = false
+		}
+	}
+}
+
+// defaultRank ranks a card written before ranks existed by its number, so
+// such cards keep their creation order.
+func defaultRank(number int) string {
+	return fmt.Sprintf("%06s", strconv.FormatInt(int64(number), 36)) + "i"
+}
+
+func validRank(rank string) bool {
+	if rank == "" || strings.HasSuffix(rank, "0") {
+		return false
+	}
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func compareCardRanks(a, b model.Card) int {
+	return cmp.Or(strings.Compare(a.Rank, b.Rank), cmp.Compare(a.Number, b.Number))
+}
+
+// rankCardUnlocked picks card's rank in the status column it is in. The zero
+// position puts it at the bottom; otherwise it goes directly before or after
+// another card of the column.
+func (s *MarkdownStore) rankCardUnlocked(card model.Card, position model.CardPosition) (string, error) {
+	if position.Before != 0 && position.After != 0 {
+		return "", errors.New("position takes either before or after, not both")
+	}
+	cards, err := s.listProjectCards(card.ProjectSlug)
+	if err != nil {
+		return "", err
+	}
+	column := slices.DeleteFunc(cards, func(other model.Card) bool {
+		return other.Number == card.Number || other.Status != card.Status || other.MovedTo != ""
+	})
+	slices.SortFunc(column, compareCardRanks)
+
+	anchor := position.Before + position.After
+	if anchor == 0 {
+		if len(column) == 0 {
+			return rankBetween("", ""), nil
+		}
+		return rankBetween(column[len(column)-1].Rank, ""), nil
+	}
+	if anchor == card.Number {
+		return "", fmt.Errorf("card %d cannot be placed relative to itself", anchor)
+	}
+	idx := slices.IndexFunc(column, func(other model.Card) bool { return other.Number == anchor })
+	if idx < 0 {
+		if _, err := s.getCardUnlocked(card.ProjectSlug, anchor); errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("card %d not found", anchor)
+		}
+		return "", fmt.Errorf("card %d is not in status %s", anchor, card.Status)
+	}
+	var lower, upper string
+	if position.Before != 0 {
+		upper = column[idx].Rank
+		if idx > 0 {
+			lower = column[idx-1].Rank
+		}
+	} else {
+		lower = column[idx].Rank
+		if idx+1 < len(column) {
+			upper = column[idx+1].Rank
+		}
+	}
+	if upper != "" && lower >= upper {
+		return "", fmt.Errorf("cards around card %d share rank %s; move one of them first", anchor, upper)
+	}
+	return rankBetween(lower, upper), nil
+}
@@ -0,0 +1,262 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+type cardRelationFrontmatter struct {
+	Type string `yaml:"type"`
+	Card string `yaml:"card"`
+}
+
+// AddRelation links a card to another card and records the inverse relation on
+// the other card. Both cards are returned, the source first.
+func (s *MarkdownStore) AddRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (_, _ model.Card, err error) {
+	targetSlug, _, _ := model.ParseCardID(targetID)
+	unlock, err := s.lockProjects(projectSlug, targetSlug)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	defer unlock()
+
+	relationType = strings.TrimSpace(relationType)
+	inverse, ok := model.InverseRelation[relationType]
+	if !ok {
+		return model.Card{}, model.Card{}, fmt.Errorf("invalid relation type %q", relationType)
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	targetSlug, targetNumber, err := model.ParseCardID(targetID)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if targetSlug == projectSlug && targetNumber == number {
+		return model.Card{}, model.Card{}, errors.New("a card cannot be related to itself")
+	}
+	target, err := s.getCardUnlocked(targetSlug, targetNumber)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, model.Card{}, fmt.Errorf("related card %s not found", targetID)
+		}
+		return model.Card{}, model.Card{}, err
+	}
+	if target.Deleted {
+		return model.Card{}, model.Card{}, fmt.Errorf("related card %s is deleted", target.ID)
+	}
+	relation := model.CardRelation{Type: relationType, CardID: target.ID}
+	if slices.Contains(card.Relations, relation) {
+		return model.Card{}, model.Card{}, fmt.Errorf("card %d already %s %s", number, relationType, target.ID)
+	}
+
+	now := time.Now().UTC()
+	card.Relations = append(card.Relations, relation)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.relation.added", Details: fmt.Sprintf("%s %s", relationType, target.ID)})
+	reverse := model.CardRelation{Type: inverse, CardID: card.ID}
+	if !slices.Contains(target.Relations, reverse) {
+		target.Relations = append(target.Relations, reverse)
+		target.UpdatedAt = now
+		target.History = append(target.History, model.HistoryEvent{Timestamp: now, Type: "card.relation.added", Details: fmt.Sprintf("%s %s", inverse, card.ID)})
+	}
+	tx := s.beginJournal("card.relation.add")
+	defer tx.end(&err)
+	if err := tx.writeCard(&card); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.writeCard(&target); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	return card, target, nil
+}
+
+// RemoveRelation unlinks two cards. The other card is returned zero-valued
+// when it no longer exists, e.g. because its project was deleted.
+func (s *MarkdownStore) RemoveRelation(projectSlug string, number int, relationType, targetID string, expectedRevision int) (_, _ model.Card, err error) {
+	targetSlug, _, _ := model.ParseCardID(targetID)
+	unlock, err := s.lockProjects(projectSlug, targetSlug)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	defer unlock()
+
+	relationType = strings.TrimSpace(relationType)
+	inverse, ok := model.InverseRelation[relationType]
+	if !ok {
+		return model.Card{}, model.Card{}, fmt.Errorf("invalid relation type %q", relationType)
+	}
+	card, err := s.getCardAtRevisionUnlocked(projectSlug, number, expectedRevision)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	targetID = strings.TrimSpace(targetID)
+	idx := slices.Index(card.Relations, model.CardRelation{Type: relationType, CardID: targetID})
+	if idx < 0 {
+		return model.Card{}, model.Card{}, fmt.Errorf("card %d has no %s relation to %s", number, relationType, targetID)
+	}
+
+	now := time.Now().UTC()
+	card.Relations = slices.Delete(card.Relations, idx, idx+1)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.relation.removed", Details: fmt.Sprintf("%s %s", relationType, targetID)})
+	tx := s.beginJournal("card.relation.remove")
+	defer tx.end(&err)
+	if err := tx.writeCard(&card); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	target, err := s.unlinkRelationUnlocked(tx, targetID, model.CardRelation{Type: inverse, CardID: card.ID}, now)
+	if err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Card{}, model.Card{}, err
+	}
+	return card, target, nil
+}
+
+// checkBlockersUnlocked refuses moving card to status while cards block it
+// that are neither in their project's done status nor deleted, when its project
+// guards the move, unless force is set. The caller holds the locks of the
+// blocking cards' projects; see lockProjectsWithBlockers.
+func (s *MarkdownStore) checkBlockersUnlocked(project model.Project, card model.Card, status string, force bool) error {
+	if force || !project.GuardsBlockedMove(card.Status, status) {
+		return nil
+	}
+	var blockers []string
+	for _, relation := range card.Relations {
+		if relation.Type != model.RelationBlockedBy {
+			continue
+		}
+		slug, number, err := model.ParseCardID(relation.CardID)
+		if err != nil {
+			continue
+		}
+		blocker, err := s.getCardUnlocked(slug, number)
+		if err != nil || blocker.Deleted {
+			continue
+		}
+		if blockerProject, err := s.loadProject(slug); err == nil && blocker.Status == blockerProject.DoneStatus() {
+			continue
+		}
+		blockers = append(blockers, blocker.ID)
+	}
+	if len(blockers) == 0 {
+		return nil
+	}
+	return &model.BlockedError{Number: card.Number, Blockers: blockers}
+}
+
+// unlinkRelationUnlocked drops one relation from the card with the given ID.
+// A missing card, or one without the relation, is left alone and returned
+// zero-valued.
+func (s *MarkdownStore) unlinkRelationUnlocked(tx *journalTx, cardID string, relation model.CardRelation, now time.Time) (model.Card, error) {
+	slug, number, err := model.ParseCardID(cardID)
+	if err != nil {
+		return model.Card{}, nil
+	}
+	card, err := s.getCardUnlocked(slug, number)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return model.Card{}, nil
+		}
+		return model.Card{}, err
+	}
+	idx := slices.Index(card.Relations, relation)
+	if idx < 0 {
+		return model.Card{}, nil
+	}
+	card.Relations = slices.Delete(card.Relations, idx, idx+1)
+	card.UpdatedAt = now
+	card.History = append(card.History, model.HistoryEvent{Timestamp: now, Type: "card.relation.removed", Details: fmt.Sprintf("%s %s", relation.Type, relation.CardID)})
+	if err := tx.writeCard(&card); err != nil {
+		return model.Card{}, err
+	}
+	return card, nil
+}
+
+// unlinkAllRelationsUnlocked removes the inverse side of every relation of a
+// card that is going away for good.
+func (s *MarkdownStore) unlinkAllRelationsUnlocked(tx *journalTx, card model.Card, now time.Time) error {
+	for _, relation := range card.Relations {
+		inverse := model.CardRelation{Type: model.InverseRelation[relation.Type], CardID: card.ID}
+		if _, err := s.unlinkRelationUnlocked(tx, relation.CardID, inverse, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relinkRelationsUnlocked points the inverse side of a transferred card's
+// relations at its new ID.
+func (s *MarkdownStore) relinkRelationsUnlocked(tx *journalTx, oldID string, moved model.Card, now time.Time) error {
+	for _, relation := range moved.Relations {
+		slug, number, err := model.ParseCardID(relation.CardID)
+		if err != nil {
+			continue
+		}
+		other, err := s.getCardUnlocked(slug, number)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		changed := false
+		for i := range other.Relations {
+			if other.Relations[i].CardID == oldID {
+				other.Relations[i].CardID = moved.ID
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		other.UpdatedAt = now
+		other.History = append(other.History, model.HistoryEvent{Timestamp: now, Type: "card.relation.updated", Details: fmt.Sprintf("%s moved to %s", oldID, moved.ID)})
+		if err := tx.writeCard(&other); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func relationsToFrontmatter(relations []model.CardRelation) []cardRelationFrontmatter {
+	if len(relations) == 0 {
+		return nil
+	}
+	out := make([]cardRelationFrontmatter, 0, len(relations))
+	for _, relation := range relations {
+		out = append(out, cardRelationFrontmatter{Type: relation.Type, Card: relation.CardID})
+	}
+	return out
+}
+
+// relationsFromFrontmatter drops unknown relation types and duplicates a hand
+// edit may have left behind.
+func relationsFromFrontmatter(relations []cardRelationFrontmatter) []model.CardRelation {
+	if len(relations) == 0 {
+		return nil
+	}
+	out := make([]model.CardRelation, 0, len(relations))
+	for _, raw := range relations {
+		relation := model.CardRelation{Type: strings.TrimSpace(raw.Type), CardID: strings.TrimSpace(raw.Card)}
+		if _, ok := model.InverseRelation[relation.Type]; !ok || relation.CardID == "" {
+			continue
+		}
+		if !slices.Contains(out, relation) {
+			out = append(out, relation)
+		}
+	}
+	return out
+}
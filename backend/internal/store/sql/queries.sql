@@ -6,7 +6,9 @@ CREATE TABLE IF NOT EXISTS projects (
   remote_url TEXT,
   next_card_seq INTEGER NOT NULL,
   created_at TEXT NOT NULL,
-  updated_at TEXT NOT NULL
+  updated_at TEXT NOT NULL,
+  done_status TEXT NOT NULL,
+  statuses TEXT NOT NULL
 );
 
 -- name: InitCardsTable :exec
@@ -18,6 +20,7 @@ CREATE TABLE IF NOT EXISTS cards (
   branch TEXT,
   status TEXT NOT NULL,
   deleted INTEGER NOT NULL,
+  revision INTEGER NOT NULL,
   created_at TEXT NOT NULL,
   updated_at TEXT NOT NULL,
   comments_count INTEGER NOT NULL,
@@ -26,25 +29,47 @@ CREATE TABLE IF NOT EXISTS cards (
   todos_completed_count INTEGER NOT NULL,
   acceptance_criteria_count INTEGER NOT NULL,
   acceptance_criteria_completed_count INTEGER NOT NULL,
+  moved_to TEXT,
+  priority TEXT,
+  due_at TEXT,
+  parent_id TEXT,
+  rank TEXT NOT NULL,
   UNIQUE(project_slug, number)
 );
 
+-- name: InitCardLabelsTable :exec
+CREATE TABLE IF NOT EXISTS card_labels (
+  card_id TEXT NOT NULL,
+  label TEXT NOT NULL,
+  PRIMARY KEY(card_id, label)
+);
+
+-- name: InitCardRelationsTable :exec
+CREATE TABLE IF NOT EXISTS card_relations (
+  card_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  target_id TEXT NOT NULL,
+  PRIMARY KEY(card_id, type, target_id)
+);
+
 -- name: UpsertProject :exec
-INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at, done_status, statuses)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(slug) DO UPDATE SET
   name = excluded.name,
   local_path = excluded.local_path,
   remote_url = excluded.remote_url,
   next_card_seq = excluded.next_card_seq,
   created_at = excluded.created_at,
-  updated_at = excluded.updated_at;
+  updated_at = excluded.updated_at,
+  done_status = excluded.done_status,
+  statuses = excluded.statuses;
 
 -- name: UpsertCard :exec
 INSERT INTO cards (
-  id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+  id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 )
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
   project_slug = excluded.project_slug,
   number = excluded.number,
@@ -52,6 +77,7 @@ ON CONFLICT(id) DO UPDATE SET
   branch = excluded.branch,
   status = excluded.status,
   deleted = excluded.deleted,
+  revision = excluded.revision,
   created_at = excluded.created_at,
   updated_at = excluded.updated_at,
   comments_count = excluded.comments_count,
@@ -59,7 +85,40 @@ ON CONFLICT(id) DO UPDATE SET
   todos_count = excluded.todos_count,
   todos_completed_count = excluded.todos_completed_count,
   acceptance_criteria_count = excluded.acceptance_criteria_count,
-  acceptance_criteria_completed_count = excluded.acceptance_criteria_completed_count;
+  acceptance_criteria_completed_count = excluded.acceptance_criteria_completed_count,
+  moved_to = excluded.moved_to,
+  priority = excluded.priority,
+  due_at = excluded.due_at,
+  parent_id = excluded.parent_id,
+  rank = excluded.rank;
+
+-- name: InsertCardLabel :exec
+INSERT INTO card_labels (card_id, label) VALUES (?, ?);
+
+-- name: DeleteCardLabels :exec
+DELETE FROM card_labels WHERE card_id = ?;
+
+-- name: DeleteCardLabelsByNumber :exec
+DELETE FROM card_labels
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ? AND number = ?);
+
+-- name: DeleteCardLabelsByProject :exec
+DELETE FROM card_labels
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ?);
+
+-- name: InsertCardRelation :exec
+INSERT INTO card_relations (card_id, type, target_id) VALUES (?, ?, ?);
+
+-- name: DeleteCardRelations :exec
+DELETE FROM card_relations WHERE card_id = ?;
+
+-- name: DeleteCardRelationsByNumber :exec
+DELETE FROM card_relations
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ? AND number = ?);
+
+-- name: DeleteCardRelationsByProject :exec
+DELETE FROM card_relations
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ?);
 
 -- name: HardDeleteCard :exec
 DELETE FROM cards WHERE project_slug = ? AND number = ?;
@@ -71,16 +130,82 @@ DELETE FROM cards WHERE project_slug = ?;
 DELETE FROM projects WHERE slug = ?;
 
 -- name: ListCardsActive :many
-SELECT id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 FROM cards
 WHERE project_slug = ? AND deleted = 0
-ORDER BY number ASC;
+ORDER BY status ASC, rank ASC, number ASC;
 
 -- name: ListCardsWithDeleted :many
-SELECT id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 FROM cards
 WHERE project_slug = ?
-ORDER BY number ASC;
+ORDER BY status ASC, rank ASC, number ASC;
+
+-- name: ListCardsActiveByLabel :many
+SELECT cards.id, cards.project_slug, cards.number, cards.title, cards.branch, cards.status, cards.deleted, cards.revision, cards.created_at, cards.updated_at, cards.comments_count, cards.history_count, cards.todos_count, cards.todos_completed_count, cards.acceptance_criteria_count, cards.acceptance_criteria_completed_count, cards.moved_to, cards.priority, cards.due_at, cards.parent_id, cards.rank
+FROM cards
+JOIN card_labels ON card_labels.card_id = cards.id
+WHERE cards.project_slug = ? AND cards.deleted = 0 AND card_labels.label = ?
+ORDER BY cards.status ASC, cards.rank ASC, cards.number ASC;
+
+-- name: ListCardsWithDeletedByLabel :many
+SELECT cards.id, cards.project_slug, cards.number, cards.title, cards.branch, cards.status, cards.deleted, cards.revision, cards.created_at, cards.updated_at, cards.comments_count, cards.history_count, cards.todos_count, cards.todos_completed_count, cards.acceptance_criteria_count, cards.acceptance_criteria_completed_count, cards.moved_to, cards.priority, cards.due_at, cards.parent_id, cards.rank
+FROM cards
+JOIN card_labels ON card_labels.card_id = cards.id
+WHERE cards.project_slug = ? AND card_labels.label = ?
+ORDER BY cards.status ASC, cards.rank ASC, cards.number ASC;
+
+-- name: ListCardLabelsByProject :many
+SELECT card_labels.card_id, card_labels.label
+FROM card_labels
+JOIN cards ON cards.id = card_labels.card_id
+WHERE cards.project_slug = ?
+ORDER BY card_labels.card_id ASC, card_labels.label ASC;
+
+-- name: DeleteAllCardLabels :exec
+DELETE FROM card_labels;
+
+-- name: ListBlockedCardIDsByProject :many
+SELECT DISTINCT card_relations.card_id
+FROM card_relations
+JOIN cards ON cards.id = card_relations.card_id
+JOIN cards AS blockers ON blockers.id = card_relations.target_id
+JOIN projects AS blocker_projects ON blocker_projects.slug = blockers.project_slug
+WHERE cards.project_slug = ? AND card_relations.type = 'blocked_by' AND blockers.deleted = 0 AND blockers.status != blocker_projects.done_status
+ORDER BY card_relations.card_id ASC;
+
+-- name: GetCardByID :one
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
+FROM cards
+WHERE id = ?;
+
+-- name: ListChildCards :many
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
+FROM cards
+WHERE parent_id = ? AND deleted = 0
+ORDER BY project_slug ASC, number ASC;
+
+-- name: ListChildCountsByProject :many
+SELECT parents.id AS parent_id, COUNT(*) AS children_total, CAST(SUM(CASE WHEN children.status = child_projects.done_status THEN 1 ELSE 0 END) AS INTEGER) AS children_done
+FROM cards AS parents
+JOIN cards AS children ON children.parent_id = parents.id
+JOIN projects AS child_projects ON child_projects.slug = children.project_slug
+WHERE parents.project_slug = ? AND children.deleted = 0
+GROUP BY parents.id
+ORDER BY parents.id ASC;
+
+-- name: GetProjectStatuses :one
+SELECT statuses
+FROM projects
+WHERE slug = ?;
+
+-- name: ProjectExists :one
+SELECT COUNT(*)
+FROM projects
+WHERE slug = ?;
+
+-- name: DeleteAllCardRelations :exec
+DELETE FROM card_relations;
 
 -- name: DeleteAllCards :exec
 DELETE FROM cards;
@@ -89,11 +214,11 @@ DELETE FROM cards;
 DELETE FROM projects;
 
 -- name: InsertProject :exec
-INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?);
+INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at, done_status, statuses)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: InsertCard :exec
 INSERT INTO cards (
-  id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+  id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 )
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
@@ -5,7 +5,9 @@ CREATE TABLE IF NOT EXISTS projects (
   remote_url TEXT,
   next_card_seq INTEGER NOT NULL,
   created_at TEXT NOT NULL,
-  updated_at TEXT NOT NULL
+  updated_at TEXT NOT NULL,
+  done_status TEXT NOT NULL,
+  statuses TEXT NOT NULL
 );
 
 CREATE TABLE IF NOT EXISTS cards (
@@ -16,6 +18,7 @@ CREATE TABLE IF NOT EXISTS cards (
   branch TEXT,
   status TEXT NOT NULL,
   deleted INTEGER NOT NULL,
+  revision INTEGER NOT NULL,
   created_at TEXT NOT NULL,
   updated_at TEXT NOT NULL,
   comments_count INTEGER NOT NULL,
@@ -24,5 +27,23 @@ CREATE TABLE IF NOT EXISTS cards (
   todos_completed_count INTEGER NOT NULL,
   acceptance_criteria_count INTEGER NOT NULL,
   acceptance_criteria_completed_count INTEGER NOT NULL,
+  moved_to TEXT,
+  priority TEXT,
+  due_at TEXT,
+  parent_id TEXT,
+  rank TEXT NOT NULL,
   UNIQUE(project_slug, number)
 );
+
+CREATE TABLE IF NOT EXISTS card_labels (
+  card_id TEXT NOT NULL,
+  label TEXT NOT NULL,
+  PRIMARY KEY(card_id, label)
+);
+
+CREATE TABLE IF NOT EXISTS card_relations (
+  card_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  target_id TEXT NOT NULL,
+  PRIMARY KEY(card_id, type, target_id)
+);
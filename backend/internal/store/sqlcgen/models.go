@@ -16,6 +16,7 @@ type Card struct {
 	Branch                           sql.NullString
 	Status                           string
 	Deleted                          int64
+	Revision                         int64
 	CreatedAt                        string
 	UpdatedAt                        string
 	CommentsCount                    int64
@@ -24,6 +25,16 @@ type Card struct {
 	TodosCompletedCount              int64
 	AcceptanceCriteriaCount          int64
 	AcceptanceCriteriaCompletedCount int64
+	MovedTo                          sql.NullString
+	Priority                         sql.NullString
+	DueAt                            sql.NullString
+	ParentID                         sql.NullString
+	Rank                             string
+}
+
+type CardLabel struct {
+	CardID string
+	Label  string
 }
 
 type Project struct {
@@ -34,4 +45,6 @@ type Project struct {
 	NextCardSeq int64
 	CreatedAt   string
 	UpdatedAt   string
+	DoneStatus  string
+	Statuses    string
 }
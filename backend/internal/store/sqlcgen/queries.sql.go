@@ -10,6 +10,24 @@ import (
 	"database/sql"
 )
 
+const deleteAllCardLabels = `-- name: DeleteAllCardLabels :exec
+DELETE FROM card_labels
+`
+
+func (q *Queries) DeleteAllCardLabels(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteAllCardLabels)
+	return err
+}
+
+const deleteAllCardRelations = `-- name: DeleteAllCardRelations :exec
+DELETE FROM card_relations
+`
+
+func (q *Queries) DeleteAllCardRelations(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteAllCardRelations)
+	return err
+}
+
 const deleteAllCards = `-- name: DeleteAllCards :exec
 DELETE FROM cards
 `
@@ -28,6 +46,74 @@ func (q *Queries) DeleteAllProjects(ctx context.Context) error {
 	return err
 }
 
+const deleteCardLabels = `-- name: DeleteCardLabels :exec
+DELETE FROM card_labels WHERE card_id = ?
+`
+
+func (q *Queries) DeleteCardLabels(ctx context.Context, cardID string) error {
+	_, err := q.db.ExecContext(ctx, deleteCardLabels, cardID)
+	return err
+}
+
+const deleteCardLabelsByNumber = `-- name: DeleteCardLabelsByNumber :exec
+DELETE FROM card_labels
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ? AND number = ?)
+`
+
+type DeleteCardLabelsByNumberParams struct {
+	ProjectSlug string
+	Number      int64
+}
+
+func (q *Queries) DeleteCardLabelsByNumber(ctx context.Context, arg DeleteCardLabelsByNumberParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCardLabelsByNumber, arg.ProjectSlug, arg.Number)
+	return err
+}
+
+const deleteCardLabelsByProject = `-- name: DeleteCardLabelsByProject :exec
+DELETE FROM card_labels
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ?)
+`
+
+func (q *Queries) DeleteCardLabelsByProject(ctx context.Context, projectSlug string) error {
+	_, err := q.db.ExecContext(ctx, deleteCardLabelsByProject, projectSlug)
+	return err
+}
+
+const deleteCardRelations = `-- name: DeleteCardRelations :exec
+DELETE FROM card_relations WHERE card_id = ?
+`
+
+func (q *Queries) DeleteCardRelations(ctx context.Context, cardID string) error {
+	_, err := q.db.ExecContext(ctx, deleteCardRelations, cardID)
+	return err
+}
+
+const deleteCardRelationsByNumber = `-- name: DeleteCardRelationsByNumber :exec
+DELETE FROM card_relations
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ? AND number = ?)
+`
+
+type DeleteCardRelationsByNumberParams struct {
+	ProjectSlug string
+	Number      int64
+}
+
+func (q *Queries) DeleteCardRelationsByNumber(ctx context.Context, arg DeleteCardRelationsByNumberParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCardRelationsByNumber, arg.ProjectSlug, arg.Number)
+	return err
+}
+
+const deleteCardRelationsByProject = `-- name: DeleteCardRelationsByProject :exec
+DELETE FROM card_relations
+WHERE card_id IN (SELECT id FROM cards WHERE project_slug = ?)
+`
+
+func (q *Queries) DeleteCardRelationsByProject(ctx context.Context, projectSlug string) error {
+	_, err := q.db.ExecContext(ctx, deleteCardRelationsByProject, projectSlug)
+	return err
+}
+
 const deleteCardsByProject = `-- name: DeleteCardsByProject :exec
 DELETE FROM cards WHERE project_slug = ?
 `
@@ -46,6 +132,54 @@ func (q *Queries) DeleteProjectBySlug(ctx context.Context, slug string) error {
 	return err
 }
 
+const getCardByID = `-- name: GetCardByID :one
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
+FROM cards
+WHERE id = ?
+`
+
+func (q *Queries) GetCardByID(ctx context.Context, id string) (Card, error) {
+	row := q.db.QueryRowContext(ctx, getCardByID, id)
+	var i Card
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectSlug,
+		&i.Number,
+		&i.Title,
+		&i.Branch,
+		&i.Status,
+		&i.Deleted,
+		&i.Revision,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CommentsCount,
+		&i.HistoryCount,
+		&i.TodosCount,
+		&i.TodosCompletedCount,
+		&i.AcceptanceCriteriaCount,
+		&i.AcceptanceCriteriaCompletedCount,
+		&i.MovedTo,
+		&i.Priority,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Rank,
+	)
+	return i, err
+}
+
+const getProjectStatuses = `-- name: GetProjectStatuses :one
+SELECT statuses
+FROM projects
+WHERE slug = ?
+`
+
+func (q *Queries) GetProjectStatuses(ctx context.Context, slug string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProjectStatuses, slug)
+	var statuses string
+	err := row.Scan(&statuses)
+	return statuses, err
+}
+
 const hardDeleteCard = `-- name: HardDeleteCard :exec
 DELETE FROM cards WHERE project_slug = ? AND number = ?
 `
@@ -60,6 +194,33 @@ func (q *Queries) HardDeleteCard(ctx context.Context, arg HardDeleteCardParams)
 	return err
 }
 
+const initCardLabelsTable = `-- name: InitCardLabelsTable :exec
+CREATE TABLE IF NOT EXISTS card_labels (
+  card_id TEXT NOT NULL,
+  label TEXT NOT NULL,
+  PRIMARY KEY(card_id, label)
+)
+`
+
+func (q *Queries) InitCardLabelsTable(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, initCardLabelsTable)
+	return err
+}
+
+const initCardRelationsTable = `-- name: InitCardRelationsTable :exec
+CREATE TABLE IF NOT EXISTS card_relations (
+  card_id TEXT NOT NULL,
+  type TEXT NOT NULL,
+  target_id TEXT NOT NULL,
+  PRIMARY KEY(card_id, type, target_id)
+)
+`
+
+func (q *Queries) InitCardRelationsTable(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, initCardRelationsTable)
+	return err
+}
+
 const initCardsTable = `-- name: InitCardsTable :exec
 CREATE TABLE IF NOT EXISTS cards (
   id TEXT PRIMARY KEY,
@@ -69,6 +230,7 @@ CREATE TABLE IF NOT EXISTS cards (
   branch TEXT,
   status TEXT NOT NULL,
   deleted INTEGER NOT NULL,
+  revision INTEGER NOT NULL,
   created_at TEXT NOT NULL,
   updated_at TEXT NOT NULL,
   comments_count INTEGER NOT NULL,
@@ -77,6 +239,11 @@ CREATE TABLE IF NOT EXISTS cards (
   todos_completed_count INTEGER NOT NULL,
   acceptance_criteria_count INTEGER NOT NULL,
   acceptance_criteria_completed_count INTEGER NOT NULL,
+  moved_to TEXT,
+  priority TEXT,
+  due_at TEXT,
+  parent_id TEXT,
+  rank TEXT NOT NULL,
   UNIQUE(project_slug, number)
 )
 `
@@ -94,7 +261,9 @@ CREATE TABLE IF NOT EXISTS projects (
   remote_url TEXT,
   next_card_seq INTEGER NOT NULL,
   created_at TEXT NOT NULL,
-  updated_at TEXT NOT NULL
+  updated_at TEXT NOT NULL,
+  done_status TEXT NOT NULL,
+  statuses TEXT NOT NULL
 )
 `
 
@@ -105,9 +274,9 @@ func (q *Queries) InitProjectsTable(ctx context.Context) error {
 
 const insertCard = `-- name: InsertCard :exec
 INSERT INTO cards (
-  id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+  id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 )
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type InsertCardParams struct {
@@ -118,6 +287,7 @@ type InsertCardParams struct {
 	Branch                           sql.NullString
 	Status                           string
 	Deleted                          int64
+	Revision                         int64
 	CreatedAt                        string
 	UpdatedAt                        string
 	CommentsCount                    int64
@@ -126,6 +296,11 @@ type InsertCardParams struct {
 	TodosCompletedCount              int64
 	AcceptanceCriteriaCount          int64
 	AcceptanceCriteriaCompletedCount int64
+	MovedTo                          sql.NullString
+	Priority                         sql.NullString
+	DueAt                            sql.NullString
+	ParentID                         sql.NullString
+	Rank                             string
 }
 
 func (q *Queries) InsertCard(ctx context.Context, arg InsertCardParams) error {
@@ -137,6 +312,7 @@ func (q *Queries) InsertCard(ctx context.Context, arg InsertCardParams) error {
 		arg.Branch,
 		arg.Status,
 		arg.Deleted,
+		arg.Revision,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.CommentsCount,
@@ -145,13 +321,47 @@ func (q *Queries) InsertCard(ctx context.Context, arg InsertCardParams) error {
 		arg.TodosCompletedCount,
 		arg.AcceptanceCriteriaCount,
 		arg.AcceptanceCriteriaCompletedCount,
+		arg.MovedTo,
+		arg.Priority,
+		arg.DueAt,
+		arg.ParentID,
+		arg.Rank,
 	)
 	return err
 }
 
+const insertCardLabel = `-- name: InsertCardLabel :exec
+INSERT INTO card_labels (card_id, label) VALUES (?, ?)
+`
+
+type InsertCardLabelParams struct {
+	CardID string
+	Label  string
+}
+
+func (q *Queries) InsertCardLabel(ctx context.Context, arg InsertCardLabelParams) error {
+	_, err := q.db.ExecContext(ctx, insertCardLabel, arg.CardID, arg.Label)
+	return err
+}
+
+const insertCardRelation = `-- name: InsertCardRelation :exec
+INSERT INTO card_relations (card_id, type, target_id) VALUES (?, ?, ?)
+`
+
+type InsertCardRelationParams struct {
+	CardID   string
+	Type     string
+	TargetID string
+}
+
+func (q *Queries) InsertCardRelation(ctx context.Context, arg InsertCardRelationParams) error {
+	_, err := q.db.ExecContext(ctx, insertCardRelation, arg.CardID, arg.Type, arg.TargetID)
+	return err
+}
+
 const insertProject = `-- name: InsertProject :exec
-INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at, done_status, statuses)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type InsertProjectParams struct {
@@ -162,6 +372,8 @@ type InsertProjectParams struct {
 	NextCardSeq int64
 	CreatedAt   string
 	UpdatedAt   string
+	DoneStatus  string
+	Statuses    string
 }
 
 func (q *Queries) InsertProject(ctx context.Context, arg InsertProjectParams) error {
@@ -173,15 +385,81 @@ func (q *Queries) InsertProject(ctx context.Context, arg InsertProjectParams) er
 		arg.NextCardSeq,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.DoneStatus,
+		arg.Statuses,
 	)
 	return err
 }
 
+const listBlockedCardIDsByProject = `-- name: ListBlockedCardIDsByProject :many
+SELECT DISTINCT card_relations.card_id
+FROM card_relations
+JOIN cards ON cards.id = card_relations.card_id
+JOIN cards AS blockers ON blockers.id = card_relations.target_id
+JOIN projects AS blocker_projects ON blocker_projects.slug = blockers.project_slug
+WHERE cards.project_slug = ? AND card_relations.type = 'blocked_by' AND blockers.deleted = 0 AND blockers.status != blocker_projects.done_status
+ORDER BY card_relations.card_id ASC
+`
+
+func (q *Queries) ListBlockedCardIDsByProject(ctx context.Context, projectSlug string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listBlockedCardIDsByProject, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var card_id string
+		if err := rows.Scan(&card_id); err != nil {
+			return nil, err
+		}
+		items = append(items, card_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCardLabelsByProject = `-- name: ListCardLabelsByProject :many
+SELECT card_labels.card_id, card_labels.label
+FROM card_labels
+JOIN cards ON cards.id = card_labels.card_id
+WHERE cards.project_slug = ?
+ORDER BY card_labels.card_id ASC, card_labels.label ASC
+`
+
+func (q *Queries) ListCardLabelsByProject(ctx context.Context, projectSlug string) ([]CardLabel, error) {
+	rows, err := q.db.QueryContext(ctx, listCardLabelsByProject, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CardLabel{}
+	for rows.Next() {
+		var i CardLabel
+		if err := rows.Scan(&i.CardID, &i.Label); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCardsActive = `-- name: ListCardsActive :many
-SELECT id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 FROM cards
 WHERE project_slug = ? AND deleted = 0
-ORDER BY number ASC
+ORDER BY status ASC, rank ASC, number ASC
 `
 
 func (q *Queries) ListCardsActive(ctx context.Context, projectSlug string) ([]Card, error) {
@@ -201,6 +479,7 @@ func (q *Queries) ListCardsActive(ctx context.Context, projectSlug string) ([]Ca
 			&i.Branch,
 			&i.Status,
 			&i.Deleted,
+			&i.Revision,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CommentsCount,
@@ -209,6 +488,69 @@ func (q *Queries) ListCardsActive(ctx context.Context, projectSlug string) ([]Ca
 			&i.TodosCompletedCount,
 			&i.AcceptanceCriteriaCount,
 			&i.AcceptanceCriteriaCompletedCount,
+			&i.MovedTo,
+			&i.Priority,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCardsActiveByLabel = `-- name: ListCardsActiveByLabel :many
+SELECT cards.id, cards.project_slug, cards.number, cards.title, cards.branch, cards.status, cards.deleted, cards.revision, cards.created_at, cards.updated_at, cards.comments_count, cards.history_count, cards.todos_count, cards.todos_completed_count, cards.acceptance_criteria_count, cards.acceptance_criteria_completed_count, cards.moved_to, cards.priority, cards.due_at, cards.parent_id, cards.rank
+FROM cards
+JOIN card_labels ON card_labels.card_id = cards.id
+WHERE cards.project_slug = ? AND cards.deleted = 0 AND card_labels.label = ?
+ORDER BY cards.status ASC, cards.rank ASC, cards.number ASC
+`
+
+type ListCardsActiveByLabelParams struct {
+	ProjectSlug string
+	Label       string
+}
+
+func (q *Queries) ListCardsActiveByLabel(ctx context.Context, arg ListCardsActiveByLabelParams) ([]Card, error) {
+	rows, err := q.db.QueryContext(ctx, listCardsActiveByLabel, arg.ProjectSlug, arg.Label)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Card{}
+	for rows.Next() {
+		var i Card
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectSlug,
+			&i.Number,
+			&i.Title,
+			&i.Branch,
+			&i.Status,
+			&i.Deleted,
+			&i.Revision,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CommentsCount,
+			&i.HistoryCount,
+			&i.TodosCount,
+			&i.TodosCompletedCount,
+			&i.AcceptanceCriteriaCount,
+			&i.AcceptanceCriteriaCompletedCount,
+			&i.MovedTo,
+			&i.Priority,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -224,10 +566,10 @@ func (q *Queries) ListCardsActive(ctx context.Context, projectSlug string) ([]Ca
 }
 
 const listCardsWithDeleted = `-- name: ListCardsWithDeleted :many
-SELECT id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 FROM cards
 WHERE project_slug = ?
-ORDER BY number ASC
+ORDER BY status ASC, rank ASC, number ASC
 `
 
 func (q *Queries) ListCardsWithDeleted(ctx context.Context, projectSlug string) ([]Card, error) {
@@ -247,6 +589,65 @@ func (q *Queries) ListCardsWithDeleted(ctx context.Context, projectSlug string)
 			&i.Branch,
 			&i.Status,
 			&i.Deleted,
+			&i.Revision,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CommentsCount,
+			&i.HistoryCount,
+			&i.TodosCount,
+			&i.TodosCompletedCount,
+			&i.AcceptanceCriteriaCount,
+			&i.AcceptanceCriteriaCompletedCount,
+			&i.MovedTo,
+			&i.Priority,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCardsWithDeletedByLabel = `-- name: ListCardsWithDeletedByLabel :many
+SELECT cards.id, cards.project_slug, cards.number, cards.title, cards.branch, cards.status, cards.deleted, cards.revision, cards.created_at, cards.updated_at, cards.comments_count, cards.history_count, cards.todos_count, cards.todos_completed_count, cards.acceptance_criteria_count, cards.acceptance_criteria_completed_count, cards.moved_to, cards.priority, cards.due_at, cards.parent_id, cards.rank
+FROM cards
+JOIN card_labels ON card_labels.card_id = cards.id
+WHERE cards.project_slug = ? AND card_labels.label = ?
+ORDER BY cards.status ASC, cards.rank ASC, cards.number ASC
+`
+
+type ListCardsWithDeletedByLabelParams struct {
+	ProjectSlug string
+	Label       string
+}
+
+func (q *Queries) ListCardsWithDeletedByLabel(ctx context.Context, arg ListCardsWithDeletedByLabelParams) ([]Card, error) {
+	rows, err := q.db.QueryContext(ctx, listCardsWithDeletedByLabel, arg.ProjectSlug, arg.Label)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Card{}
+	for rows.Next() {
+		var i Card
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectSlug,
+			&i.Number,
+			&i.Title,
+			&i.Branch,
+			&i.Status,
+			&i.Deleted,
+			&i.Revision,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CommentsCount,
@@ -255,6 +656,11 @@ func (q *Queries) ListCardsWithDeleted(ctx context.Context, projectSlug string)
 			&i.TodosCompletedCount,
 			&i.AcceptanceCriteriaCount,
 			&i.AcceptanceCriteriaCompletedCount,
+			&i.MovedTo,
+			&i.Priority,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -269,11 +675,115 @@ func (q *Queries) ListCardsWithDeleted(ctx context.Context, projectSlug string)
 	return items, nil
 }
 
+const listChildCards = `-- name: ListChildCards :many
+SELECT id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
+FROM cards
+WHERE parent_id = ? AND deleted = 0
+ORDER BY project_slug ASC, number ASC
+`
+
+func (q *Queries) ListChildCards(ctx context.Context, parentID sql.NullString) ([]Card, error) {
+	rows, err := q.db.QueryContext(ctx, listChildCards, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Card{}
+	for rows.Next() {
+		var i Card
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectSlug,
+			&i.Number,
+			&i.Title,
+			&i.Branch,
+			&i.Status,
+			&i.Deleted,
+			&i.Revision,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CommentsCount,
+			&i.HistoryCount,
+			&i.TodosCount,
+			&i.TodosCompletedCount,
+			&i.AcceptanceCriteriaCount,
+			&i.AcceptanceCriteriaCompletedCount,
+			&i.MovedTo,
+			&i.Priority,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChildCountsByProject = `-- name: ListChildCountsByProject :many
+SELECT parents.id AS parent_id, COUNT(*) AS children_total, CAST(SUM(CASE WHEN children.status = child_projects.done_status THEN 1 ELSE 0 END) AS INTEGER) AS children_done
+FROM cards AS parents
+JOIN cards AS children ON children.parent_id = parents.id
+JOIN projects AS child_projects ON child_projects.slug = children.project_slug
+WHERE parents.project_slug = ? AND children.deleted = 0
+GROUP BY parents.id
+ORDER BY parents.id ASC
+`
+
+type ListChildCountsByProjectRow struct {
+	ParentID      string
+	ChildrenTotal int64
+	ChildrenDone  int64
+}
+
+func (q *Queries) ListChildCountsByProject(ctx context.Context, projectSlug string) ([]ListChildCountsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listChildCountsByProject, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListChildCountsByProjectRow{}
+	for rows.Next() {
+		var i ListChildCountsByProjectRow
+		if err := rows.Scan(&i.ParentID, &i.ChildrenTotal, &i.ChildrenDone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const projectExists = `-- name: ProjectExists :one
+SELECT COUNT(*)
+FROM projects
+WHERE slug = ?
+`
+
+func (q *Queries) ProjectExists(ctx context.Context, slug string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, projectExists, slug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const upsertCard = `-- name: UpsertCard :exec
 INSERT INTO cards (
-  id, project_slug, number, title, branch, status, deleted, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count
+  id, project_slug, number, title, branch, status, deleted, revision, created_at, updated_at, comments_count, history_count, todos_count, todos_completed_count, acceptance_criteria_count, acceptance_criteria_completed_count, moved_to, priority, due_at, parent_id, rank
 )
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
   project_slug = excluded.project_slug,
   number = excluded.number,
@@ -281,6 +791,7 @@ ON CONFLICT(id) DO UPDATE SET
   branch = excluded.branch,
   status = excluded.status,
   deleted = excluded.deleted,
+  revision = excluded.revision,
   created_at = excluded.created_at,
   updated_at = excluded.updated_at,
   comments_count = excluded.comments_count,
@@ -288,7 +799,12 @@ ON CONFLICT(id) DO UPDATE SET
   todos_count = excluded.todos_count,
   todos_completed_count = excluded.todos_completed_count,
   acceptance_criteria_count = excluded.acceptance_criteria_count,
-  acceptance_criteria_completed_count = excluded.acceptance_criteria_completed_count
+  acceptance_criteria_completed_count = excluded.acceptance_criteria_completed_count,
+  moved_to = excluded.moved_to,
+  priority = excluded.priority,
+  due_at = excluded.due_at,
+  parent_id = excluded.parent_id,
+  rank = excluded.rank
 `
 
 type UpsertCardParams struct {
@@ -299,6 +815,7 @@ type UpsertCardParams struct {
 	Branch                           sql.NullString
 	Status                           string
 	Deleted                          int64
+	Revision                         int64
 	CreatedAt                        string
 	UpdatedAt                        string
 	CommentsCount                    int64
@@ -307,6 +824,11 @@ type UpsertCardParams struct {
 	TodosCompletedCount              int64
 	AcceptanceCriteriaCount          int64
 	AcceptanceCriteriaCompletedCount int64
+	MovedTo                          sql.NullString
+	Priority                         sql.NullString
+	DueAt                            sql.NullString
+	ParentID                         sql.NullString
+	Rank                             string
 }
 
 func (q *Queries) UpsertCard(ctx context.Context, arg UpsertCardParams) error {
@@ -318,6 +840,7 @@ func (q *Queries) UpsertCard(ctx context.Context, arg UpsertCardParams) error {
 		arg.Branch,
 		arg.Status,
 		arg.Deleted,
+		arg.Revision,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.CommentsCount,
@@ -326,20 +849,27 @@ func (q *Queries) UpsertCard(ctx context.Context, arg UpsertCardParams) error {
 		arg.TodosCompletedCount,
 		arg.AcceptanceCriteriaCount,
 		arg.AcceptanceCriteriaCompletedCount,
+		arg.MovedTo,
+		arg.Priority,
+		arg.DueAt,
+		arg.ParentID,
+		arg.Rank,
 	)
 	return err
 }
 
 const upsertProject = `-- name: UpsertProject :exec
-INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO projects (slug, name, local_path, remote_url, next_card_seq, created_at, updated_at, done_status, statuses)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(slug) DO UPDATE SET
   name = excluded.name,
   local_path = excluded.local_path,
   remote_url = excluded.remote_url,
   next_card_seq = excluded.next_card_seq,
   created_at = excluded.created_at,
-  updated_at = excluded.updated_at
+  updated_at = excluded.updated_at,
+  done_status = excluded.done_status,
+  statuses = excluded.statuses
 `
 
 type UpsertProjectParams struct {
@@ -350,6 +880,8 @@ type UpsertProjectParams struct {
 	NextCardSeq int64
 	CreatedAt   string
 	UpdatedAt   string
+	DoneStatus  string
+	Statuses    string
 }
 
 func (q *Queries) UpsertProject(ctx context.Context, arg UpsertProjectParams) error {
@@ -361,6 +893,8 @@ func (q *Queries) UpsertProject(ctx context.Context, arg UpsertProjectParams) er
 		arg.NextCardSeq,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.DoneStatus,
+		arg.Statuses,
 	)
 	return err
 }
@@ -1,10 +1,14 @@
 package store
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	_ "modernc.org/sqlite"
@@ -40,7 +44,13 @@ func (p *SQLiteProjection) init() error {
 	if err := p.queries.InitProjectsTable(ctx); err != nil {
 		return err
 	}
-	return p.queries.InitCardsTable(ctx)
+	if err := p.queries.InitCardsTable(ctx); err != nil {
+		return err
+	}
+	if err := p.queries.InitCardLabelsTable(ctx); err != nil {
+		return err
+	}
+	return p.queries.InitCardRelationsTable(ctx)
 }
 
 func (p *SQLiteProjection) UpsertProject(project model.Project) error {
@@ -52,33 +62,87 @@ func (p *SQLiteProjection) UpsertProject(project model.Project) error {
 		NextCardSeq: int64(project.NextCardSeq),
 		CreatedAt:   project.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:   project.UpdatedAt.UTC().Format(time.RFC3339),
+		DoneStatus:  project.DoneStatus(),
+		Statuses:    strings.Join(project.Statuses, "\n"),
 	})
 }
 
+// HasProject reports whether the project is in the projection.
+func (p *SQLiteProjection) HasProject(slug string) (bool, error) {
+	count, err := p.queries.ProjectExists(context.Background(), slug)
+	return count > 0, err
+}
+
 func (p *SQLiteProjection) UpsertCard(card model.Card) error {
+	ctx := context.Background()
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	qtx := p.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
 	todosCompleted := completedTodosCount(card.Todos)
 	acceptanceCompleted := completedAcceptanceCriteriaCount(card.AcceptanceCriteria)
-	return p.queries.UpsertCard(context.Background(), sqlcgen.UpsertCardParams{
-		ID:                                card.ID,
-		ProjectSlug:                       card.ProjectSlug,
-		Number:                            int64(card.Number),
-		Title:                             card.Title,
-		Branch:                            nullableString(card.Branch),
-		Status:                            card.Status,
-		Deleted:                           boolToInt(card.Deleted),
-		CreatedAt:                         card.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt:                         card.UpdatedAt.UTC().Format(time.RFC3339),
-		CommentsCount:                     int64(len(card.Comments)),
-		HistoryCount:                      int64(len(card.History)),
-		TodosCount:                        int64(len(card.Todos)),
-		TodosCompletedCount:               int64(todosCompleted),
-		AcceptanceCriteriaCount:           int64(len(card.AcceptanceCriteria)),
-		AcceptanceCriteriaCompletedCount:  int64(acceptanceCompleted),
-	})
+	if err = qtx.UpsertCard(ctx, sqlcgen.UpsertCardParams{
+		ID:                               card.ID,
+		ProjectSlug:                      card.ProjectSlug,
+		Number:                           int64(card.Number),
+		Title:                            card.Title,
+		Branch:                           nullableString(card.Branch),
+		Status:                           card.Status,
+		Deleted:                          boolToInt(card.Deleted),
+		Revision:                         int64(card.Revision),
+		CreatedAt:                        card.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:                        card.UpdatedAt.UTC().Format(time.RFC3339),
+		CommentsCount:                    int64(len(card.Comments)),
+		HistoryCount:                     int64(len(card.History)),
+		TodosCount:                       int64(len(card.Todos)),
+		TodosCompletedCount:              int64(todosCompleted),
+		AcceptanceCriteriaCount:          int64(len(card.AcceptanceCriteria)),
+		AcceptanceCriteriaCompletedCount: int64(acceptanceCompleted),
+		MovedTo:                          nullableString(card.MovedTo),
+		Priority:                         nullableString(card.Priority),
+		DueAt:                            nullableTime(card.DueAt),
+		ParentID:                         nullableString(card.ParentID),
+		Rank:                             card.Rank,
+	}); err != nil {
+		return err
+	}
+	if err = qtx.DeleteCardLabels(ctx, card.ID); err != nil {
+		return err
+	}
+	if err = insertCardLabels(ctx, qtx, card); err != nil {
+		return err
+	}
+	if err = qtx.DeleteCardRelations(ctx, card.ID); err != nil {
+		return err
+	}
+	if err = insertCardRelations(ctx, qtx, card); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (p *SQLiteProjection) HardDeleteCard(projectSlug string, number int) error {
-	return p.queries.HardDeleteCard(context.Background(), sqlcgen.HardDeleteCardParams{
+	ctx := context.Background()
+	if err := p.queries.DeleteCardLabelsByNumber(ctx, sqlcgen.DeleteCardLabelsByNumberParams{
+		ProjectSlug: projectSlug,
+		Number:      int64(number),
+	}); err != nil {
+		return err
+	}
+	if err := p.queries.DeleteCardRelationsByNumber(ctx, sqlcgen.DeleteCardRelationsByNumberParams{
+		ProjectSlug: projectSlug,
+		Number:      int64(number),
+	}); err != nil {
+		return err
+	}
+	return p.queries.HardDeleteCard(ctx, sqlcgen.HardDeleteCardParams{
 		ProjectSlug: projectSlug,
 		Number:      int64(number),
 	})
@@ -86,26 +150,186 @@ func (p *SQLiteProjection) HardDeleteCard(projectSlug string, number int) error
 
 func (p *SQLiteProjection) DeleteProject(projectSlug string) error {
 	ctx := context.Background()
+	if err := p.queries.DeleteCardLabelsByProject(ctx, projectSlug); err != nil {
+		return err
+	}
+	if err := p.queries.DeleteCardRelationsByProject(ctx, projectSlug); err != nil {
+		return err
+	}
 	if err := p.queries.DeleteCardsByProject(ctx, projectSlug); err != nil {
 		return err
 	}
 	return p.queries.DeleteProjectBySlug(ctx, projectSlug)
 }
 
-func (p *SQLiteProjection) ListCards(projectSlug string, includeDeleted bool) ([]model.CardSummary, error) {
+func (p *SQLiteProjection) ListCards(projectSlug string, opts model.CardListOptions) ([]model.CardSummary, error) {
 	ctx := context.Background()
-	if includeDeleted {
-		rows, err := p.queries.ListCardsWithDeleted(ctx, projectSlug)
-		if err != nil {
-			return nil, err
-		}
-		return mapCardSummaryRowsFromAll(rows)
+	var (
+		rows []sqlcgen.Card
+		err  error
+	)
+	switch {
+	case opts.Label != "" && opts.IncludeDeleted:
+		rows, err = p.queries.ListCardsWithDeletedByLabel(ctx, sqlcgen.ListCardsWithDeletedByLabelParams{ProjectSlug: projectSlug, Label: opts.Label})
+	case opts.Label != "":
+		rows, err = p.queries.ListCardsActiveByLabel(ctx, sqlcgen.ListCardsActiveByLabelParams{ProjectSlug: projectSlug, Label: opts.Label})
+	case opts.IncludeDeleted:
+		rows, err = p.queries.ListCardsWithDeleted(ctx, projectSlug)
+	default:
+		rows, err = p.queries.ListCardsActive(ctx, projectSlug)
+	}
+	if err != nil {
+		return nil, err
 	}
-	rows, err := p.queries.ListCardsActive(ctx, projectSlug)
+	cards, err := mapCardSummaryRows(rows)
 	if err != nil {
 		return nil, err
 	}
-	return mapCardSummaryRowsFromActive(rows)
+	if err := p.annotateCards(ctx, cards); err != nil {
+		return nil, err
+	}
+	statuses, err := p.queries.GetProjectStatuses(ctx, projectSlug)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	project := model.Project{Slug: projectSlug}
+	if statuses != "" {
+		project.Statuses = strings.Split(statuses, "\n")
+	}
+	if opts.Overdue {
+		cards = overdueCards(cards, project.DoneStatus(), time.Now().UTC())
+	}
+	sortCardSummaries(cards, opts.Sort, project.Statuses)
+	return cards, nil
+}
+
+// GetCardSummary returns the summary of one card by ID.
+func (p *SQLiteProjection) GetCardSummary(cardID string) (model.CardSummary, error) {
+	ctx := context.Background()
+	row, err := p.queries.GetCardByID(ctx, cardID)
+	if err != nil {
+		return model.CardSummary{}, err
+	}
+	card, err := cardSummaryFromRow(row)
+	if err != nil {
+		return model.CardSummary{}, err
+	}
+	cards := []model.CardSummary{card}
+	if err := p.annotateCards(ctx, cards); err != nil {
+		return model.CardSummary{}, err
+	}
+	return cards[0], nil
+}
+
+// ListChildCards returns the live cards whose parent is parentID, from any
+// project.
+func (p *SQLiteProjection) ListChildCards(parentID string) ([]model.CardSummary, error) {
+	ctx := context.Background()
+	rows, err := p.queries.ListChildCards(ctx, nullableString(parentID))
+	if err != nil {
+		return nil, err
+	}
+	cards, err := mapCardSummaryRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.annotateCards(ctx, cards); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// annotateCards fills in the labels, blocked flag and child rollup of cards,
+// which are looked up per project.
+func (p *SQLiteProjection) annotateCards(ctx context.Context, cards []model.CardSummary) error {
+	byProject := make(map[string][]int)
+	for i := range cards {
+		byProject[cards[i].ProjectSlug] = append(byProject[cards[i].ProjectSlug], i)
+	}
+	for projectSlug, indexes := range byProject {
+		labels, err := p.queries.ListCardLabelsByProject(ctx, projectSlug)
+		if err != nil {
+			return err
+		}
+		byCard := make(map[string][]string, len(labels))
+		for _, label := range labels {
+			byCard[label.CardID] = append(byCard[label.CardID], label.Label)
+		}
+		blockedIDs, err := p.queries.ListBlockedCardIDsByProject(ctx, projectSlug)
+		if err != nil {
+			return err
+		}
+		childCounts, err := p.queries.ListChildCountsByProject(ctx, projectSlug)
+		if err != nil {
+			return err
+		}
+		byParent := make(map[string]sqlcgen.ListChildCountsByProjectRow, len(childCounts))
+		for _, counts := range childCounts {
+			byParent[counts.ParentID] = counts
+		}
+		for _, i := range indexes {
+			if values, ok := byCard[cards[i].ID]; ok {
+				cards[i].Labels = values
+			}
+			cards[i].Blocked = slices.Contains(blockedIDs, cards[i].ID)
+			if counts, ok := byParent[cards[i].ID]; ok {
+				cards[i].ChildrenTotal = int(counts.ChildrenTotal)
+				cards[i].ChildrenDone = int(counts.ChildrenDone)
+			}
+		}
+	}
+	return nil
+}
+
+// overdueCards keeps the cards whose due date has passed and that are not in
+// the project's done status.
+func overdueCards(cards []model.CardSummary, doneStatus string, now time.Time) []model.CardSummary {
+	return slices.DeleteFunc(cards, func(card model.CardSummary) bool {
+		return card.DueAt == nil || !card.DueAt.Before(now) || card.Status == doneStatus
+	})
+}
+
+// sortCardSummaries reorders cards already sorted by status, then rank. The
+// default order puts statuses in workflow order, with statuses the workflow
+// does not know last. Cards without a priority or due date sort after those
+// with one; ties keep number order.
+func sortCardSummaries(cards []model.CardSummary, order string, statuses []string) {
+	switch order {
+	case "", model.CardSortRank:
+		slices.SortStableFunc(cards, func(a, b model.CardSummary) int {
+			return compareMissingLast(!slices.Contains(statuses, a.Status), !slices.Contains(statuses, b.Status), func() int {
+				return cmp.Compare(slices.Index(statuses, a.Status), slices.Index(statuses, b.Status))
+			})
+		})
+		return
+	}
+	slices.SortFunc(cards, func(a, b model.CardSummary) int { return cmp.Compare(a.Number, b.Number) })
+	switch order {
+	case model.CardSortPriority:
+		slices.SortStableFunc(cards, func(a, b model.CardSummary) int {
+			return compareMissingLast(a.Priority == "", b.Priority == "", func() int { return cmp.Compare(a.Priority, b.Priority) })
+		})
+	case model.CardSortDue:
+		slices.SortStableFunc(cards, func(a, b model.CardSummary) int {
+			return compareMissingLast(a.DueAt == nil, b.DueAt == nil, func() int { return a.DueAt.Compare(*b.DueAt) })
+		})
+	case model.CardSortUpdated:
+		slices.SortStableFunc(cards, func(a, b model.CardSummary) int {
+			return b.UpdatedAt.Compare(a.UpdatedAt)
+		})
+	}
+}
+
+func compareMissingLast(aMissing, bMissing bool, compare func() int) int {
+	switch {
+	case aMissing && bMissing:
+		return 0
+	case aMissing:
+		return 1
+	case bMissing:
+		return -1
+	}
+	return compare()
 }
 
 func (p *SQLiteProjection) RebuildFromMarkdown(projects []model.Project, cards []model.Card) error {
@@ -128,6 +352,12 @@ func (p *SQLiteProjection) RebuildFromMarkdown(projects []model.Project, cards [
 		}
 	}()
 
+	if err = qtx.DeleteAllCardLabels(context.Background()); err != nil {
+		return err
+	}
+	if err = qtx.DeleteAllCardRelations(context.Background()); err != nil {
+		return err
+	}
 	if err = qtx.DeleteAllCards(context.Background()); err != nil {
 		return err
 	}
@@ -144,6 +374,8 @@ func (p *SQLiteProjection) RebuildFromMarkdown(projects []model.Project, cards [
 			NextCardSeq: int64(project.NextCardSeq),
 			CreatedAt:   project.CreatedAt.UTC().Format(time.RFC3339),
 			UpdatedAt:   project.UpdatedAt.UTC().Format(time.RFC3339),
+			DoneStatus:  project.DoneStatus(),
+			Statuses:    strings.Join(project.Statuses, "\n"),
 		}); err != nil {
 			return fmt.Errorf("insert project %s: %w", project.Slug, err)
 		}
@@ -160,6 +392,7 @@ func (p *SQLiteProjection) RebuildFromMarkdown(projects []model.Project, cards [
 			Branch:                           nullableString(card.Branch),
 			Status:                           card.Status,
 			Deleted:                          boolToInt(card.Deleted),
+			Revision:                         int64(card.Revision),
 			CreatedAt:                        card.CreatedAt.UTC().Format(time.RFC3339),
 			UpdatedAt:                        card.UpdatedAt.UTC().Format(time.RFC3339),
 			CommentsCount:                    int64(len(card.Comments)),
@@ -168,62 +401,47 @@ func (p *SQLiteProjection) RebuildFromMarkdown(projects []model.Project, cards [
 			TodosCompletedCount:              int64(todosCompleted),
 			AcceptanceCriteriaCount:          int64(len(card.AcceptanceCriteria)),
 			AcceptanceCriteriaCompletedCount: int64(acceptanceCompleted),
+			MovedTo:                          nullableString(card.MovedTo),
+			Priority:                         nullableString(card.Priority),
+			DueAt:                            nullableTime(card.DueAt),
+			ParentID:                         nullableString(card.ParentID),
+			Rank:                             card.Rank,
 		}); err != nil {
 			return fmt.Errorf("insert card %s: %w", card.ID, err)
 		}
+		if err = insertCardLabels(context.Background(), qtx, card); err != nil {
+			return fmt.Errorf("insert labels of card %s: %w", card.ID, err)
+		}
+		if err = insertCardRelations(context.Background(), qtx, card); err != nil {
+			return fmt.Errorf("insert relations of card %s: %w", card.ID, err)
+		}
 	}
 
 	return tx.Commit()
 }
 
-func mapCardSummaryRowsFromActive(rows []sqlcgen.Card) ([]model.CardSummary, error) {
-	cards := make([]model.CardSummary, 0, len(rows))
-	for _, row := range rows {
-		card, err := cardSummaryFromRaw(
-			row.ID,
-			row.ProjectSlug,
-			row.Number,
-			row.Title,
-			row.Branch,
-			row.Status,
-			row.Deleted,
-			row.CreatedAt,
-			row.UpdatedAt,
-			row.CommentsCount,
-			row.HistoryCount,
-			row.TodosCount,
-			row.TodosCompletedCount,
-			row.AcceptanceCriteriaCount,
-			row.AcceptanceCriteriaCompletedCount,
-		)
-		if err != nil {
-			return nil, err
+func insertCardLabels(ctx context.Context, q *sqlcgen.Queries, card model.Card) error {
+	for _, label := range card.Labels {
+		if err := q.InsertCardLabel(ctx, sqlcgen.InsertCardLabelParams{CardID: card.ID, Label: label}); err != nil {
+			return err
 		}
-		cards = append(cards, card)
 	}
-	return cards, nil
+	return nil
+}
+
+func insertCardRelations(ctx context.Context, q *sqlcgen.Queries, card model.Card) error {
+	for _, relation := range card.Relations {
+		if err := q.InsertCardRelation(ctx, sqlcgen.InsertCardRelationParams{CardID: card.ID, Type: relation.Type, TargetID: relation.CardID}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func mapCardSummaryRowsFromAll(rows []sqlcgen.Card) ([]model.CardSummary, error) {
+func mapCardSummaryRows(rows []sqlcgen.Card) ([]model.CardSummary, error) {
 	cards := make([]model.CardSummary, 0, len(rows))
 	for _, row := range rows {
-		card, err := cardSummaryFromRaw(
-			row.ID,
-			row.ProjectSlug,
-			row.Number,
-			row.Title,
-			row.Branch,
-			row.Status,
-			row.Deleted,
-			row.CreatedAt,
-			row.UpdatedAt,
-			row.CommentsCount,
-			row.HistoryCount,
-			row.TodosCount,
-			row.TodosCompletedCount,
-			row.AcceptanceCriteriaCount,
-			row.AcceptanceCriteriaCompletedCount,
-		)
+		card, err := cardSummaryFromRow(row)
 		if err != nil {
 			return nil, err
 		}
@@ -232,31 +450,46 @@ func mapCardSummaryRowsFromAll(rows []sqlcgen.Card) ([]model.CardSummary, error)
 	return cards, nil
 }
 
-func cardSummaryFromRaw(id, projectSlug string, number int64, title string, branch sql.NullString, status string, deleted int64, created, updated string, commentsCount, historyCount, todosCount, todosCompletedCount, acceptanceCriteriaCount, acceptanceCriteriaCompletedCount int64) (model.CardSummary, error) {
-	createdAt, err := time.Parse(time.RFC3339, created)
+func cardSummaryFromRow(row sqlcgen.Card) (model.CardSummary, error) {
+	createdAt, err := time.Parse(time.RFC3339, row.CreatedAt)
 	if err != nil {
 		return model.CardSummary{}, err
 	}
-	updatedAt, err := time.Parse(time.RFC3339, updated)
+	updatedAt, err := time.Parse(time.RFC3339, row.UpdatedAt)
 	if err != nil {
 		return model.CardSummary{}, err
 	}
+	var dueAt *time.Time
+	if row.DueAt.Valid {
+		parsed, err := time.Parse(time.RFC3339, row.DueAt.String)
+		if err != nil {
+			return model.CardSummary{}, err
+		}
+		dueAt = &parsed
+	}
 	return model.CardSummary{
-		ID:                               id,
-		ProjectSlug:                      projectSlug,
-		Number:                           int(number),
-		Title:                            title,
-		Branch:                           branch.String,
-		Status:                           status,
-		Deleted:                          deleted == 1,
+		ID:                               row.ID,
+		ProjectSlug:                      row.ProjectSlug,
+		Number:                           int(row.Number),
+		Title:                            row.Title,
+		Branch:                           row.Branch.String,
+		Status:                           row.Status,
+		Deleted:                          row.Deleted == 1,
+		Revision:                         int(row.Revision),
 		CreatedAt:                        createdAt,
 		UpdatedAt:                        updatedAt,
-		CommentsCount:                    int(commentsCount),
-		HistoryCount:                     int(historyCount),
-		TodosCount:                       int(todosCount),
-		TodosCompletedCount:              int(todosCompletedCount),
-		AcceptanceCriteriaCount:          int(acceptanceCriteriaCount),
-		AcceptanceCriteriaCompletedCount: int(acceptanceCriteriaCompletedCount),
+		Labels:                           []string{},
+		CommentsCount:                    int(row.CommentsCount),
+		HistoryCount:                     int(row.HistoryCount),
+		TodosCount:                       int(row.TodosCount),
+		TodosCompletedCount:              int(row.TodosCompletedCount),
+		AcceptanceCriteriaCount:          int(row.AcceptanceCriteriaCount),
+		AcceptanceCriteriaCompletedCount: int(row.AcceptanceCriteriaCompletedCount),
+		MovedTo:                          row.MovedTo.String,
+		Priority:                         row.Priority.String,
+		DueAt:                            dueAt,
+		ParentID:                         row.ParentID.String,
+		Rank:                             row.Rank,
 	}, nil
 }
 
@@ -267,6 +500,13 @@ func boolToInt(v bool) int64 {
 	return 0
 }
 
+func nullableTime(v *time.Time) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: v.UTC().Format(time.RFC3339), Valid: true}
+}
+
 func nullableString(v string) sql.NullString {
 	trimmed := v
 	if trimmed == "" {
@@ -2,11 +2,13 @@ package store
 
 import (
 	"database/sql"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/simonjohansson/kanban/backend/internal/model"
+	"github.com/simonjohansson/kanban/backend/internal/store/sqlcgen"
 	"github.com/stretchr/testify/require"
 )
 
@@ -58,7 +60,7 @@ func TestSQLiteProjectionLifecycle(t *testing.T) {
 	card2.Deleted = true
 	require.NoError(t, p.UpsertCard(card2))
 
-	active, err := p.ListCards("alpha", false)
+	active, err := p.ListCards("alpha", model.CardListOptions{})
 	require.NoError(t, err)
 	require.Len(t, active, 1)
 	require.Equal(t, "alpha/card-1", active[0].ID)
@@ -68,21 +70,72 @@ func TestSQLiteProjectionLifecycle(t *testing.T) {
 	require.Equal(t, 2, active[0].AcceptanceCriteriaCount)
 	require.Equal(t, 1, active[0].AcceptanceCriteriaCompletedCount)
 
-	allCards, err := p.ListCards("alpha", true)
+	allCards, err := p.ListCards("alpha", model.CardListOptions{IncludeDeleted: true})
 	require.NoError(t, err)
 	require.Len(t, allCards, 2)
 
 	require.NoError(t, p.HardDeleteCard("alpha", 2))
-	allCards, err = p.ListCards("alpha", true)
+	allCards, err = p.ListCards("alpha", model.CardListOptions{IncludeDeleted: true})
 	require.NoError(t, err)
 	require.Len(t, allCards, 1)
 
 	require.NoError(t, p.DeleteProject("alpha"))
-	allCards, err = p.ListCards("alpha", true)
+	allCards, err = p.ListCards("alpha", model.CardListOptions{IncludeDeleted: true})
 	require.NoError(t, err)
 	require.Len(t, allCards, 0)
 }
 
+func TestSQLiteProjectionFiltersByLabel(t *testing.T) {
+	p, err := NewSQLiteProjection(filepath.Join(t.TempDir(), "projection.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Close() })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	base := model.Card{ProjectSlug: "alpha", Title: "Task", Status: "Todo", CreatedAt: now, UpdatedAt: now}
+	for number, labels := range map[int][]string{1: {"bug", "infra"}, 2: {"infra"}, 3: nil} {
+		card := base
+		card.ID = fmt.Sprintf("alpha/card-%d", number)
+		card.Number = number
+		card.Labels = labels
+		require.NoError(t, p.UpsertCard(card))
+	}
+
+	infra, err := p.ListCards("alpha", model.CardListOptions{Label: "infra"})
+	require.NoError(t, err)
+	require.Len(t, infra, 2)
+	require.Equal(t, []string{"bug", "infra"}, infra[0].Labels)
+	require.Equal(t, []string{"infra"}, infra[1].Labels)
+
+	all, err := p.ListCards("alpha", model.CardListOptions{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, []string{}, all[2].Labels)
+
+	relabeled := base
+	relabeled.ID = "alpha/card-1"
+	relabeled.Number = 1
+	relabeled.Labels = []string{"infra"}
+	relabeled.Deleted = true
+	require.NoError(t, p.UpsertCard(relabeled))
+	bugs, err := p.ListCards("alpha", model.CardListOptions{Label: "bug", IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Empty(t, bugs)
+	infra, err = p.ListCards("alpha", model.CardListOptions{Label: "infra"})
+	require.NoError(t, err)
+	require.Len(t, infra, 1)
+	infra, err = p.ListCards("alpha", model.CardListOptions{Label: "infra", IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, infra, 2)
+
+	require.NoError(t, p.HardDeleteCard("alpha", 2))
+	var count int
+	require.NoError(t, p.db.QueryRow(`SELECT COUNT(*) FROM card_labels`).Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, p.DeleteProject("alpha"))
+	require.NoError(t, p.db.QueryRow(`SELECT COUNT(*) FROM card_labels`).Scan(&count))
+	require.Zero(t, count)
+}
+
 func TestSQLiteProjectionRebuildFromMarkdown(t *testing.T) {
 	dbPath := filepath.Join(t.TempDir(), "projection.db")
 	p, err := NewSQLiteProjection(dbPath)
@@ -91,8 +144,8 @@ func TestSQLiteProjectionRebuildFromMarkdown(t *testing.T) {
 
 	now := time.Now().UTC().Truncate(time.Second)
 	projects := []model.Project{
-		{Slug: "beta", Name: "Beta", CreatedAt: now, UpdatedAt: now, NextCardSeq: 1},
-		{Slug: "alpha", Name: "Alpha", CreatedAt: now, UpdatedAt: now, NextCardSeq: 3},
+		{Slug: "beta", Name: "Beta", CreatedAt: now, UpdatedAt: now, NextCardSeq: 1, Statuses: model.DefaultStatuses},
+		{Slug: "alpha", Name: "Alpha", CreatedAt: now, UpdatedAt: now, NextCardSeq: 3, Statuses: model.DefaultStatuses},
 	}
 	cards := []model.Card{
 		{ID: "beta/card-1", ProjectSlug: "beta", Number: 1, Title: "B", Branch: "feature/b", Status: "Doing", CreatedAt: now, UpdatedAt: now},
@@ -102,7 +155,7 @@ func TestSQLiteProjectionRebuildFromMarkdown(t *testing.T) {
 
 	require.NoError(t, p.RebuildFromMarkdown(projects, cards))
 
-	alphaCards, err := p.ListCards("alpha", true)
+	alphaCards, err := p.ListCards("alpha", model.CardListOptions{IncludeDeleted: true})
 	require.NoError(t, err)
 	require.Len(t, alphaCards, 2)
 	require.Equal(t, 1, alphaCards[0].Number)
@@ -110,7 +163,7 @@ func TestSQLiteProjectionRebuildFromMarkdown(t *testing.T) {
 	require.Equal(t, "feature/a1", alphaCards[0].Branch)
 	require.Equal(t, "feature/a2", alphaCards[1].Branch)
 
-	betaCards, err := p.ListCards("beta", true)
+	betaCards, err := p.ListCards("beta", model.CardListOptions{IncludeDeleted: true})
 	require.NoError(t, err)
 	require.Len(t, betaCards, 1)
 	require.Equal(t, "beta/card-1", betaCards[0].ID)
@@ -119,9 +172,29 @@ func TestSQLiteProjectionRebuildFromMarkdown(t *testing.T) {
 
 func TestSQLiteHelperFunctions(t *testing.T) {
 	now := time.Now().UTC().Truncate(time.Second)
-	summary, err := cardSummaryFromRaw("alpha/card-1", "alpha", 1, "Task", sql.NullString{String: "feature/x", Valid: true}, "Todo", 1, now.Format(time.RFC3339), now.Format(time.RFC3339), 2, 3, 4, 1, 5, 2)
+	row := sqlcgen.Card{
+		ID:                               "alpha/card-1",
+		ProjectSlug:                      "alpha",
+		Number:                           1,
+		Title:                            "Task",
+		Branch:                           sql.NullString{String: "feature/x", Valid: true},
+		Status:                           "Todo",
+		Deleted:                          1,
+		Revision:                         7,
+		CreatedAt:                        now.Format(time.RFC3339),
+		UpdatedAt:                        now.Format(time.RFC3339),
+		CommentsCount:                    2,
+		HistoryCount:                     3,
+		TodosCount:                       4,
+		TodosCompletedCount:              1,
+		AcceptanceCriteriaCount:          5,
+		AcceptanceCriteriaCompletedCount: 2,
+		MovedTo:                          sql.NullString{String: "beta/card-4", Valid: true},
+	}
+	summary, err := cardSummaryFromRow(row)
 	require.NoError(t, err)
 	require.True(t, summary.Deleted)
+	require.Equal(t, 7, summary.Revision)
 	require.Equal(t, "feature/x", summary.Branch)
 	require.Equal(t, 2, summary.CommentsCount)
 	require.Equal(t, 3, summary.HistoryCount)
@@ -129,10 +202,11 @@ func TestSQLiteHelperFunctions(t *testing.T) {
 	require.Equal(t, 1, summary.TodosCompletedCount)
 	require.Equal(t, 5, summary.AcceptanceCriteriaCount)
 	require.Equal(t, 2, summary.AcceptanceCriteriaCompletedCount)
+	require.Equal(t, "beta/card-4", summary.MovedTo)
 
-	_, err = cardSummaryFromRaw("id", "alpha", 1, "Task", sql.NullString{}, "Todo", 0, "bad", now.Format(time.RFC3339), 0, 0, 0, 0, 0, 0)
+	_, err = cardSummaryFromRow(sqlcgen.Card{CreatedAt: "bad", UpdatedAt: now.Format(time.RFC3339)})
 	require.Error(t, err)
-	_, err = cardSummaryFromRaw("id", "alpha", 1, "Task", sql.NullString{}, "Todo", 0, now.Format(time.RFC3339), "bad", 0, 0, 0, 0, 0, 0)
+	_, err = cardSummaryFromRow(sqlcgen.Card{CreatedAt: now.Format(time.RFC3339), UpdatedAt: "bad"})
 	require.Error(t, err)
 
 	require.EqualValues(t, 1, boolToInt(true))
@@ -144,3 +218,151 @@ func TestSQLiteHelperFunctions(t *testing.T) {
 	require.True(t, null.Valid)
 	require.Equal(t, "value", null.String)
 }
+
+func TestSQLiteProjectionSortsAndFiltersOverdue(t *testing.T) {
+	p, err := NewSQLiteProjection(filepath.Join(t.TempDir(), "projection.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Close() })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	past := now.Add(-48 * time.Hour)
+	future := now.Add(48 * time.Hour)
+	project := model.Project{Name: "Alpha", Slug: "alpha", NextCardSeq: 5, CreatedAt: now, UpdatedAt: now, Statuses: model.DefaultStatuses}
+	require.NoError(t, p.UpsertProject(project))
+	cards := []struct {
+		priority string
+		dueAt    *time.Time
+		status   string
+		rank     string
+	}{
+		1: {priority: "", dueAt: &past, status: "Doing", rank: "i"},
+		2: {priority: "P2", dueAt: nil, status: "Todo", rank: "r"},
+		3: {priority: "P0", dueAt: &future, status: "Todo", rank: "i"},
+		4: {priority: "P2", dueAt: &past, status: "Done", rank: "i"},
+	}
+	for number := 1; number < len(cards); number++ {
+		require.NoError(t, p.UpsertCard(model.Card{
+			ID:          fmt.Sprintf("alpha/card-%d", number),
+			ProjectSlug: "alpha",
+			Number:      number,
+			Title:       "Task",
+			Status:      cards[number].status,
+			Rank:        cards[number].rank,
+			Priority:    cards[number].priority,
+			DueAt:       cards[number].dueAt,
+			CreatedAt:   now,
+			UpdatedAt:   now.Add(time.Duration(number) * time.Minute),
+		}))
+	}
+
+	numbers := func(opts model.CardListOptions) []int {
+		t.Helper()
+		summaries, err := p.ListCards("alpha", opts)
+		require.NoError(t, err)
+		out := make([]int, 0, len(summaries))
+		for _, summary := range summaries {
+			out = append(out, summary.Number)
+		}
+		return out
+	}
+
+	require.Equal(t, []int{3, 2, 1, 4}, numbers(model.CardListOptions{}), "statuses in workflow order, then rank")
+	require.Equal(t, []int{3, 2, 1, 4}, numbers(model.CardListOptions{Sort: model.CardSortRank}))
+	require.Equal(t, []int{1, 2, 3, 4}, numbers(model.CardListOptions{Sort: model.CardSortNumber}))
+	require.Equal(t, []int{3, 2, 4, 1}, numbers(model.CardListOptions{Sort: model.CardSortPriority}))
+	require.Equal(t, []int{1, 4, 3, 2}, numbers(model.CardListOptions{Sort: model.CardSortDue}))
+	require.Equal(t, []int{4, 3, 2, 1}, numbers(model.CardListOptions{Sort: model.CardSortUpdated}))
+	require.Equal(t, []int{1}, numbers(model.CardListOptions{Overdue: true}))
+
+	project.Statuses = []string{"Todo", "Doing", "Done", "Released"}
+	require.NoError(t, p.UpsertProject(project))
+	require.Equal(t, []int{1, 4}, numbers(model.CardListOptions{Overdue: true}), "only the last status counts as done")
+
+	summaries, err := p.ListCards("alpha", model.CardListOptions{Sort: model.CardSortDue})
+	require.NoError(t, err)
+	require.Equal(t, "P2", summaries[1].Priority)
+	require.True(t, past.Equal(*summaries[1].DueAt))
+}
+
+func TestSQLiteProjectionReportsBlockedCards(t *testing.T) {
+	p, err := NewSQLiteProjection(filepath.Join(t.TempDir(), "projection.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Close() })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	blocker := model.Card{ID: "beta/card-1", ProjectSlug: "beta", Number: 1, Title: "Schema", Status: "Doing", CreatedAt: now, UpdatedAt: now,
+		Relations: []model.CardRelation{{Type: model.RelationBlocks, CardID: "alpha/card-1"}}}
+	blocked := model.Card{ID: "alpha/card-1", ProjectSlug: "alpha", Number: 1, Title: "API", Status: "Todo", CreatedAt: now, UpdatedAt: now,
+		Relations: []model.CardRelation{{Type: model.RelationBlockedBy, CardID: "beta/card-1"}, {Type: model.RelationRelatesTo, CardID: "alpha/card-2"}}}
+	related := model.Card{ID: "alpha/card-2", ProjectSlug: "alpha", Number: 2, Title: "Docs", Status: "Todo", CreatedAt: now, UpdatedAt: now,
+		Relations: []model.CardRelation{{Type: model.RelationRelatesTo, CardID: "alpha/card-1"}}}
+	projects := []model.Project{{Name: "Alpha", Slug: "alpha", Statuses: model.DefaultStatuses}, {Name: "Beta", Slug: "beta", Statuses: []string{"Todo", "Doing", "Review", "Shipped"}}}
+	require.NoError(t, p.RebuildFromMarkdown(projects, []model.Card{blocker, blocked, related}))
+
+	blockedFlags := func() []bool {
+		t.Helper()
+		cards, err := p.ListCards("alpha", model.CardListOptions{})
+		require.NoError(t, err)
+		out := make([]bool, 0, len(cards))
+		for _, card := range cards {
+			out = append(out, card.Blocked)
+		}
+		return out
+	}
+	require.Equal(t, []bool{true, false}, blockedFlags())
+
+	blocker.Status = "Shipped"
+	require.NoError(t, p.UpsertCard(blocker))
+	require.Equal(t, []bool{false, false}, blockedFlags(), "the blocker's own project decides what is done")
+
+	blocker.Status = "Review"
+	require.NoError(t, p.UpsertCard(blocker))
+	require.Equal(t, []bool{true, false}, blockedFlags())
+
+	require.NoError(t, p.DeleteProject("beta"))
+	require.Equal(t, []bool{false, false}, blockedFlags())
+	var count int
+	require.NoError(t, p.db.QueryRow(`SELECT COUNT(*) FROM card_relations`).Scan(&count))
+	require.Equal(t, 3, count, "only the deleted project's relation rows go")
+}
+
+func TestSQLiteProjectionRollsUpChildCards(t *testing.T) {
+	p, err := NewSQLiteProjection(filepath.Join(t.TempDir(), "projection.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.Close() })
+
+	now := time.Now().UTC().Truncate(time.Second)
+	card := func(id, slug string, number int, status, parent string) model.Card {
+		return model.Card{ID: id, ProjectSlug: slug, Number: number, Title: id, Status: status, ParentID: parent, CreatedAt: now, UpdatedAt: now}
+	}
+	epic := card("alpha/card-1", "alpha", 1, "Doing", "")
+	done := card("alpha/card-2", "alpha", 2, "Done", epic.ID)
+	open := card("beta/card-1", "beta", 1, "Todo", epic.ID)
+	grandchild := card("alpha/card-3", "alpha", 3, "Done", open.ID)
+	projects := []model.Project{{Name: "Alpha", Slug: "alpha", Statuses: model.DefaultStatuses}, {Name: "Beta", Slug: "beta", Statuses: model.DefaultStatuses}}
+	require.NoError(t, p.RebuildFromMarkdown(projects, []model.Card{epic, done, open, grandchild}))
+
+	cards, err := p.ListCards("alpha", model.CardListOptions{})
+	require.NoError(t, err)
+	require.Len(t, cards, 3)
+	require.Equal(t, 1, cards[0].ChildrenDone)
+	require.Equal(t, 2, cards[0].ChildrenTotal)
+	require.Empty(t, cards[0].ParentID)
+	require.Equal(t, epic.ID, cards[1].ParentID)
+	require.Equal(t, 0, cards[1].ChildrenTotal)
+
+	children, err := p.ListChildCards(epic.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	require.Equal(t, done.ID, children[0].ID)
+	require.Equal(t, open.ID, children[1].ID)
+	require.Equal(t, 1, children[1].ChildrenDone, "cross-project children carry their own rollup")
+	require.Equal(t, 1, children[1].ChildrenTotal)
+
+	open.Deleted = true
+	require.NoError(t, p.UpsertCard(open))
+	summary, err := p.GetCardSummary(epic.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, summary.ChildrenDone)
+	require.Equal(t, 1, summary.ChildrenTotal)
+}
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+const maxStatusLength = 40
+
+// SetProjectStatuses replaces a project's workflow. Cards in a status the new
+// workflow drops move to statusMap[old]; a dropped status still in use without
+// a mapping is refused, so no card is left in a status its project does not
+// know. The migrated cards are returned.
+func (s *MarkdownStore) SetProjectStatuses(slug string, statuses []string, statusMap map[string]string) (_ model.Project, _ []model.Card, err error) {
+	unlock, err := s.lockProjects(slug)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	defer unlock()
+
+	statuses, err = normalizeStatuses(statuses)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	project, err := s.loadProject(slug)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	mapping := make(map[string]string, len(statusMap))
+	for from, to := range statusMap {
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if slices.Contains(statuses, from) {
+			return model.Project{}, nil, fmt.Errorf("status %q is kept; only dropped statuses can be mapped", from)
+		}
+		if !slices.Contains(statuses, to) {
+			return model.Project{}, nil, fmt.Errorf("status %q is mapped to %q, which is not in the new statuses", from, to)
+		}
+		mapping[from] = to
+	}
+
+	cards, err := s.listProjectCards(slug)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	var (
+		migrate  []model.Card
+		unmapped []string
+	)
+	for _, card := range cards {
+		if card.MovedTo != "" || slices.Contains(statuses, card.Status) {
+			continue
+		}
+		if _, ok := mapping[card.Status]; !ok {
+			if !slices.Contains(unmapped, card.Status) {
+				unmapped = append(unmapped, card.Status)
+			}
+			continue
+		}
+		migrate = append(migrate, card)
+	}
+	if len(unmapped) > 0 {
+		sort.Strings(unmapped)
+		return model.Project{}, nil, fmt.Errorf("cards still use status %s; map it to one of %s", strings.Join(unmapped, ", "), strings.Join(statuses, ", "))
+	}
+	if slices.Equal(statuses, project.Statuses) {
+		return project, nil, nil
+	}
+
+	now := time.Now().UTC()
+	tx := s.beginJournal("project.statuses")
+	defer tx.end(&err)
+	for i := range migrate {
+		card := &migrate[i]
+		to := mapping[card.Status]
+		card.History = append(card.History, model.HistoryEvent{
+			Timestamp: now,
+			Type:      "card.status.migrated",
+			Details:   fieldChange("status", card.Status, to),
+		})
+		card.Status = to
+		if card.Rank, err = s.rankCardUnlocked(*card, model.CardPosition{}); err != nil {
+			return model.Project{}, nil, err
+		}
+		card.UpdatedAt = now
+		if err := tx.writeCard(card); err != nil {
+			return model.Project{}, nil, err
+		}
+	}
+	project.Statuses = statuses
+	project.WIPLimits = keepWIPLimits(project.WIPLimits, statuses)
+	project.TransitionRules = keepTransitionRules(project.TransitionRules, project)
+	project.UpdatedAt = now
+	if err := tx.writeProject(project); err != nil {
+		return model.Project{}, nil, err
+	}
+	if err := tx.commit(); err != nil {
+		return model.Project{}, nil, err
+	}
+	return project, migrate, nil
+}
+
+// normalizeStatuses trims a workflow and checks it has at least a start and a
+// done status, without blanks or duplicates.
+func normalizeStatuses(statuses []string) ([]string, error) {
+	out := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		status = strings.TrimSpace(status)
+		switch {
+		case status == "":
+			return nil, errors.New("statuses must not be blank")
+		case len(status) > maxStatusLength:
+			return nil, fmt.Errorf("status %q must be at most %d characters", status, maxStatusLength)
+		case strings.ContainsAny(status, "\r\n"):
+			return nil, fmt.Errorf("status %q must be a single line", status)
+		case slices.ContainsFunc(out, func(existing string) bool { return strings.EqualFold(existing, status) }):
+			return nil, fmt.Errorf("status %q is listed twice", status)
+		}
+		out = append(out, status)
+	}
+	if len(out) < 2 {
+		return nil, errors.New("a workflow needs at least two statuses")
+	}
+	return out, nil
+}
+
+// transferStatus picks a card's status in the project it is transferred to:
+// the same status if the target has it, the target's done status for a done
+// card, and otherwise the target's first status.
+func transferStatus(source, target model.Project, status string) string {
+	switch {
+	case target.HasStatus(status):
+		return status
+	case status == source.DoneStatus():
+		return target.DoneStatus()
+	default:
+		return target.Statuses[0]
+	}
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+type transitionRuleFrontmatter struct {
+	From     string   `yaml:"from,omitempty"`
+	To       string   `yaml:"to"`
+	Requires []string `yaml:"requires"`
+}
+
+// SetTransitionRules replaces a project's transition rules. An empty list
+// removes them.
+func (s *MarkdownStore) SetTransitionRules(slug string, rules []model.TransitionRule) (model.Project, error) {
+	unlock, err := s.lockProjects(slug)
+	if err != nil {
+		return model.Project{}, err
+	}
+	defer unlock()
+
+	project, err := s.loadProject(slug)
+	if err != nil {
+		return model.Project{}, err
+	}
+	rules, err = normalizeTransitionRules(project, rules)
+	if err != nil {
+		return model.Project{}, err
+	}
+	if slices.EqualFunc(rules, project.TransitionRules, equalTransitionRules) {
+		return project, nil
+	}
+	project.TransitionRules = rules
+	project.UpdatedAt = time.Now().UTC()
+	if err := s.writeProject(project); err != nil {
+		return model.Project{}, err
+	}
+	return project, nil
+}
+
+// normalizeTransitionRules trims rules and checks they name the project's
+// statuses and known conditions.
+func normalizeTransitionRules(project model.Project, rules []model.TransitionRule) ([]model.TransitionRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	out := make([]model.TransitionRule, 0, len(rules))
+	for _, rule := range rules {
+		rule.From, rule.To = strings.TrimSpace(rule.From), strings.TrimSpace(rule.To)
+		if rule.From != "" {
+			if err := validateStatus(project, rule.From); err != nil {
+				return nil, err
+			}
+		}
+		if err := validateStatus(project, rule.To); err != nil {
+			return nil, err
+		}
+		if rule.From == rule.To {
+			return nil, fmt.Errorf("rule from %s to itself never applies", rule.To)
+		}
+		if len(rule.Requires) == 0 {
+			return nil, fmt.Errorf("rule to %s requires no conditions", rule.To)
+		}
+		requires := make([]string, 0, len(rule.Requires))
+		for _, condition := range rule.Requires {
+			condition = strings.ToLower(strings.TrimSpace(condition))
+			if _, ok := model.AllowedConditions[condition]; !ok {
+				return nil, fmt.Errorf("invalid condition %q: use acceptance_criteria_completed, todos_completed or branch", condition)
+			}
+			if !slices.Contains(requires, condition) {
+				requires = append(requires, condition)
+			}
+		}
+		rule.Requires = requires
+		out = append(out, rule)
+	}
+	return out, nil
+}
+
+// checkTransitionRules refuses card entering status while it fails the
+// project's transition rules, unless force is set. A forced move past them
+// returns the history event recording the override.
+func checkTransitionRules(project model.Project, card model.Card, status string, force bool, now time.Time) (*model.HistoryEvent, error) {
+	if status == card.Status {
+		return nil, nil
+	}
+	unmet := project.UnmetConditions(card, status)
+	if len(unmet) == 0 {
+		return nil, nil
+	}
+	if !force {
+		return nil, &model.TransitionError{Number: card.Number, From: card.Status, To: status, Unmet: unmet}
+	}
+	return &model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.transition.overridden",
+		Details:   fmt.Sprintf("%s -> %s: %s", card.Status, status, strings.Join(unmet, "; ")),
+	}, nil
+}
+
+func equalTransitionRules(a, b model.TransitionRule) bool {
+	return a.From == b.From && a.To == b.To && slices.Equal(a.Requires, b.Requires)
+}
+
+func transitionRulesToFrontmatter(rules []model.TransitionRule) []transitionRuleFrontmatter {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]transitionRuleFrontmatter, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, transitionRuleFrontmatter{From: rule.From, To: rule.To, Requires: rule.Requires})
+	}
+	return out
+}
+
+func transitionRulesFromFrontmatter(rules []transitionRuleFrontmatter) []model.TransitionRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]model.TransitionRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, model.TransitionRule{From: rule.From, To: rule.To, Requires: rule.Requires})
+	}
+	return out
+}
+
+// keepTransitionRules drops the rules a hand edit or a workflow change left
+// invalid, e.g. naming a status the project no longer has.
+func keepTransitionRules(rules []model.TransitionRule, project model.Project) []model.TransitionRule {
+	var out []model.TransitionRule
+	for _, rule := range rules {
+		if valid, err := normalizeTransitionRules(project, []model.TransitionRule{rule}); err == nil {
+			out = append(out, valid...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,222 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// trashTimeLayout is the deletion timestamp suffix of a trash entry. It has no
+// dashes so the slug can be recovered by splitting on the last one.
+const trashTimeLayout = "20060102T150405.000Z"
+
+var trashIDPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*-\d{8}T\d{6}\.\d{3}Z$`)
+
+// ListTrashedProjects returns the projects under the trash directory, most
+// recently deleted first.
+func (s *MarkdownStore) ListTrashedProjects() ([]model.TrashedProject, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries, err := os.ReadDir(s.trashDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []model.TrashedProject{}, nil
+		}
+		return nil, err
+	}
+	trashed := make([]model.TrashedProject, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !trashIDPattern.MatchString(entry.Name()) {
+			continue
+		}
+		item, err := s.loadTrashedProject(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		trashed = append(trashed, item)
+	}
+	sort.Slice(trashed, func(i, j int) bool {
+		if trashed[i].DeletedAt.Equal(trashed[j].DeletedAt) {
+			return trashed[i].ID < trashed[j].ID
+		}
+		return trashed[i].DeletedAt.After(trashed[j].DeletedAt)
+	})
+	return trashed, nil
+}
+
+// RestoreTrashedProject moves a trashed project back under its original slug
+// and returns it with its cards. It fails with os.ErrExist if a project with
+// that slug has been created since.
+func (s *MarkdownStore) RestoreTrashedProject(id string) (model.Project, []model.Card, error) {
+	slug, err := s.moveProjectFromTrash(id)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	unlock := s.rlockProject(slug)
+	defer unlock()
+	project, err := s.loadProject(slug)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	cards, err := s.listProjectCards(slug)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	return project, cards, nil
+}
+
+func (s *MarkdownStore) moveProjectFromTrash(id string) (string, error) {
+	unlock, err := s.lockStore()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	item, err := s.trashedProjectUnlocked(id)
+	if err != nil {
+		return "", err
+	}
+	target := s.projectDir(item.Slug)
+	if _, err := os.Stat(target); err == nil {
+		return "", fmt.Errorf("project %q already exists: %w", item.Slug, os.ErrExist)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	// Record the files under their restored paths first so the watcher treats
+	// the move back as the store's own write.
+	source := filepath.Join(s.trashDir, id)
+	files, err := os.ReadDir(source)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(source, file.Name()))
+		if err != nil {
+			return "", err
+		}
+		s.rememberWrite(filepath.Join(target, file.Name()), data)
+	}
+	if err := renameFile(source, target); err != nil {
+		return "", err
+	}
+	return item.Slug, nil
+}
+
+// PurgeTrashedProject permanently removes one trash entry.
+func (s *MarkdownStore) PurgeTrashedProject(id string) error {
+	unlock, err := s.lockStore()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err := s.trashedProjectUnlocked(id); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(s.trashDir, id))
+}
+
+// PurgeTrashBefore permanently removes every trash entry deleted before the
+// cutoff and returns what was removed.
+func (s *MarkdownStore) PurgeTrashBefore(cutoff time.Time) ([]model.TrashedProject, error) {
+	trashed, err := s.ListTrashedProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := s.lockStore()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	purged := make([]model.TrashedProject, 0)
+	for _, item := range trashed {
+		if !item.DeletedAt.Before(cutoff) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(s.trashDir, item.ID)); err != nil {
+			return purged, err
+		}
+		purged = append(purged, item)
+	}
+	return purged, nil
+}
+
+// moveProjectToTrash renames a project directory into the trash. The caller
+// holds s.mu.
+func (s *MarkdownStore) moveProjectToTrash(slug string, now time.Time) error {
+	if err := os.MkdirAll(s.trashDir, 0o755); err != nil {
+		return err
+	}
+	// Deleting, recreating and deleting a slug again within the same
+	// millisecond would collide, so step forward to the next free id.
+	deletedAt := now.UTC()
+	for {
+		target := filepath.Join(s.trashDir, slug+"-"+deletedAt.Format(trashTimeLayout))
+		if _, err := os.Stat(target); errors.Is(err, os.ErrNotExist) {
+			return renameFile(s.projectDir(slug), target)
+		} else if err != nil {
+			return err
+		}
+		deletedAt = deletedAt.Add(time.Millisecond)
+	}
+}
+
+func (s *MarkdownStore) trashedProjectUnlocked(id string) (model.TrashedProject, error) {
+	if !trashIDPattern.MatchString(id) {
+		return model.TrashedProject{}, fmt.Errorf("invalid trash id %q", id)
+	}
+	info, err := os.Stat(filepath.Join(s.trashDir, id))
+	if err != nil {
+		return model.TrashedProject{}, err
+	}
+	if !info.IsDir() {
+		return model.TrashedProject{}, os.ErrNotExist
+	}
+	return s.loadTrashedProject(id)
+}
+
+func (s *MarkdownStore) loadTrashedProject(id string) (model.TrashedProject, error) {
+	cut := strings.LastIndex(id, "-")
+	slug := id[:cut]
+	deletedAt, err := time.Parse(trashTimeLayout, id[cut+1:])
+	if err != nil {
+		return model.TrashedProject{}, err
+	}
+
+	dir := filepath.Join(s.trashDir, id)
+	item := model.TrashedProject{ID: id, Slug: slug, Name: slug, DeletedAt: deletedAt}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return model.TrashedProject{}, err
+	}
+	for _, entry := range entries {
+		if _, ok := cardNumberFromFilename(entry.Name()); ok {
+			item.CardsCount++
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "project.md"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return item, nil
+		}
+		return model.TrashedProject{}, err
+	}
+	if project, err := parseProject(data, slug); err == nil && project.Name != "" {
+		item.Name = project.Name
+	}
+	return item, nil
+}
@@ -0,0 +1,242 @@
+package store
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// FileChange describes a project or card markdown file that was changed on
+// disk by something other than the store. CardNumber is zero for project files.
+type FileChange struct {
+	ProjectSlug string
+	CardNumber  int
+	Removed     bool
+}
+
+// Editors often save through several filesystem operations (truncate+write,
+// remove+create, rename). Events for one path are coalesced for this long
+// before the file is inspected.
+var watchDebounce = 100 * time.Millisecond
+
+type Watcher struct {
+	store    *MarkdownStore
+	fsw      *fsnotify.Watcher
+	logger   *slog.Logger
+	onChange func(FileChange)
+
+	mu      sync.Mutex
+	pending map[string]*time.Timer
+	closed  bool
+
+	emitMu sync.Mutex
+	done   chan struct{}
+}
+
+// NewWatcher watches the store's project directories and calls onChange for
+// every project.md or card-N.md file whose content no longer matches what the
+// store itself wrote. Writes and removals performed through the store are
+// suppressed.
+func NewWatcher(s *MarkdownStore, logger *slog.Logger, onChange func(FileChange)) (*Watcher, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		store:    s,
+		fsw:      fsw,
+		logger:   logger,
+		onChange: onChange,
+		pending:  map[string]*time.Timer{},
+		done:     make(chan struct{}),
+	}
+	if err := fsw.Add(s.projectsDir); err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+	entries, err := os.ReadDir(s.projectsDir)
+	if err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if err := fsw.Add(filepath.Join(s.projectsDir, entry.Name())); err != nil {
+			_ = fsw.Close()
+			return nil, err
+		}
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *Watcher) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	for path, timer := range w.pending {
+		timer.Stop()
+		delete(w.pending, path)
+	}
+	w.mu.Unlock()
+
+	// Wait for an in-flight callback so onChange never runs after Close.
+	w.emitMu.Lock()
+	w.emitMu.Unlock()
+
+	err := w.fsw.Close()
+	<-w.done
+	return err
+}
+
+func (w *Watcher) run() {
+	defer close(w.done)
+	for {
+		select {
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			w.handleEvent(event)
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			w.logger.Warn("markdown watcher error", "error", err)
+		}
+	}
+}
+
+func (w *Watcher) handleEvent(event fsnotify.Event) {
+	rel, err := filepath.Rel(w.store.projectsDir, event.Name)
+	if err != nil {
+		return
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) == 0 || strings.HasPrefix(parts[0], ".") {
+		return
+	}
+
+	switch len(parts) {
+	case 1:
+		// A project directory moved away (to the trash) keeps its inotify
+		// watch; drop it so later events are not reported under the old path.
+		if event.Has(fsnotify.Rename) || event.Has(fsnotify.Remove) {
+			_ = w.fsw.Remove(event.Name)
+			return
+		}
+		// A new project directory: watch it and pick up files that may have
+		// been written before the watch was in place.
+		if !event.Has(fsnotify.Create) {
+			return
+		}
+		info, err := os.Stat(event.Name)
+		if err != nil || !info.IsDir() {
+			return
+		}
+		if err := w.fsw.Add(event.Name); err != nil {
+			w.logger.Warn("markdown watcher add failed", "path", event.Name, "error", err)
+			return
+		}
+		entries, err := os.ReadDir(event.Name)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			if isWatchedFile(entry.Name()) {
+				w.schedule(filepath.Join(event.Name, entry.Name()))
+			}
+		}
+	case 2:
+		if isWatchedFile(parts[1]) {
+			w.schedule(event.Name)
+		}
+	}
+}
+
+func (w *Watcher) schedule(path string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+	if timer, ok := w.pending[path]; ok {
+		timer.Reset(watchDebounce)
+		return
+	}
+	w.pending[path] = time.AfterFunc(watchDebounce, func() { w.flush(path) })
+}
+
+func (w *Watcher) flush(path string) {
+	w.emitMu.Lock()
+	defer w.emitMu.Unlock()
+
+	w.mu.Lock()
+	delete(w.pending, path)
+	closed := w.closed
+	w.mu.Unlock()
+	if closed {
+		return
+	}
+
+	change, ok, err := w.store.changeForPath(path)
+	if err != nil {
+		w.logger.Warn("markdown watcher inspect failed", "path", path, "error", err)
+		return
+	}
+	if ok {
+		w.onChange(change)
+	}
+}
+
+// changeForPath maps a watched path to the change it represents, or reports
+// false when the file still matches what the store knows about.
+func (s *MarkdownStore) changeForPath(path string) (FileChange, bool, error) {
+	slug := filepath.Base(filepath.Dir(path))
+	name := filepath.Base(path)
+
+	removed, changed, err := s.observe(path)
+	if err != nil || !changed {
+		return FileChange{}, false, err
+	}
+	change := FileChange{ProjectSlug: slug, Removed: removed}
+	if name == "project.md" {
+		if removed {
+			// Only a project whose directory is gone counts as removed; a
+			// missing project.md alone is left for the operator to repair.
+			if _, err := os.Stat(s.projectDir(slug)); !errors.Is(err, os.ErrNotExist) {
+				return FileChange{}, false, nil
+			}
+		}
+		return change, true, nil
+	}
+	number, ok := cardNumberFromFilename(name)
+	if !ok {
+		return FileChange{}, false, nil
+	}
+	change.CardNumber = number
+	return change, true, nil
+}
+
+func isWatchedFile(name string) bool {
+	if name == "project.md" {
+		return true
+	}
+	_, ok := cardNumberFromFilename(name)
+	return ok
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+func startTestWatcher(t *testing.T, s *MarkdownStore) <-chan FileChange {
+	t.Helper()
+	changes := make(chan FileChange, 16)
+	w, err := NewWatcher(s, slog.New(slog.NewTextHandler(io.Discard, nil)), func(change FileChange) {
+		changes <- change
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = w.Close() })
+	return changes
+}
+
+func requireChange(t *testing.T, changes <-chan FileChange, expected FileChange) {
+	t.Helper()
+	select {
+	case got := <-changes:
+		require.Equal(t, expected, got)
+	case <-time.After(3 * time.Second):
+		t.Fatalf("expected change %+v", expected)
+	}
+}
+
+func requireNoChange(t *testing.T, changes <-chan FileChange) {
+	t.Helper()
+	select {
+	case got := <-changes:
+		t.Fatalf("unexpected change %+v", got)
+	case <-time.After(3 * watchDebounce):
+	}
+}
+
+func TestWatcherSuppressesStoreWrites(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	changes := startTestWatcher(t, s)
+
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	_, err = s.AddComment("alpha", 1, "first", 0)
+	require.NoError(t, err)
+	_, err = s.MoveCard("alpha", 1, "Doing", model.CardPosition{}, false, 0)
+	require.NoError(t, err)
+	_, err = s.DeleteCard("alpha", 1, true, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteProject("alpha"))
+
+	requireNoChange(t, changes)
+}
+
+func TestWatcherReportsHandEdits(t *testing.T) {
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = s.CreateProject("Alpha", "", "")
+	require.NoError(t, err)
+	_, err = s.CreateCard("alpha", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+	changes := startTestWatcher(t, s)
+
+	cardPath := s.cardPath("alpha", 1)
+	raw, err := os.ReadFile(cardPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cardPath, []byte(strings.Replace(string(raw), "title: Task", "title: Edited", 1)), 0o644))
+	requireChange(t, changes, FileChange{ProjectSlug: "alpha", CardNumber: 1})
+
+	card, err := s.GetCard("alpha", 1)
+	require.NoError(t, err)
+	require.Equal(t, "Edited", card.Title)
+
+	projectPath := s.projectPath("alpha")
+	raw, err = os.ReadFile(projectPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(projectPath, []byte(strings.Replace(string(raw), "name: Alpha", "name: Alpha Renamed", 1)), 0o644))
+	requireChange(t, changes, FileChange{ProjectSlug: "alpha"})
+
+	require.NoError(t, os.Remove(cardPath))
+	requireChange(t, changes, FileChange{ProjectSlug: "alpha", CardNumber: 1, Removed: true})
+	requireNoChange(t, changes)
+}
+
+func TestWatcherPicksUpProjectDirectoriesCreatedByHand(t *testing.T) {
+	src, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = src.CreateProject("Beta", "", "")
+	require.NoError(t, err)
+	_, err = src.CreateCard("beta", "Task", "", "", "Todo", "", false)
+	require.NoError(t, err)
+
+	s, err := NewMarkdownStore(t.TempDir())
+	require.NoError(t, err)
+	changes := startTestWatcher(t, s)
+
+	require.NoError(t, os.Rename(src.projectDir("beta"), filepath.Join(s.projectsDir, "beta")))
+
+	got := map[FileChange]bool{}
+	for range 2 {
+		select {
+		case change := <-changes:
+			got[change] = true
+		case <-time.After(3 * time.Second):
+			t.Fatalf("expected project and card changes, got %+v", got)
+		}
+	}
+	require.True(t, got[FileChange{ProjectSlug: "beta"}])
+	require.True(t, got[FileChange{ProjectSlug: "beta", CardNumber: 1}])
+
+	require.NoError(t, os.RemoveAll(filepath.Join(s.projectsDir, "beta")))
+	removed := map[FileChange]bool{}
+	for range 2 {
+		select {
+		case change := <-changes:
+			removed[change] = true
+		case <-time.After(3 * time.Second):
+			t.Fatalf("expected project and card removals, got %+v", removed)
+		}
+	}
+	require.True(t, removed[FileChange{ProjectSlug: "beta", Removed: true}])
+	require.True(t, removed[FileChange{ProjectSlug: "beta", CardNumber: 1, Removed: true}])
+}
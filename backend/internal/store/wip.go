@@ -0,0 +1,83 @@
+package store
+
+import (
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/simonjohansson/kanban/backend/internal/model"
+)
+
+// applyWIPLimits merges limits into the project's WIP limits and returns the
+// result. A limit of 0 removes the limit of that status.
+func applyWIPLimits(project model.Project, limits map[string]int) (map[string]int, error) {
+	out := maps.Clone(project.WIPLimits)
+	if out == nil {
+		out = make(map[string]int, len(limits))
+	}
+	for status, limit := range limits {
+		if err := validateStatus(project, status); err != nil {
+			return nil, err
+		}
+		switch {
+		case limit < 0:
+			return nil, fmt.Errorf("WIP limit for %s must not be negative", status)
+		case limit == 0:
+			delete(out, status)
+		default:
+			out[status] = limit
+		}
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// keepWIPLimits drops limits that are not positive or name a status the
+// workflow does not have, e.g. after a hand edit or a workflow change.
+func keepWIPLimits(limits map[string]int, statuses []string) map[string]int {
+	var out map[string]int
+	for status, limit := range limits {
+		if limit <= 0 || !(model.Project{Statuses: statuses}).HasStatus(status) {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]int, len(limits))
+		}
+		out[status] = limit
+	}
+	return out
+}
+
+// checkWIPLimitUnlocked refuses card entering a status that already holds as
+// many live cards as the project's WIP limit allows, unless force is set. A
+// forced violation is recorded in the card's history.
+func (s *MarkdownStore) checkWIPLimitUnlocked(project model.Project, card *model.Card, force bool, now time.Time) error {
+	limit := project.WIPLimit(card.Status)
+	if limit == 0 {
+		return nil
+	}
+	cards, err := s.listProjectCards(project.Slug)
+	if err != nil {
+		return err
+	}
+	count := 1
+	for _, other := range cards {
+		if !other.Deleted && other.Number != card.Number && other.Status == card.Status {
+			count++
+		}
+	}
+	if count <= limit {
+		return nil
+	}
+	if !force {
+		return &model.WIPLimitError{Status: card.Status, Limit: limit}
+	}
+	card.History = append(card.History, model.HistoryEvent{
+		Timestamp: now,
+		Type:      "card.wip_limit.exceeded",
+		Details:   fmt.Sprintf("%s holds %d cards; limit %d", card.Status, count, limit),
+	})
+	return nil
+}
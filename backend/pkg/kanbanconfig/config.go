@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	DefaultServerURL = "http://127.0.0.1:8080"
-	DefaultOutput    = "text"
+	DefaultServerURL          = "http://127.0.0.1:8080"
+	DefaultOutput             = "text"
+	DefaultTrashRetentionDays = 30
 )
 
 type Config struct {
@@ -23,6 +24,9 @@ type Config struct {
 type BackendConfig struct {
 	SQLitePath string `yaml:"sqlite_path"`
 	CardsPath  string `yaml:"cards_path"`
+	// TrashRetentionDays is how long deleted projects are kept in the trash.
+	// A negative value keeps them until purged by hand.
+	TrashRetentionDays int `yaml:"trash_retention_days"`
 }
 
 type CLIConfig struct {
@@ -36,8 +40,9 @@ func Default(home string) Config {
 	return Config{
 		ServerURL: DefaultServerURL,
 		Backend: BackendConfig{
-			SQLitePath: filepath.Join(stateDir, "projection.db"),
-			CardsPath:  cardsPath,
+			SQLitePath:         filepath.Join(stateDir, "projection.db"),
+			CardsPath:          cardsPath,
+			TrashRetentionDays: DefaultTrashRetentionDays,
 		},
 		CLI: CLIConfig{
 			Output: DefaultOutput,
@@ -115,6 +120,9 @@ func Merge(defaults Config, user Config) Config {
 	if in.Backend.CardsPath != "" {
 		out.Backend.CardsPath = in.Backend.CardsPath
 	}
+	if in.Backend.TrashRetentionDays != 0 {
+		out.Backend.TrashRetentionDays = in.Backend.TrashRetentionDays
+	}
 
 	if in.CLI.Output != "" {
 		out.CLI.Output = in.CLI.Output
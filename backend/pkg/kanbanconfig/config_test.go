@@ -18,6 +18,7 @@ func TestLoadOrInitCreatesDefaults(t *testing.T) {
 	require.Equal(t, DefaultServerURL, cfg.ServerURL)
 	require.NotEmpty(t, cfg.Backend.SQLitePath)
 	require.NotEmpty(t, cfg.Backend.CardsPath)
+	require.Equal(t, DefaultTrashRetentionDays, cfg.Backend.TrashRetentionDays)
 	require.Equal(t, DefaultOutput, cfg.CLI.Output)
 	require.Equal(t, filepath.Join(home, ".config", "kanban", "config.yaml"), ConfigPath(home))
 